@@ -0,0 +1,29 @@
+package balancers
+
+import (
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/circuitbreaker"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+// WithCircuitBreaker enables circuit breaker of endpoints: circuit breaker of endpoint opens if failure rate
+// of calls over endpoint crosses threshold and moves to half-open state after cool-down.
+// Endpoints with open circuit breaker are excluded from balancing and calls which bound to such endpoint
+// (e.g. calls of session on node) fails fast with retry.CircuitBreakerOpenError
+func WithCircuitBreaker(balancer *balancerConfig.Config, opts ...retry.CircuitBreakerOption) *balancerConfig.Config {
+	circuitBreaker := circuitbreaker.Config{
+		FailureRateThreshold: retry.DefaultCircuitBreakerFailureRateThreshold,
+		MinRequests:          retry.DefaultCircuitBreakerMinRequests,
+		Interval:             retry.DefaultCircuitBreakerInterval,
+		CoolDown:             retry.DefaultCircuitBreakerCoolDown,
+		HalfOpenRequests:     retry.DefaultCircuitBreakerHalfOpenRequests,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&circuitBreaker)
+		}
+	}
+	balancer.CircuitBreaker = &circuitBreaker
+
+	return balancer
+}
@@ -15,6 +15,10 @@ const (
 	typeRandomChoice = balancerType("random_choice")
 	typeSingle       = balancerType("single")
 	typeDisable      = balancerType("disable")
+
+	typeLeastInFlight     = balancerType("least_in_flight")
+	typePowerOfTwoChoices = balancerType("power_of_two_choices")
+	typeLatencyWeighted   = balancerType("latency_weighted")
 )
 
 type preferType string
@@ -29,6 +33,15 @@ type balancersConfig struct {
 	Prefer    preferType   `json:"prefer,omitempty"`
 	Fallback  bool         `json:"fallback,omitempty"`
 	Locations []string     `json:"locations,omitempty"`
+
+	// OutlierDetection enables outlier detection with default settings
+	OutlierDetection bool `json:"outlier_detection,omitempty"`
+
+	// Hedging enables hedged requests with default settings
+	Hedging bool `json:"hedging,omitempty"`
+
+	// CircuitBreaker enables circuit breaker of endpoints with default settings
+	CircuitBreaker bool `json:"circuit_breaker,omitempty"`
 }
 
 type fromConfigOptionsHolder struct {
@@ -60,6 +73,12 @@ func createByType(t balancerType) (*balancerConfig.Config, error) {
 		return RandomChoice(), nil
 	case typeRoundRobin:
 		return RoundRobin(), nil
+	case typeLeastInFlight:
+		return LeastInFlight(), nil
+	case typePowerOfTwoChoices:
+		return PowerOfTwoChoices(), nil
+	case typeLatencyWeighted:
+		return LatencyWeighted(), nil
 	default:
 		return nil, xerrors.WithStackTrace(fmt.Errorf("unknown type of balancer: %s", t))
 	}
@@ -87,6 +106,18 @@ func CreateFromConfig(s string) (*balancerConfig.Config, error) {
 		return nil, xerrors.WithStackTrace(err)
 	}
 
+	if c.OutlierDetection {
+		b = WithOutlierDetection(b)
+	}
+
+	if c.Hedging {
+		b = WithHedging(b)
+	}
+
+	if c.CircuitBreaker {
+		b = WithCircuitBreaker(b)
+	}
+
 	switch c.Prefer {
 	case preferTypeLocalDC:
 		if c.Fallback {
@@ -131,6 +131,47 @@ func TestFromConfig(t *testing.T) {
 				}),
 			},
 		},
+		{
+			name:   "least_in_flight",
+			config: `least_in_flight`,
+			res:    balancerConfig.Config{Policy: newLeastInFlight()},
+		},
+		{
+			name: "random_choice/hedging",
+			config: `{
+				"type": "random_choice",
+				"hedging": true
+			}`,
+			res: balancerConfig.Config{
+				Hedging: &balancerConfig.Hedging{
+					Delay:       DefaultHedgingDelay,
+					BudgetRatio: DefaultHedgingBudgetRatio,
+					BudgetBurst: DefaultHedgingBudgetBurst,
+				},
+			},
+		},
+		{
+			name: "power_of_two_choices/prefer_local_dc",
+			config: `{
+				"type": "power_of_two_choices",
+				"prefer": "local_dc"
+			}`,
+			res: balancerConfig.Config{
+				DetectLocalDC: true,
+				Filter: filterFunc(func(info balancerConfig.Info, c conn.Conn) bool {
+					// some non nil func
+					return false
+				}),
+				Policy: newPowerOfTwoChoices(),
+			},
+		},
+		{
+			name: "latency_weighted/JSON",
+			config: `{
+				"type": "latency_weighted"
+			}`,
+			res: balancerConfig.Config{Policy: newLatencyWeighted()},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			var (
@@ -161,6 +202,14 @@ func TestFromConfig(t *testing.T) {
 				tt.res.Filter = nil
 			}
 
+			// policies contains random generators
+			if tt.res.Policy != nil {
+				require.NotNil(t, b.Policy)
+				require.Equal(t, tt.res.Policy.String(), b.Policy.String())
+				b.Policy = nil
+				tt.res.Policy = nil
+			}
+
 			require.Equal(t, tt.res, *b)
 		})
 	}
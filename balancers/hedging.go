@@ -0,0 +1,60 @@
+package balancers
+
+import (
+	"time"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+)
+
+// Default settings of hedged requests
+const (
+	DefaultHedgingDelay       = 50 * time.Millisecond
+	DefaultHedgingBudgetRatio = 0.1
+	DefaultHedgingBudgetBurst = 10
+)
+
+type HedgingOption func(h *balancerConfig.Hedging)
+
+// WithHedgingDelay sets fixed delay before sending of hedged request
+func WithHedgingDelay(delay time.Duration) HedgingOption {
+	return func(h *balancerConfig.Hedging) {
+		h.Delay = delay
+	}
+}
+
+// WithHedgingPercentile sets percentile (in range (0, 1)) of observed latencies of hedgeable calls
+// which used as delay before sending of hedged request. Fixed delay used until enough latencies is observed
+func WithHedgingPercentile(percentile float64) HedgingOption {
+	return func(h *balancerConfig.Hedging) {
+		h.Percentile = percentile
+	}
+}
+
+// WithHedgingBudget sets global budget of hedged requests: ratio is a maximum ratio of hedged requests
+// to all hedgeable calls (extra load), burst is a maximum count of hedged requests which can be sent in a row
+func WithHedgingBudget(ratio float64, burst int) HedgingOption {
+	return func(h *balancerConfig.Hedging) {
+		h.BudgetRatio = ratio
+		h.BudgetBurst = burst
+	}
+}
+
+// WithHedging enables hedged requests for idempotent read calls (such as table.Session.ReadRows,
+// scheme.Client.ListDirectory and scheme.Client.DescribePath): if response is not received within delay,
+// the same request sends again to another endpoint, first received response is used and other call is cancelled.
+// Calls bound to node of session are never hedged
+func WithHedging(balancer *balancerConfig.Config, opts ...HedgingOption) *balancerConfig.Config {
+	hedging := balancerConfig.Hedging{
+		Delay:       DefaultHedgingDelay,
+		BudgetRatio: DefaultHedgingBudgetRatio,
+		BudgetBurst: DefaultHedgingBudgetBurst,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&hedging)
+		}
+	}
+	balancer.Hedging = &hedging
+
+	return balancer
+}
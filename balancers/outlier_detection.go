@@ -0,0 +1,80 @@
+package balancers
+
+import (
+	"time"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+)
+
+// Default settings of outlier detection
+const (
+	DefaultOutlierConsecutiveErrors  = 5
+	DefaultOutlierBaseEjectionTime   = 30 * time.Second
+	DefaultOutlierMaxEjectionTime    = 5 * time.Minute
+	DefaultOutlierMaxEjectionPercent = 10
+	DefaultOutlierSlowStartWindow    = 10 * time.Second
+)
+
+type OutlierDetectionOption func(o *balancerConfig.OutlierDetection)
+
+// WithOutlierConsecutiveErrors sets count of consecutive errors for ejection of endpoint.
+// Zero value disables ejection by consecutive errors
+func WithOutlierConsecutiveErrors(n int) OutlierDetectionOption {
+	return func(o *balancerConfig.OutlierDetection) {
+		o.ConsecutiveErrors = n
+	}
+}
+
+// WithOutlierFailureRate enables ejection of endpoint if share of failed calls within interval
+// reaches threshold (in range (0, 1]) and count of calls within interval is not less than minRequests
+func WithOutlierFailureRate(threshold float64, minRequests int, interval time.Duration) OutlierDetectionOption {
+	return func(o *balancerConfig.OutlierDetection) {
+		o.FailureRateThreshold = threshold
+		o.FailureRateMinRequests = minRequests
+		o.FailureRateInterval = interval
+	}
+}
+
+// WithOutlierEjectionTime sets duration of first ejection and maximum duration of ejection.
+// Every next ejection of the same endpoint doubles ejection time up to max
+func WithOutlierEjectionTime(base, max time.Duration) OutlierDetectionOption {
+	return func(o *balancerConfig.OutlierDetection) {
+		o.BaseEjectionTime = base
+		o.MaxEjectionTime = max
+	}
+}
+
+// WithOutlierMaxEjectionPercent sets maximum share of endpoints in percents which can be ejected at the same time
+func WithOutlierMaxEjectionPercent(percent int) OutlierDetectionOption {
+	return func(o *balancerConfig.OutlierDetection) {
+		o.MaxEjectionPercent = percent
+	}
+}
+
+// WithOutlierSlowStart sets duration of linear ramp-up of traffic to re-admitted endpoint.
+// Zero value disables slow start
+func WithOutlierSlowStart(window time.Duration) OutlierDetectionOption {
+	return func(o *balancerConfig.OutlierDetection) {
+		o.SlowStartWindow = window
+	}
+}
+
+// WithOutlierDetection enables ejection of endpoints with abnormal count of errors
+// from balancing with exponentially increasing ejection time and slow start of re-admitted endpoints
+func WithOutlierDetection(balancer *balancerConfig.Config, opts ...OutlierDetectionOption) *balancerConfig.Config {
+	outlierDetection := balancerConfig.OutlierDetection{
+		ConsecutiveErrors:  DefaultOutlierConsecutiveErrors,
+		BaseEjectionTime:   DefaultOutlierBaseEjectionTime,
+		MaxEjectionTime:    DefaultOutlierMaxEjectionTime,
+		MaxEjectionPercent: DefaultOutlierMaxEjectionPercent,
+		SlowStartWindow:    DefaultOutlierSlowStartWindow,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&outlierDetection)
+		}
+	}
+	balancer.OutlierDetection = &outlierDetection
+
+	return balancer
+}
@@ -0,0 +1,193 @@
+package balancers
+
+import (
+	"time"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
+)
+
+type (
+	// Policy defines algorithm of choosing connection from candidates
+	Policy = balancerConfig.Policy
+	// Candidates is a list of connections with their statistics
+	Candidates = balancerConfig.Candidates
+	// ConnStats is a snapshot of connection statistics (in-flight calls, latency, error rate and location)
+	ConnStats = balancerConfig.ConnStats
+)
+
+// WithPolicy sets custom balancing policy which defines algorithm of choosing connection
+// from endpoints selected with filter
+func WithPolicy(balancer *balancerConfig.Config, policy Policy) *balancerConfig.Config {
+	balancer.Policy = policy
+
+	return balancer
+}
+
+// LeastInFlight creates balancer which choose connection with minimal count of in-flight calls
+func LeastInFlight() *balancerConfig.Config {
+	return &balancerConfig.Config{
+		Policy: newLeastInFlight(),
+	}
+}
+
+// PowerOfTwoChoices creates balancer which choose two random connections
+// and use connection with least load (in-flight calls weighted by latency and error rate)
+func PowerOfTwoChoices() *balancerConfig.Config {
+	return &balancerConfig.Config{
+		Policy: newPowerOfTwoChoices(),
+	}
+}
+
+// LatencyWeighted creates balancer which choose random connection with probability
+// inversely proportional to connection latency
+func LatencyWeighted() *balancerConfig.Config {
+	return &balancerConfig.Config{
+		Policy: newLatencyWeighted(),
+	}
+}
+
+type leastInFlight struct {
+	rand xrand.Rand
+}
+
+func newLeastInFlight() *leastInFlight {
+	return &leastInFlight{
+		rand: xrand.New(xrand.WithLock()),
+	}
+}
+
+func (p *leastInFlight) Choose(candidates Candidates) int {
+	var (
+		chosen = -1
+		min    int64
+		ties   int
+	)
+	for i := 0; i < candidates.Len(); i++ {
+		inFlight := candidates.Stats(i).InFlight
+		switch {
+		case chosen == -1 || inFlight < min:
+			chosen, min, ties = i, inFlight, 1
+		case inFlight == min:
+			// reservoir sampling for uniform choice between connections with equal load
+			ties++
+			if p.rand.Int(ties) == 0 {
+				chosen = i
+			}
+		}
+	}
+
+	return chosen
+}
+
+func (p *leastInFlight) String() string {
+	return "LeastInFlight"
+}
+
+type powerOfTwoChoices struct {
+	rand xrand.Rand
+}
+
+func newPowerOfTwoChoices() *powerOfTwoChoices {
+	return &powerOfTwoChoices{
+		rand: xrand.New(xrand.WithLock()),
+	}
+}
+
+// cost returns load of connection as in-flight calls weighted by latency and error rate
+func cost(s ConnStats) float64 {
+	latency := float64(s.Latency)
+	if latency < float64(time.Microsecond) {
+		latency = float64(time.Microsecond)
+	}
+	errorRate := s.ErrorRate
+	if errorRate > 0.99 {
+		errorRate = 0.99
+	}
+
+	return float64(s.InFlight+1) * latency / (1 - errorRate)
+}
+
+func (p *powerOfTwoChoices) Choose(candidates Candidates) int {
+	n := candidates.Len()
+	if n < 2 {
+		return n - 1
+	}
+	i := p.rand.Int(n)
+	j := p.rand.Int(n - 1)
+	if j >= i {
+		j++
+	}
+	if cost(candidates.Stats(j)) < cost(candidates.Stats(i)) {
+		return j
+	}
+
+	return i
+}
+
+func (p *powerOfTwoChoices) String() string {
+	return "PowerOfTwoChoices"
+}
+
+type latencyWeighted struct {
+	rand xrand.Rand
+}
+
+func newLatencyWeighted() *latencyWeighted {
+	return &latencyWeighted{
+		rand: xrand.New(xrand.WithLock()),
+	}
+}
+
+func (p *latencyWeighted) Choose(candidates Candidates) int {
+	n := candidates.Len()
+	if n < 2 {
+		return n - 1
+	}
+
+	var (
+		stats      = make([]ConnStats, n)
+		minLatency time.Duration
+	)
+	for i := range stats {
+		stats[i] = candidates.Stats(i)
+		if l := stats[i].Latency; l > 0 && (minLatency == 0 || l < minLatency) {
+			minLatency = l
+		}
+	}
+	if minLatency == 0 {
+		// no statistics yet
+		return p.rand.Int(n)
+	}
+
+	var (
+		weights = make([]float64, n)
+		total   float64
+	)
+	for i, s := range stats {
+		latency := s.Latency
+		if latency == 0 {
+			// connections without statistics are considered as fastest for warming up
+			latency = minLatency
+		}
+		weights[i] = float64(minLatency) / float64(latency) * (1 - s.ErrorRate)
+		total += weights[i]
+	}
+	if total <= 0 {
+		return p.rand.Int(n)
+	}
+
+	r := float64(p.rand.Int64(1<<53)) / (1 << 53) * total
+	for i, w := range weights {
+		if r < w {
+			return i
+		}
+		r -= w
+	}
+
+	return n - 1
+}
+
+func (p *latencyWeighted) String() string {
+	return "LatencyWeighted"
+}
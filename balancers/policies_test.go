@@ -0,0 +1,81 @@
+package balancers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type candidatesMock []ConnStats
+
+func (c candidatesMock) Len() int {
+	return len(c)
+}
+
+func (c candidatesMock) Stats(i int) ConnStats {
+	return c[i]
+}
+
+func choices(p Policy, candidates Candidates, n int) map[int]int {
+	counts := make(map[int]int)
+	for i := 0; i < n; i++ {
+		counts[p.Choose(candidates)]++
+	}
+
+	return counts
+}
+
+func TestLeastInFlight(t *testing.T) {
+	p := newLeastInFlight()
+	require.Equal(t, -1, p.Choose(candidatesMock{}))
+	require.Equal(t, 1, p.Choose(candidatesMock{
+		{InFlight: 3},
+		{InFlight: 1},
+		{InFlight: 2},
+	}))
+	counts := choices(p, candidatesMock{
+		{InFlight: 1},
+		{InFlight: 5},
+		{InFlight: 1},
+	}, 1000)
+	require.Zero(t, counts[1])
+	require.Greater(t, counts[0], 300)
+	require.Greater(t, counts[2], 300)
+}
+
+func TestPowerOfTwoChoices(t *testing.T) {
+	p := newPowerOfTwoChoices()
+	require.Equal(t, -1, p.Choose(candidatesMock{}))
+	require.Equal(t, 0, p.Choose(candidatesMock{{}}))
+	require.Equal(t, 1, p.Choose(candidatesMock{
+		{Latency: 10 * time.Millisecond},
+		{Latency: time.Millisecond},
+	}))
+	require.Equal(t, 0, p.Choose(candidatesMock{
+		{Latency: time.Millisecond, InFlight: 2},
+		{Latency: time.Millisecond, ErrorRate: 0.9},
+	}))
+	// slowest connection can be chosen only if it is picked twice, which is impossible
+	counts := choices(p, candidatesMock{
+		{Latency: time.Millisecond},
+		{Latency: time.Millisecond},
+		{Latency: time.Second},
+	}, 1000)
+	require.Zero(t, counts[2])
+}
+
+func TestLatencyWeighted(t *testing.T) {
+	p := newLatencyWeighted()
+	require.Equal(t, -1, p.Choose(candidatesMock{}))
+	require.Equal(t, 0, p.Choose(candidatesMock{{}}))
+	counts := choices(p, candidatesMock{
+		{Latency: time.Millisecond},
+		{Latency: 10 * time.Millisecond},
+		{},
+	}, 10000)
+	// weights are 1 : 0.1 : 1 (connection without statistics considered as fastest)
+	require.Greater(t, counts[0], 4000)
+	require.Less(t, counts[1], 1000)
+	require.Greater(t, counts[2], 4000)
+}
@@ -12,6 +12,7 @@ import (
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -32,6 +33,9 @@ type Config struct {
 	tlsConfig      *tls.Config
 	meta           *meta.Meta
 
+	staticEndpoints                []string
+	staticEndpointsResolveInterval time.Duration
+
 	excludeGRPCCodesForPessimization []grpcCodes.Code
 }
 
@@ -103,6 +107,18 @@ func (c *Config) Balancer() *balancerConfig.Config {
 	return c.balancerConfig
 }
 
+// StaticEndpoints is an optional fixed list of endpoints for balancing without cluster discovery
+func (c *Config) StaticEndpoints() []string {
+	return c.staticEndpoints
+}
+
+// StaticEndpointsResolveInterval defines interval of DNS resolving of static endpoints hosts.
+//
+// If StaticEndpointsResolveInterval is zero - static endpoints are used as is without resolving.
+func (c *Config) StaticEndpointsResolveInterval() time.Duration {
+	return c.staticEndpointsResolveInterval
+}
+
 type Option func(c *Config)
 
 // WithInternalDNSResolver
@@ -118,6 +134,21 @@ func WithEndpoint(endpoint string) Option {
 	}
 }
 
+// WithStaticEndpoints sets fixed list of endpoints for balancing without cluster discovery
+func WithStaticEndpoints(addrs ...string) Option {
+	return func(c *Config) {
+		c.staticEndpoints = append(c.staticEndpoints, addrs...)
+	}
+}
+
+// WithStaticEndpointsResolveInterval enables resolving of static endpoints hosts into addresses
+// and periodic refresh of addresses with interval
+func WithStaticEndpointsResolveInterval(interval time.Duration) Option {
+	return func(c *Config) {
+		c.staticEndpointsResolveInterval = interval
+	}
+}
+
 // WithSecure changes secure connection flag.
 //
 // Warning: if secure is false - TLS config options has no effect.
@@ -161,6 +192,27 @@ func WithTraceRetry(t *trace.Retry, opts ...trace.RetryComposeOption) Option {
 	}
 }
 
+// WithRetryBudget applies shared retry budget to all retry calls of driver
+func WithRetryBudget(budget *retry.Budget) Option {
+	return func(c *Config) {
+		config.SetRetryBudget(&c.Common, budget)
+	}
+}
+
+// WithCircuitBreaker applies circuit breaker to all labeled retry calls of driver
+func WithCircuitBreaker(circuitBreaker *retry.CircuitBreaker) Option {
+	return func(c *Config) {
+		config.SetCircuitBreaker(&c.Common, circuitBreaker)
+	}
+}
+
+// WithErrorClassifier applies user-defined classifier of errors to all retry calls of driver
+func WithErrorClassifier(classifier func(err error) retry.Decision) Option {
+	return func(c *Config) {
+		config.SetErrorClassifier(&c.Common, classifier)
+	}
+}
+
 // WithApplicationName add provided application name to all api requests
 func WithApplicationName(applicationName string) Option {
 	return func(c *Config) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
 	"github.com/ydb-platform/ydb-go-sdk/v3/discovery"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
@@ -51,6 +52,9 @@ type Connection interface {
 	// Ratelimiter returns ratelimiter client
 	Ratelimiter() ratelimiter.Client
 
+	// Operation returns operation client
+	Operation() operation.Client
+
 	// Discovery returns discovery client
 	Discovery() discovery.Client
 
@@ -34,3 +34,79 @@ func NewStaticCredentials(
 ) *credentials.Static {
 	return credentials.NewStaticCredentials(user, password, authEndpoint, opts...)
 }
+
+// NewOAuth2TokenExchangeCredentials makes credentials with OAuth 2.0 token exchange (RFC 8693).
+// Token endpoint (see WithTokenEndpoint) and subject token (see WithSubjectToken) are required.
+// Access token refreshes in background before expiration
+func NewOAuth2TokenExchangeCredentials(
+	opts ...credentials.OAuth2TokenExchangeCredentialsOption,
+) (*credentials.OAuth2TokenExchange, error) {
+	return credentials.NewOAuth2TokenExchangeCredentials(opts...)
+}
+
+// NewServiceAccountKeyFileCredentials makes credentials from authorized key file of service account.
+// JWT signed with RSA or ECDSA private key of service account exchanges for IAM token at IAM endpoint
+// (see WithIAMEndpoint). IAM token refreshes in background before expiration
+func NewServiceAccountKeyFileCredentials(
+	path string, opts ...credentials.ServiceAccountCredentialsOption,
+) (*credentials.ServiceAccount, error) {
+	return credentials.NewServiceAccountKeyFileCredentials(path, opts...)
+}
+
+// NewServiceAccountKeyCredentials makes credentials from content of authorized key file of service account
+func NewServiceAccountKeyCredentials(
+	content []byte, opts ...credentials.ServiceAccountCredentialsOption,
+) (*credentials.ServiceAccount, error) {
+	return credentials.NewServiceAccountKeyCredentials(content, opts...)
+}
+
+// NewJWTTokenSource makes source of JWT signed with RSA or ECDSA private key for OAuth 2.0 token exchange
+func NewJWTTokenSource(opts ...credentials.JWTTokenSourceOption) (*credentials.JWTTokenSource, error) {
+	return credentials.NewJWTTokenSource(opts...)
+}
+
+// NewFixedTokenSource makes source of fixed token for OAuth 2.0 token exchange
+func NewFixedTokenSource(token, tokenType string) *credentials.FixedTokenSource {
+	return credentials.NewFixedTokenSource(token, tokenType)
+}
+
+// Chain makes chain of credentials which tries providers in order (for example, environment variables,
+// token file, metadata service and static credentials) and returns token of first provider which
+// provided non-empty token. Token of chain caches with early refresh and single-flight requests of token
+func Chain(providers ...Credentials) *credentials.Chain {
+	chain := make([]credentials.Credentials, 0, len(providers))
+	for _, provider := range providers {
+		chain = append(chain, provider)
+	}
+
+	return credentials.NewChainCredentials(chain)
+}
+
+// NewChainCredentials makes chain of credentials with options (see Chain)
+func NewChainCredentials(
+	providers []Credentials, opts ...credentials.ChainCredentialsOption,
+) *credentials.Chain {
+	chain := make([]credentials.Credentials, 0, len(providers))
+	for _, provider := range providers {
+		chain = append(chain, provider)
+	}
+
+	return credentials.NewChainCredentials(chain, opts...)
+}
+
+// NewEnvironCredentials makes credentials with access token from environment variable
+// (YDB_ACCESS_TOKEN_CREDENTIALS by default, see WithEnvironVariable)
+func NewEnvironCredentials(opts ...credentials.EnvironCredentialsOption) *credentials.Environ {
+	return credentials.NewEnvironCredentials(opts...)
+}
+
+// NewTokenFileCredentials makes credentials with token from file. Token reloads when file rotates
+// (for example, projected service account token of Kubernetes or token rendered by Vault agent)
+func NewTokenFileCredentials(path string, opts ...credentials.TokenFileCredentialsOption) *credentials.TokenFile {
+	return credentials.NewTokenFileCredentials(path, opts...)
+}
+
+// NewMetadataCredentials makes credentials with token of service account from metadata service of virtual machine
+func NewMetadataCredentials(opts ...credentials.MetadataCredentialsOption) *credentials.Metadata {
+	return credentials.NewMetadataCredentials(opts...)
+}
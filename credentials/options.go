@@ -1,11 +1,32 @@
 package credentials
 
 import (
+	"crypto"
+	"net/http"
+	"time"
+
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/credentials"
 )
 
+type (
+	// TokenSource is a source of subject or actor tokens for OAuth 2.0 token exchange
+	TokenSource = credentials.TokenSource
+	// Token is a token of token source with type of token
+	Token = credentials.Token
+
+	httpOption interface {
+		credentials.OAuth2TokenExchangeCredentialsOption
+		credentials.ServiceAccountCredentialsOption
+		credentials.MetadataCredentialsOption
+	}
+	oauth2AndJWTOption interface {
+		credentials.OAuth2TokenExchangeCredentialsOption
+		credentials.JWTTokenSourceOption
+	}
+)
+
 // WithSourceInfo option append to credentials object the source info for reporting source info details on error case
 func WithSourceInfo(sourceInfo string) credentials.SourceInfoOption {
 	return credentials.WithSourceInfo(sourceInfo)
@@ -15,3 +36,122 @@ func WithSourceInfo(sourceInfo string) credentials.SourceInfoOption {
 func WithGrpcDialOptions(opts ...grpc.DialOption) credentials.StaticCredentialsOption {
 	return credentials.WithGrpcDialOptions(opts...)
 }
+
+// WithTokenEndpoint sets URL of OAuth 2.0 token exchange endpoint
+func WithTokenEndpoint(endpoint string) credentials.OAuth2TokenExchangeCredentialsOption {
+	return credentials.WithTokenEndpoint(endpoint)
+}
+
+// WithGrantType sets grant type of token exchange request.
+// Default grant type is urn:ietf:params:oauth:grant-type:token-exchange
+func WithGrantType(grantType string) credentials.OAuth2TokenExchangeCredentialsOption {
+	return credentials.WithGrantType(grantType)
+}
+
+// WithResource sets resource of token exchange request
+func WithResource(resource string) credentials.OAuth2TokenExchangeCredentialsOption {
+	return credentials.WithResource(resource)
+}
+
+// WithScope appends scopes of token exchange request
+func WithScope(scope ...string) credentials.OAuth2TokenExchangeCredentialsOption {
+	return credentials.WithScope(scope...)
+}
+
+// WithRequestedTokenType sets type of requested token.
+// Default type is urn:ietf:params:oauth:token-type:access_token
+func WithRequestedTokenType(tokenType string) credentials.OAuth2TokenExchangeCredentialsOption {
+	return credentials.WithRequestedTokenType(tokenType)
+}
+
+// WithSubjectToken sets source of subject token for token exchange
+func WithSubjectToken(source TokenSource) credentials.OAuth2TokenExchangeCredentialsOption {
+	return credentials.WithSubjectToken(source)
+}
+
+// WithActorToken sets source of actor token for token exchange
+func WithActorToken(source TokenSource) credentials.OAuth2TokenExchangeCredentialsOption {
+	return credentials.WithActorToken(source)
+}
+
+// WithAudience appends audience of token exchange request or audience claim of JWT
+func WithAudience(audience ...string) oauth2AndJWTOption {
+	return credentials.WithAudience(audience...)
+}
+
+// WithHTTPClient sets HTTP client for requests to token endpoint
+func WithHTTPClient(client *http.Client) httpOption {
+	return credentials.WithHTTPClient(client)
+}
+
+// WithRequestTimeout sets timeout of request to token endpoint
+func WithRequestTimeout(timeout time.Duration) httpOption {
+	return credentials.WithRequestTimeout(timeout)
+}
+
+// WithIAMEndpoint sets URL of IAM endpoint for exchange of JWT for IAM token
+func WithIAMEndpoint(endpoint string) credentials.ServiceAccountCredentialsOption {
+	return credentials.WithIAMEndpoint(endpoint)
+}
+
+// WithSigningMethod sets JWT signing method (such as RS256, PS256 or ES256).
+// By default, signing method is chosen by type of private key
+func WithSigningMethod(method string) credentials.JWTTokenSourceOption {
+	return credentials.WithSigningMethod(method)
+}
+
+// WithKeyID sets key ID (kid) header of JWT
+func WithKeyID(keyID string) credentials.JWTTokenSourceOption {
+	return credentials.WithKeyID(keyID)
+}
+
+// WithPrivateKey sets RSA or ECDSA private key for signing of JWT
+func WithPrivateKey(key crypto.Signer) credentials.JWTTokenSourceOption {
+	return credentials.WithPrivateKey(key)
+}
+
+// WithPrivateKeyPEM sets RSA or ECDSA private key in PEM encoding for signing of JWT
+func WithPrivateKeyPEM(content []byte) credentials.JWTTokenSourceOption {
+	return credentials.WithPrivateKeyPEM(content)
+}
+
+// WithIssuer sets issuer claim of JWT
+func WithIssuer(issuer string) credentials.JWTTokenSourceOption {
+	return credentials.WithIssuer(issuer)
+}
+
+// WithSubject sets subject claim of JWT
+func WithSubject(subject string) credentials.JWTTokenSourceOption {
+	return credentials.WithSubject(subject)
+}
+
+// WithID sets JWT ID claim
+func WithID(id string) credentials.JWTTokenSourceOption {
+	return credentials.WithID(id)
+}
+
+// WithTokenTTL sets lifetime of JWT. Default lifetime is one hour
+func WithTokenTTL(ttl time.Duration) credentials.JWTTokenSourceOption {
+	return credentials.WithTokenTTL(ttl)
+}
+
+// WithCacheTTL sets lifetime of cached token of credentials chain. Default lifetime is one minute
+func WithCacheTTL(ttl time.Duration) credentials.ChainCredentialsOption {
+	return credentials.WithCacheTTL(ttl)
+}
+
+// WithEnvironVariable sets name of environment variable with access token.
+// Default name is YDB_ACCESS_TOKEN_CREDENTIALS
+func WithEnvironVariable(variable string) credentials.EnvironCredentialsOption {
+	return credentials.WithEnvironVariable(variable)
+}
+
+// WithPollInterval sets interval of checks of token file rotation. Default interval is 10 seconds
+func WithPollInterval(interval time.Duration) credentials.TokenFileCredentialsOption {
+	return credentials.WithPollInterval(interval)
+}
+
+// WithMetadataURL sets URL of token in metadata service
+func WithMetadataURL(url string) credentials.MetadataCredentialsOption {
+	return credentials.WithMetadataURL(url)
+}
@@ -0,0 +1,151 @@
+package decimal
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"fmt"
+	"strconv"
+
+	internalDecimal "github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	_ value.ValueConverter     = Decimal{}
+	_ value.ValueScanner       = (*Decimal)(nil)
+	_ scanner.Scanner          = (*Decimal)(nil)
+	_ sql.Scanner              = (*Decimal)(nil)
+	_ driver.Valuer            = Decimal{}
+	_ encoding.TextMarshaler   = Decimal{}
+	_ encoding.TextUnmarshaler = (*Decimal)(nil)
+)
+
+// parseAny parses decimal with scale of textual representation and maximum precision
+func parseAny(s string) (Decimal, error) {
+	unscaled, scale, err := parse(s)
+	if err != nil {
+		return Decimal{}, err
+	}
+	if scale > MaxPrecision {
+		return Decimal{}, xerrors.WithStackTrace(fmt.Errorf("%w: %q", ErrOverflow, s))
+	}
+
+	return makeDecimal(unscaled, MaxPrecision, scale)
+}
+
+func fromInternal(d internalDecimal.Decimal) (Decimal, error) {
+	unscaled := internalDecimal.FromInt128(d.Bytes, d.Precision, d.Scale)
+	if internalDecimal.IsInf(unscaled) || internalDecimal.IsNaN(unscaled) {
+		return Decimal{}, xerrors.WithStackTrace(fmt.Errorf("%w: %s",
+			ErrOverflow, internalDecimal.Format(unscaled, d.Precision, d.Scale),
+		))
+	}
+
+	return makeDecimal(unscaled, d.Precision, d.Scale)
+}
+
+// ToYDBValue converts decimal into YDB value of type Decimal(precision,scale)
+func (d Decimal) ToYDBValue() (value.Value, error) {
+	d = d.norm()
+
+	return value.DecimalValueFromBigInt(d.unscaled, d.precision, d.scale), nil
+}
+
+// ScanYDBValue fills decimal from YDB value. Decimal values keep its precision and scale,
+// values of other types (integers, strings) are parsed with scale of textual representation
+// and maximum precision
+func (d *Decimal) ScanYDBValue(v value.Value) error {
+	if valuer, ok := v.(value.DecimalValuer); ok {
+		dd, err := fromInternal(internalDecimal.Decimal{
+			Bytes:     valuer.Value(),
+			Precision: valuer.Precision(),
+			Scale:     valuer.Scale(),
+		})
+		if err != nil {
+			return err
+		}
+		*d = dd
+
+		return nil
+	}
+	var s string
+	if err := value.CastTo(v, &s); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	dd, err := parseAny(s)
+	if err != nil {
+		return err
+	}
+	*d = dd
+
+	return nil
+}
+
+// UnmarshalYDB implements scanner of table service results
+func (d *Decimal) UnmarshalYDB(raw scanner.RawValue) error {
+	return d.Scan(raw.Any())
+}
+
+// Scan implements sql.Scanner. NULL cannot be scanned into Decimal (use *Decimal for nullable columns)
+func (d *Decimal) Scan(src interface{}) error {
+	var (
+		dd  Decimal
+		err error
+	)
+	switch v := src.(type) {
+	case nil:
+		return xerrors.WithStackTrace(fmt.Errorf("decimal: cannot scan NULL into %T", d))
+	case Decimal:
+		dd = v
+	case *Decimal:
+		dd = *v
+	case value.Value:
+		return d.ScanYDBValue(v)
+	case internalDecimal.Decimal:
+		dd, err = fromInternal(v)
+	case *internalDecimal.Decimal:
+		dd, err = fromInternal(*v)
+	case string:
+		dd, err = parseAny(v)
+	case []byte:
+		dd, err = parseAny(string(v))
+	case int64:
+		dd, err = parseAny(strconv.FormatInt(v, 10))
+	case float64:
+		dd, err = parseAny(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("decimal: cannot scan %T into %T", src, d))
+	}
+	if err != nil {
+		return err
+	}
+	*d = dd
+
+	return nil
+}
+
+// Value implements driver.Valuer. Decimal is passed as exact string representation
+// (ydb driver of database/sql binds Decimal as YDB value of type Decimal(precision,scale))
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// MarshalText implements encoding.TextMarshaler (such as JSON string "123.45")
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Decimal is parsed with scale
+// of textual representation and maximum precision (use Rescale for exact type)
+func (d *Decimal) UnmarshalText(text []byte) error {
+	dd, err := parseAny(string(text))
+	if err != nil {
+		return err
+	}
+	*d = dd
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package decimal
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	internalDecimal "github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestYDBValue(t *testing.T) {
+	d := MustParse("-123.456789012", 22, 9)
+	v, err := d.ToYDBValue()
+	require.NoError(t, err)
+	require.Equal(t, `Decimal("-123.456789012",22,9)`, v.Yql())
+
+	t.Run("ScanYDBValue", func(t *testing.T) {
+		var dst Decimal
+		require.NoError(t, dst.ScanYDBValue(v))
+		require.Equal(t, d, dst)
+	})
+	t.Run("CastTo", func(t *testing.T) {
+		var dst Decimal
+		require.NoError(t, value.CastTo(value.OptionalValue(v), &dst))
+		require.Equal(t, d.String(), dst.String())
+		var ptr *Decimal
+		require.NoError(t, value.CastTo(value.OptionalValue(v), &ptr))
+		require.Equal(t, d.String(), ptr.String())
+		require.NoError(t, value.CastTo(value.NullValue(types.NewDecimal(22, 9)), &ptr))
+		require.Nil(t, ptr)
+		require.Error(t, value.CastTo(value.NullValue(types.NewDecimal(22, 9)), &dst))
+	})
+	t.Run("FromGoToGo", func(t *testing.T) {
+		type row struct {
+			Amount  Decimal  `sql:"amount"`
+			Comment *Decimal `sql:"comment"`
+		}
+		v, err := value.FromGo(row{Amount: d})
+		require.NoError(t, err)
+		require.Equal(t, "Struct<'amount':Decimal(22,9),'comment':Optional<Decimal(22,9)>>", v.Type().Yql())
+		var dst row
+		require.NoError(t, value.ToGo(v, &dst))
+		require.Equal(t, row{Amount: d}, dst)
+	})
+	t.Run("Text", func(t *testing.T) {
+		var dst Decimal
+		require.NoError(t, dst.ScanYDBValue(value.TextValue("1.25")))
+		require.Equal(t, "1.25", dst.String())
+		require.EqualValues(t, MaxPrecision, dst.Precision())
+	})
+	t.Run("Inf", func(t *testing.T) {
+		var dst Decimal
+		err := dst.ScanYDBValue(value.DecimalValueFromBigInt(internalDecimal.Inf(), 22, 9))
+		require.ErrorIs(t, err, ErrOverflow)
+	})
+}
+
+func TestSQL(t *testing.T) {
+	d := MustParse("12.50", 22, 2)
+	v, err := d.Value()
+	require.NoError(t, err)
+	require.Equal(t, "12.50", v)
+	for _, tt := range []struct {
+		src interface{}
+		exp string
+	}{
+		{src: "12.50", exp: "12.50"},
+		{src: []byte("12.5"), exp: "12.5"},
+		{src: int64(12), exp: "12"},
+		{src: float64(12.5), exp: "12.5"},
+		{src: d, exp: "12.50"},
+		{src: &d, exp: "12.50"},
+		{
+			src: internalDecimal.Decimal{
+				Bytes:     internalDecimal.BigIntToByte(big.NewInt(1250), 22, 2),
+				Precision: 22,
+				Scale:     2,
+			},
+			exp: "12.50",
+		},
+		{src: value.DecimalValueFromBigInt(big.NewInt(1250), 22, 2), exp: "12.50"},
+	} {
+		var dst Decimal
+		require.NoError(t, dst.Scan(tt.src))
+		require.Equal(t, tt.exp, dst.String())
+	}
+	var dst Decimal
+	require.Error(t, dst.Scan(nil))
+	require.Error(t, dst.Scan(true))
+}
+
+func TestJSON(t *testing.T) {
+	type payment struct {
+		Amount Decimal `json:"amount"`
+	}
+	b, err := json.Marshal(payment{Amount: MustParse("12.50", 22, 2)})
+	require.NoError(t, err)
+	require.Equal(t, `{"amount":"12.50"}`, string(b))
+	var p payment
+	require.NoError(t, json.Unmarshal(b, &p))
+	require.Equal(t, "12.50", p.Amount.String())
+}
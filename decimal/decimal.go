@@ -0,0 +1,343 @@
+// Package decimal provides exact decimal numbers for columns of YDB type Decimal(precision,scale)
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	// MaxPrecision is a maximum precision of YDB decimal type
+	MaxPrecision = 35
+
+	// DefaultPrecision and DefaultScale are precision and scale of zero value of Decimal
+	// (the same as YDB type Decimal(22,9))
+	DefaultPrecision = 22
+	DefaultScale     = 9
+)
+
+var (
+	ErrSyntax         = errors.New("decimal: invalid syntax")
+	ErrOverflow       = errors.New("decimal: value overflows precision")
+	ErrInexact        = errors.New("decimal: value is not representable without rounding")
+	ErrDivisionByZero = errors.New("decimal: division by zero")
+	ErrPrecision      = errors.New("decimal: invalid precision or scale")
+)
+
+// Decimal is an exact decimal number with precision (maximum number of digits) and scale (number
+// of digits after decimal point) of YDB type Decimal(precision,scale).
+//
+// Decimal is immutable: arithmetic methods return new values. The zero value is 0 of type Decimal(22,9)
+type Decimal struct {
+	// unscaled is a value multiplied by 10^scale, nil means zero
+	unscaled  *big.Int
+	precision uint32
+	scale     uint32
+}
+
+var (
+	bigTen  = big.NewInt(10)
+	bigZero = big.NewInt(0)
+)
+
+func pow10(n uint32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func checkPrecision(precision, scale uint32) error {
+	if precision == 0 || precision > MaxPrecision || scale > precision {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: Decimal(%d,%d)", ErrPrecision, precision, scale))
+	}
+
+	return nil
+}
+
+// makeDecimal checks number of digits of unscaled value and makes decimal
+func makeDecimal(unscaled *big.Int, precision, scale uint32) (Decimal, error) {
+	if err := checkPrecision(precision, scale); err != nil {
+		return Decimal{}, err
+	}
+	if new(big.Int).Abs(unscaled).Cmp(pow10(precision)) >= 0 {
+		return Decimal{}, xerrors.WithStackTrace(fmt.Errorf("%w: %s with scale %d into Decimal(%d,%d)",
+			ErrOverflow, unscaled, scale, precision, scale,
+		))
+	}
+
+	return Decimal{
+		unscaled:  unscaled,
+		precision: precision,
+		scale:     scale,
+	}, nil
+}
+
+// New makes decimal from unscaled value (value multiplied by 10^scale).
+// For example New(big.NewInt(12345), 22, 2) is 123.45 of type Decimal(22,2)
+func New(unscaled *big.Int, precision, scale uint32) (Decimal, error) {
+	return makeDecimal(new(big.Int).Set(unscaled), precision, scale)
+}
+
+// FromInt64 makes decimal with integer value v
+func FromInt64(v int64, precision, scale uint32) (Decimal, error) {
+	if err := checkPrecision(precision, scale); err != nil {
+		return Decimal{}, err
+	}
+
+	return makeDecimal(new(big.Int).Mul(big.NewInt(v), pow10(scale)), precision, scale)
+}
+
+// FromRat makes decimal from rational number with rounding to scale by given rounding mode
+func FromRat(r *big.Rat, precision, scale uint32, mode RoundingMode) (Decimal, error) {
+	if err := checkPrecision(precision, scale); err != nil {
+		return Decimal{}, err
+	}
+	num := new(big.Int).Mul(r.Num(), pow10(scale))
+	unscaled, err := divRound(num, r.Denom(), mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return makeDecimal(unscaled, precision, scale)
+}
+
+// Parse parses decimal number (such as "-123.45") into value of type Decimal(precision,scale).
+// Parse is exact: it returns ErrInexact if s has more significant digits after decimal point than scale
+// and ErrOverflow if value has more than precision-scale digits before decimal point
+func Parse(s string, precision, scale uint32) (Decimal, error) {
+	if err := checkPrecision(precision, scale); err != nil {
+		return Decimal{}, err
+	}
+	unscaled, sScale, err := parse(s)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	d := Decimal{
+		unscaled:  unscaled,
+		precision: MaxPrecision,
+		scale:     sScale,
+	}
+
+	return d.Rescale(precision, scale, RoundUnnecessary)
+}
+
+// MustParse is like Parse but panics on error. It simplifies initialization of constants
+func MustParse(s string, precision, scale uint32) Decimal {
+	d, err := Parse(s, precision, scale)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// parse parses decimal number into unscaled value and scale (number of digits after decimal point)
+func parse(s string) (unscaled *big.Int, scale uint32, _ error) {
+	src := s
+	s = strings.TrimSpace(s)
+	neg := strings.HasPrefix(s, "-")
+	if neg || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	integral, fraction, _ := strings.Cut(s, ".")
+	if integral == "" && fraction == "" {
+		return nil, 0, xerrors.WithStackTrace(fmt.Errorf("%w: %q", ErrSyntax, src))
+	}
+	for _, part := range []string{integral, fraction} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return nil, 0, xerrors.WithStackTrace(fmt.Errorf("%w: %q", ErrSyntax, src))
+			}
+		}
+	}
+	unscaled, _ = new(big.Int).SetString(integral+fraction, 10)
+	if unscaled == nil {
+		unscaled = new(big.Int)
+	}
+	if neg {
+		unscaled.Neg(unscaled)
+	}
+
+	return unscaled, uint32(len(fraction)), nil
+}
+
+func (d Decimal) norm() Decimal {
+	if d.precision == 0 {
+		return Decimal{
+			unscaled:  bigZero,
+			precision: DefaultPrecision,
+			scale:     DefaultScale,
+		}
+	}
+
+	return d
+}
+
+// Precision returns maximum number of digits of decimal
+func (d Decimal) Precision() uint32 {
+	return d.norm().precision
+}
+
+// Scale returns number of digits after decimal point
+func (d Decimal) Scale() uint32 {
+	return d.norm().scale
+}
+
+// BigInt returns unscaled value of decimal (value multiplied by 10^scale)
+func (d Decimal) BigInt() *big.Int {
+	return new(big.Int).Set(d.norm().unscaled)
+}
+
+// Rat returns value of decimal as rational number
+func (d Decimal) Rat() *big.Rat {
+	d = d.norm()
+
+	return new(big.Rat).SetFrac(d.unscaled, pow10(d.scale))
+}
+
+// String returns exact representation of decimal with scale digits after decimal point
+// (for example "-123.450" for -123.45 of type Decimal(22,3))
+func (d Decimal) String() string {
+	d = d.norm()
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	var sb strings.Builder
+	if d.unscaled.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+	sb.WriteString(digits[:len(digits)-int(d.scale)])
+	if d.scale > 0 {
+		sb.WriteByte('.')
+		sb.WriteString(digits[len(digits)-int(d.scale):])
+	}
+
+	return sb.String()
+}
+
+// Sign returns -1, 0 or +1 depending on sign of decimal
+func (d Decimal) Sign() int {
+	return d.norm().unscaled.Sign()
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	d = d.norm()
+	d.unscaled = new(big.Int).Neg(d.unscaled)
+
+	return d
+}
+
+// Abs returns |d|
+func (d Decimal) Abs() Decimal {
+	d = d.norm()
+	d.unscaled = new(big.Int).Abs(d.unscaled)
+
+	return d
+}
+
+// Cmp compares values of decimals (regardless of precision and scale) and returns -1, 0 or +1
+func (d Decimal) Cmp(y Decimal) int {
+	xu, yu, _ := align(d, y)
+
+	return xu.Cmp(yu)
+}
+
+// align returns unscaled values of decimals with common (maximum) scale
+func align(x, y Decimal) (xu, yu *big.Int, scale uint32) {
+	x, y = x.norm(), y.norm()
+	switch {
+	case x.scale < y.scale:
+		return new(big.Int).Mul(x.unscaled, pow10(y.scale-x.scale)), y.unscaled, y.scale
+	case x.scale > y.scale:
+		return x.unscaled, new(big.Int).Mul(y.unscaled, pow10(x.scale-y.scale)), x.scale
+	default:
+		return x.unscaled, y.unscaled, x.scale
+	}
+}
+
+// resultPrecision returns precision of result of arithmetic operation
+// (maximum of precisions of operands)
+func resultPrecision(x, y Decimal) uint32 {
+	if p := y.Precision(); p > x.Precision() {
+		return p
+	}
+
+	return x.Precision()
+}
+
+// Add returns d+y. Scale of result is the maximum of scales of operands, precision of result
+// is the maximum of precisions of operands. Add returns ErrOverflow if sum overflows precision
+func (d Decimal) Add(y Decimal) (Decimal, error) {
+	xu, yu, scale := align(d, y)
+
+	return makeDecimal(new(big.Int).Add(xu, yu), resultPrecision(d, y), scale)
+}
+
+// Sub returns d-y with precision and scale such as Add
+func (d Decimal) Sub(y Decimal) (Decimal, error) {
+	xu, yu, scale := align(d, y)
+
+	return makeDecimal(new(big.Int).Sub(xu, yu), resultPrecision(d, y), scale)
+}
+
+// Mul returns d*y rounded by given rounding mode to the maximum of scales of operands
+// with the maximum of precisions of operands
+func (d Decimal) Mul(y Decimal, mode RoundingMode) (Decimal, error) {
+	x, y := d.norm(), y.norm()
+	scale := x.scale
+	if y.scale > scale {
+		scale = y.scale
+	}
+	product := new(big.Int).Mul(x.unscaled, y.unscaled)
+	unscaled, err := divRound(product, pow10(x.scale+y.scale-scale), mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return makeDecimal(unscaled, resultPrecision(x, y), scale)
+}
+
+// Quo returns d/y rounded by given rounding mode to the maximum of scales of operands
+// with the maximum of precisions of operands
+func (d Decimal) Quo(y Decimal, mode RoundingMode) (Decimal, error) {
+	x, y := d.norm(), y.norm()
+	if y.unscaled.Sign() == 0 {
+		return Decimal{}, xerrors.WithStackTrace(ErrDivisionByZero)
+	}
+	scale := x.scale
+	if y.scale > scale {
+		scale = y.scale
+	}
+	// x/y = (xu / 10^xs) / (yu / 10^ys) = xu * 10^(ys+scale-xs) / yu / 10^scale
+	num := new(big.Int).Mul(x.unscaled, pow10(y.scale+scale))
+	den := new(big.Int).Mul(y.unscaled, pow10(x.scale))
+	unscaled, err := divRound(num, den, mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return makeDecimal(unscaled, resultPrecision(x, y), scale)
+}
+
+// Rescale converts decimal into type Decimal(precision,scale) with rounding by given rounding mode
+// if scale decreases. Rescale returns ErrOverflow if value does not fit into precision
+func (d Decimal) Rescale(precision, scale uint32, mode RoundingMode) (Decimal, error) {
+	if err := checkPrecision(precision, scale); err != nil {
+		return Decimal{}, err
+	}
+	d = d.norm()
+	if scale >= d.scale {
+		return makeDecimal(new(big.Int).Mul(d.unscaled, pow10(scale-d.scale)), precision, scale)
+	}
+	unscaled, err := divRound(d.unscaled, pow10(d.scale-scale), mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return makeDecimal(unscaled, precision, scale)
+}
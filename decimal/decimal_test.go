@@ -0,0 +1,178 @@
+package decimal
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseString(t *testing.T) {
+	for _, tt := range []struct {
+		s         string
+		precision uint32
+		scale     uint32
+		exp       string
+	}{
+		{s: "0", precision: 22, scale: 9, exp: "0.000000000"},
+		{s: "-123.45", precision: 22, scale: 9, exp: "-123.450000000"},
+		{s: "+0.000000001", precision: 22, scale: 9, exp: "0.000000001"},
+		{s: ".5", precision: 1, scale: 1, exp: "0.5"},
+		{s: "1.", precision: 1, scale: 0, exp: "1"},
+		{s: "1.2300", precision: 3, scale: 2, exp: "1.23"},
+		{s: "9999999999999.999999999", precision: 22, scale: 9, exp: "9999999999999.999999999"},
+		{
+			s:         "-99999999999999999999999999999999999",
+			precision: 35,
+			scale:     0,
+			exp:       "-99999999999999999999999999999999999",
+		},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			d, err := Parse(tt.s, tt.precision, tt.scale)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, d.String())
+			require.Equal(t, tt.precision, d.Precision())
+			require.Equal(t, tt.scale, d.Scale())
+			again, err := Parse(d.String(), tt.precision, tt.scale)
+			require.NoError(t, err)
+			require.Equal(t, 0, d.Cmp(again))
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tt := range []struct {
+		s         string
+		precision uint32
+		scale     uint32
+		err       error
+	}{
+		{s: "", precision: 22, scale: 9, err: ErrSyntax},
+		{s: ".", precision: 22, scale: 9, err: ErrSyntax},
+		{s: "1e5", precision: 22, scale: 9, err: ErrSyntax},
+		{s: "1.2.3", precision: 22, scale: 9, err: ErrSyntax},
+		{s: "inf", precision: 22, scale: 9, err: ErrSyntax},
+		{s: "1.001", precision: 22, scale: 2, err: ErrInexact},
+		{s: "10000000000000", precision: 22, scale: 9, err: ErrOverflow},
+		{s: "1", precision: 0, scale: 0, err: ErrPrecision},
+		{s: "1", precision: 36, scale: 0, err: ErrPrecision},
+		{s: "1", precision: 2, scale: 3, err: ErrPrecision},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			_, err := Parse(tt.s, tt.precision, tt.scale)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var d Decimal
+	require.Equal(t, "0.000000000", d.String())
+	require.EqualValues(t, DefaultPrecision, d.Precision())
+	require.EqualValues(t, DefaultScale, d.Scale())
+	require.Equal(t, 0, d.Sign())
+	sum, err := d.Add(MustParse("1.5", 10, 1))
+	require.NoError(t, err)
+	require.Equal(t, "1.500000000", sum.String())
+}
+
+func TestArithmetic(t *testing.T) {
+	a := MustParse("10.25", 22, 2)
+	b := MustParse("-3.125", 10, 3)
+	for _, tt := range []struct {
+		name string
+		f    func() (Decimal, error)
+		exp  string
+	}{
+		{name: "Add", f: func() (Decimal, error) { return a.Add(b) }, exp: "7.125"},
+		{name: "Sub", f: func() (Decimal, error) { return a.Sub(b) }, exp: "13.375"},
+		{name: "Mul", f: func() (Decimal, error) { return a.Mul(b, RoundHalfEven) }, exp: "-32.031"},
+		{name: "MulUp", f: func() (Decimal, error) { return a.Mul(b, RoundUp) }, exp: "-32.032"},
+		{name: "Quo", f: func() (Decimal, error) { return a.Quo(b, RoundHalfEven) }, exp: "-3.280"},
+		{
+			name: "QuoThird",
+			f: func() (Decimal, error) {
+				return MustParse("1", 22, 9).Quo(MustParse("3", 22, 0), RoundHalfEven)
+			},
+			exp: "0.333333333",
+		},
+		{name: "Neg", f: func() (Decimal, error) { return b.Neg(), nil }, exp: "3.125"},
+		{name: "Abs", f: func() (Decimal, error) { return b.Abs(), nil }, exp: "3.125"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := tt.f()
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, d.String())
+		})
+	}
+	t.Run("Cmp", func(t *testing.T) {
+		require.Equal(t, 1, a.Cmp(b))
+		require.Equal(t, -1, b.Cmp(a))
+		require.Equal(t, 0, MustParse("1.5", 2, 1).Cmp(MustParse("1.500", 10, 3)))
+	})
+	t.Run("Overflow", func(t *testing.T) {
+		_, err := MustParse("99.9", 3, 1).Add(MustParse("0.1", 3, 1))
+		require.ErrorIs(t, err, ErrOverflow)
+	})
+	t.Run("DivisionByZero", func(t *testing.T) {
+		_, err := a.Quo(Decimal{}, RoundHalfEven)
+		require.ErrorIs(t, err, ErrDivisionByZero)
+	})
+}
+
+func TestRescale(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		mode RoundingMode
+		exp  string
+	}{
+		{s: "2.5", mode: RoundHalfEven, exp: "2"},
+		{s: "3.5", mode: RoundHalfEven, exp: "4"},
+		{s: "-2.5", mode: RoundHalfEven, exp: "-2"},
+		{s: "2.5", mode: RoundHalfUp, exp: "3"},
+		{s: "-2.5", mode: RoundHalfUp, exp: "-3"},
+		{s: "2.5", mode: RoundHalfDown, exp: "2"},
+		{s: "2.51", mode: RoundHalfDown, exp: "3"},
+		{s: "2.1", mode: RoundUp, exp: "3"},
+		{s: "-2.1", mode: RoundUp, exp: "-3"},
+		{s: "2.9", mode: RoundDown, exp: "2"},
+		{s: "-2.9", mode: RoundDown, exp: "-2"},
+		{s: "-2.1", mode: RoundCeiling, exp: "-2"},
+		{s: "2.1", mode: RoundCeiling, exp: "3"},
+		{s: "-2.1", mode: RoundFloor, exp: "-3"},
+		{s: "2.9", mode: RoundFloor, exp: "2"},
+		{s: "2.0", mode: RoundUnnecessary, exp: "2"},
+	} {
+		t.Run(tt.mode.String()+"("+tt.s+")", func(t *testing.T) {
+			d, err := MustParse(tt.s, 10, 2).Rescale(10, 0, tt.mode)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, d.String())
+		})
+	}
+	t.Run("Inexact", func(t *testing.T) {
+		_, err := MustParse("2.1", 10, 1).Rescale(10, 0, RoundUnnecessary)
+		require.ErrorIs(t, err, ErrInexact)
+	})
+	t.Run("Widen", func(t *testing.T) {
+		d, err := MustParse("2.1", 10, 1).Rescale(22, 9, RoundUnnecessary)
+		require.NoError(t, err)
+		require.Equal(t, "2.100000000", d.String())
+	})
+}
+
+func TestBigConversions(t *testing.T) {
+	d, err := New(big.NewInt(-12345), 22, 2)
+	require.NoError(t, err)
+	require.Equal(t, "-123.45", d.String())
+	require.Equal(t, big.NewInt(-12345), d.BigInt())
+	require.Equal(t, big.NewRat(-2469, 20), d.Rat())
+	fromRat, err := FromRat(big.NewRat(2, 3), 22, 9, RoundHalfEven)
+	require.NoError(t, err)
+	require.Equal(t, "0.666666667", fromRat.String())
+	fromInt, err := FromInt64(-7, 5, 2)
+	require.NoError(t, err)
+	require.Equal(t, "-7.00", fromInt.String())
+	_, err = FromInt64(1000, 5, 2)
+	require.ErrorIs(t, err, ErrOverflow)
+}
@@ -0,0 +1,91 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// RoundingMode defines rounding of digits which do not fit into scale of decimal
+type RoundingMode uint8
+
+const (
+	// RoundHalfEven rounds to nearest, ties to even (banker's rounding, as YDB does)
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to nearest, ties away from zero
+	RoundHalfUp
+	// RoundHalfDown rounds to nearest, ties towards zero
+	RoundHalfDown
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundDown rounds towards zero (truncates)
+	RoundDown
+	// RoundCeiling rounds towards positive infinity
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity
+	RoundFloor
+	// RoundUnnecessary returns ErrInexact if rounding is required
+	RoundUnnecessary
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfEven:
+		return "RoundHalfEven"
+	case RoundHalfUp:
+		return "RoundHalfUp"
+	case RoundHalfDown:
+		return "RoundHalfDown"
+	case RoundUp:
+		return "RoundUp"
+	case RoundDown:
+		return "RoundDown"
+	case RoundCeiling:
+		return "RoundCeiling"
+	case RoundFloor:
+		return "RoundFloor"
+	case RoundUnnecessary:
+		return "RoundUnnecessary"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", uint8(m))
+	}
+}
+
+// divRound returns num/den rounded to integer by rounding mode (den must be non-zero)
+func divRound(num, den *big.Int, mode RoundingMode) (*big.Int, error) {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q, nil
+	}
+	// sign of exact quotient
+	sign := num.Sign() * den.Sign()
+	// comparison of |remainder| with |den|/2: -1 less than half, 0 exactly half, +1 more than half
+	half := new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2)).CmpAbs(den)
+	var awayFromZero bool
+	switch mode {
+	case RoundHalfEven:
+		awayFromZero = half > 0 || half == 0 && q.Bit(0) == 1
+	case RoundHalfUp:
+		awayFromZero = half >= 0
+	case RoundHalfDown:
+		awayFromZero = half > 0
+	case RoundUp:
+		awayFromZero = true
+	case RoundDown:
+		awayFromZero = false
+	case RoundCeiling:
+		awayFromZero = sign > 0
+	case RoundFloor:
+		awayFromZero = sign < 0
+	case RoundUnnecessary:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s/%s", ErrInexact, num, den))
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("decimal: unknown rounding mode %s", mode))
+	}
+	if awayFromZero {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+
+	return q, nil
+}
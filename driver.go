@@ -20,6 +20,8 @@ import (
 	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	internalOperation "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/client"
+	operationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
 	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
 	queryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	internalRatelimiter "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter"
@@ -37,6 +39,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
@@ -86,6 +89,9 @@ type Driver struct {
 	ratelimiter        *internalRatelimiter.Client
 	ratelimiterOptions []ratelimiterConfig.Option
 
+	operation        *internalOperation.Client
+	operationOptions []operationConfig.Option
+
 	topic        *topicclientinternal.Client
 	topicOptions []topicoptions.TopicOption
 
@@ -142,6 +148,7 @@ func (d *Driver) Close(ctx context.Context) (finalErr error) {
 
 	closes = append(
 		closes,
+		d.operation.Close,
 		d.ratelimiter.Close,
 		d.coordination.Close,
 		d.scheme.Close,
@@ -211,6 +218,11 @@ func (d *Driver) Ratelimiter() ratelimiter.Client {
 	return d.ratelimiter
 }
 
+// Operation returns operation client
+func (d *Driver) Operation() operation.Client {
+	return d.operation
+}
+
 // Discovery returns discovery client
 func (d *Driver) Discovery() discovery.Client {
 	return d.discovery
@@ -373,6 +385,10 @@ func newConnectionFromOptions(ctx context.Context, opts ...Option) (_ *Driver, e
 
 //nolint:cyclop, nonamedreturns, funlen
 func (d *Driver) connect(ctx context.Context) (err error) {
+	if addrs := d.config.StaticEndpoints(); d.config.Endpoint() == "" && len(addrs) > 0 {
+		d.config = d.config.With(config.WithEndpoint(addrs[0]))
+	}
+
 	if d.config.Endpoint() == "" {
 		return xerrors.WithStackTrace(errors.New("configuration: empty dial address")) //nolint:goerr113
 	}
@@ -481,6 +497,22 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 		return xerrors.WithStackTrace(err)
 	}
 
+	d.operation, err = internalOperation.New(ctx,
+		d.balancer,
+		operationConfig.New(
+			append(
+				// prepend common params from root config
+				[]operationConfig.Option{
+					operationConfig.With(d.config.Common),
+				},
+				d.operationOptions...,
+			)...,
+		),
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
 	d.discovery, err = internalDiscovery.New(ctx,
 		d.pool.Get(endpoint.New(d.config.Endpoint())),
 		discoveryConfig.New(
@@ -11,6 +11,7 @@ require (
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
 	google.golang.org/grpc v1.57.1
 	google.golang.org/protobuf v1.33.0
+	gopkg.in/yaml.v3 v3.0.0
 )
 
 // requires for tests only
@@ -28,5 +29,4 @@ require (
 	golang.org/x/sys v0.12.0 // indirect
 	golang.org/x/text v0.13.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20230525234030-28d5490b6b19 // indirect
-	gopkg.in/yaml.v3 v3.0.0 // indirect
 )
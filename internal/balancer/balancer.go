@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
@@ -43,6 +45,18 @@ type Balancer struct {
 	mu               xsync.RWMutex
 	connectionsState *connectionsState
 
+	// stats is a statistics of calls over connections. Stats is nil if not used by balancing policy
+	stats *connsStats
+
+	// outliers is an outlier detector. Outliers is nil if outlier detection is not configured
+	outliers *outlierDetector
+
+	// hedging is a state of hedged requests. Hedging is nil if hedging is not configured
+	hedging *hedging
+
+	// breakers is a set of circuit breakers of connections. Breakers is nil if circuit breaker is not configured
+	breakers *endpointBreakers
+
 	onApplyDiscoveredEndpoints []func(ctx context.Context, endpoints []endpoint.Info)
 }
 
@@ -89,6 +103,8 @@ func (b *Balancer) clusterDiscovery(ctx context.Context) (err error) {
 		},
 		retry.WithIdempotent(true),
 		retry.WithTrace(b.driverConfig.TraceRetry()),
+		retry.WithBudget(b.driverConfig.RetryBudget()),
+		retry.WithErrorClassifier(b.driverConfig.ErrorClassifier()),
 	)
 }
 
@@ -191,6 +207,19 @@ func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, endpoints []end
 
 	info := balancerConfig.Info{SelfLocation: localDC}
 	state := newConnectionsState(connections, b.config.Filter, info, b.config.AllowFallback)
+	if b.stats != nil {
+		b.stats.retain(connections)
+		state.policy = b.config.Policy
+		state.stats = b.stats
+	}
+	if b.outliers != nil {
+		b.outliers.retain(connections)
+		state.outliers = b.outliers
+	}
+	if b.breakers != nil {
+		b.breakers.retain(connections)
+		state.breakers = b.breakers
+	}
 
 	endpointsInfo := make([]endpoint.Info, len(endpoints))
 	for i, e := range endpoints {
@@ -257,14 +286,20 @@ func New(
 		pool:            pool,
 		localDCDetector: detectLocalDC,
 	}
-	d, err := internalDiscovery.New(ctx, pool.Get(
-		endpoint.New(driverConfig.Endpoint()),
-	), discoveryConfig)
-	if err != nil {
-		return nil, err
-	}
+	// static endpoints are rediscovered on discovery interval too: rediscovery re-allows banned
+	// connections, and hosts of static endpoints are resolved again only on resolve interval
+	if addrs := driverConfig.StaticEndpoints(); len(addrs) > 0 {
+		b.discoveryClient = newStaticDiscovery(addrs, driverConfig.StaticEndpointsResolveInterval(), driverConfig.Trace())
+	} else {
+		d, err := internalDiscovery.New(ctx, pool.Get(
+			endpoint.New(driverConfig.Endpoint()),
+		), discoveryConfig)
+		if err != nil {
+			return nil, err
+		}
 
-	b.discoveryClient = d
+		b.discoveryClient = d
+	}
 
 	if config := driverConfig.Balancer(); config == nil {
 		b.config = balancerConfig.Config{}
@@ -272,9 +307,29 @@ func New(
 		b.config = *config
 	}
 
+	if b.config.Policy != nil {
+		b.stats = newConnsStats()
+	}
+
+	if b.config.OutlierDetection != nil {
+		b.outliers = newOutlierDetector(*b.config.OutlierDetection, driverConfig.Trace())
+	}
+
+	if b.config.Hedging != nil {
+		b.hedging = newHedging(*b.config.Hedging)
+	}
+
+	if b.config.CircuitBreaker != nil {
+		b.breakers = newEndpointBreakers(*b.config.CircuitBreaker, driverConfig.Trace())
+	}
+
 	if b.config.SingleConn {
+		address := driverConfig.Endpoint()
+		if addrs := driverConfig.StaticEndpoints(); len(addrs) > 0 {
+			address = addrs[0]
+		}
 		b.applyDiscoveredEndpoints(ctx, []endpoint.Endpoint{
-			endpoint.New(driverConfig.Endpoint()),
+			endpoint.New(address),
 		}, "")
 	} else {
 		// initialization of balancer state
@@ -301,6 +356,12 @@ func (b *Balancer) Invoke(
 	reply interface{},
 	opts ...grpc.CallOption,
 ) error {
+	if b.hedging != nil && isHedgingAllowed(ctx) {
+		if r, ok := reply.(proto.Message); ok {
+			return b.hedgedInvoke(ctx, method, args, r, opts...)
+		}
+	}
+
 	return b.wrapCall(ctx, func(ctx context.Context, cc conn.Conn) error {
 		return cc.Invoke(ctx, method, args, reply, opts...)
 	})
@@ -311,6 +372,19 @@ func (b *Balancer) NewStream(
 	desc *grpc.StreamDesc,
 	method string,
 	opts ...grpc.CallOption,
+) (_ grpc.ClientStream, err error) {
+	if b.hedging != nil && isHedgingAllowed(ctx) && desc.ServerStreams && !desc.ClientStreams {
+		return b.hedgedNewStream(ctx, desc, method, opts...)
+	}
+
+	return b.newStream(ctx, desc, method, opts...)
+}
+
+func (b *Balancer) newStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
 ) (_ grpc.ClientStream, err error) {
 	var client grpc.ClientStream
 	err = b.wrapCall(ctx, func(ctx context.Context, cc conn.Conn) error {
@@ -331,12 +405,25 @@ func (b *Balancer) wrapCall(ctx context.Context, f func(ctx context.Context, cc
 		return xerrors.WithStackTrace(err)
 	}
 
+	return b.wrapCallOnConn(ctx, cc, f)
+}
+
+func (b *Balancer) wrapCallOnConn(
+	ctx context.Context, cc conn.Conn, f func(ctx context.Context, cc conn.Conn) error,
+) (err error) {
+	// lost attempts of hedged calls cancelled by balancer and must not be accounted as endpoint failures
+	isFailure := func(err error) bool {
+		return !isLostHedgedAttempt(ctx) &&
+			isEndpointFailure(err, b.driverConfig.ExcludeGRPCCodesForPessimization()...)
+	}
+
 	defer func() {
 		if err == nil {
 			if cc.GetState() == conn.Banned {
 				b.pool.Allow(ctx, cc)
 			}
-		} else if xerrors.MustPessimizeEndpoint(err, b.driverConfig.ExcludeGRPCCodesForPessimization()...) {
+		} else if !isLostHedgedAttempt(ctx) &&
+			xerrors.MustPessimizeEndpoint(err, b.driverConfig.ExcludeGRPCCodesForPessimization()...) {
 			b.pool.Ban(ctx, cc, err)
 		}
 	}()
@@ -345,6 +432,29 @@ func (b *Balancer) wrapCall(ctx context.Context, f func(ctx context.Context, cc
 		return xerrors.WithStackTrace(err)
 	}
 
+	if b.breakers != nil {
+		if err = b.breakers.allow(ctx, cc); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		defer func() {
+			b.breakers.done(ctx, cc, isFailure(err))
+		}()
+	}
+
+	if b.stats != nil {
+		stats, start := b.stats.get(cc), time.Now()
+		stats.start()
+		defer func() {
+			stats.done(time.Since(start), isFailure(err))
+		}()
+	}
+
+	if b.outliers != nil {
+		defer func() {
+			b.outliers.report(ctx, cc, isFailure(err))
+		}()
+	}
+
 	if err = f(ctx, cc); err != nil {
 		if conn.UseWrapping(ctx) {
 			if credentials.IsAccessError(err) {
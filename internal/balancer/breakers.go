@@ -0,0 +1,103 @@
+package balancer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/circuitbreaker"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// endpointBreakers is a set of circuit breakers of connections
+type endpointBreakers struct {
+	config circuitbreaker.Config
+	trace  *trace.Driver
+	opts   []circuitbreaker.Option
+
+	mu       sync.RWMutex
+	breakers map[conn.Conn]*circuitbreaker.Breaker
+}
+
+func newEndpointBreakers(config circuitbreaker.Config, t *trace.Driver) *endpointBreakers {
+	return &endpointBreakers{
+		config:   config,
+		trace:    t,
+		breakers: make(map[conn.Conn]*circuitbreaker.Breaker),
+	}
+}
+
+func (b *endpointBreakers) get(c conn.Conn) *circuitbreaker.Breaker {
+	b.mu.RLock()
+	breaker, has := b.breakers[c]
+	b.mu.RUnlock()
+	if has {
+		return breaker
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if breaker, has = b.breakers[c]; !has {
+		breaker = circuitbreaker.New(b.config, b.opts...)
+		b.breakers[c] = breaker
+	}
+
+	return breaker
+}
+
+// ready checks that circuit breaker of connection allows calls
+func (b *endpointBreakers) ready(c conn.Conn) bool {
+	b.mu.RLock()
+	breaker, has := b.breakers[c]
+	b.mu.RUnlock()
+
+	return !has || breaker.Ready()
+}
+
+// allow checks that call over connection is allowed by circuit breaker of connection
+func (b *endpointBreakers) allow(ctx context.Context, c conn.Conn) error {
+	allowed, until, t := b.get(c).Allow()
+	b.onTransition(ctx, c, t)
+	if !allowed {
+		return &retry.CircuitBreakerOpenError{
+			Name:  c.Endpoint().Address(),
+			Until: until,
+		}
+	}
+
+	return nil
+}
+
+// done accounts result of call over connection
+func (b *endpointBreakers) done(ctx context.Context, c conn.Conn, failed bool) {
+	b.onTransition(ctx, c, b.get(c).Done(failed))
+}
+
+func (b *endpointBreakers) onTransition(ctx context.Context, c conn.Conn, t circuitbreaker.Transition) {
+	if t.Changed() {
+		trace.DriverOnBalancerCircuitBreakerStateChange(b.trace, &ctx,
+			stack.FunctionID(""),
+			c.Endpoint(), t.From.String(), t.To.String(),
+		)()
+	}
+}
+
+// retain drops circuit breakers of connections which not contains in conns
+func (b *endpointBreakers) retain(conns []conn.Conn) {
+	actual := make(map[conn.Conn]struct{}, len(conns))
+	for _, c := range conns {
+		actual[c] = struct{}{}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for c := range b.breakers {
+		if _, has := actual[c]; !has {
+			delete(b.breakers, c)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/circuitbreaker"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestEndpointBreakers(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		c1    = &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online}
+		c2    = &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online}
+		conns = []conn.Conn{c1, c2}
+
+		transitions []string
+		breakers    = newEndpointBreakers(circuitbreaker.Config{
+			FailureRateThreshold: 1,
+			MinRequests:          2,
+			CoolDown:             time.Hour,
+		}, &trace.Driver{
+			OnBalancerCircuitBreakerStateChange: func(info trace.DriverBalancerCircuitBreakerStateChangeStartInfo) func(
+				trace.DriverBalancerCircuitBreakerStateChangeDoneInfo,
+			) {
+				transitions = append(transitions, info.Endpoint.Address()+":"+info.From+"->"+info.To)
+
+				return nil
+			},
+		})
+		b = &Balancer{
+			driverConfig: config.New(),
+			breakers:     breakers,
+		}
+		overloaded = func(ctx context.Context, cc conn.Conn) error {
+			return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+		}
+	)
+	breakers.retain(conns)
+
+	state := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+	state.breakers = breakers
+
+	for i := 0; i < 2; i++ {
+		err := b.wrapCallOnConn(ctx, c1, overloaded)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED))
+	}
+	require.Equal(t, []string{"1:closed->open"}, transitions)
+
+	err := b.wrapCallOnConn(ctx, c1, overloaded)
+	require.True(t, retry.IsCircuitBreakerOpen(err))
+
+	for i := 0; i < 10; i++ {
+		c, _ := state.GetConnection(ctx)
+		require.Equal(t, c2, c)
+	}
+
+	// connection with open circuit breaker is ok only as a last chance
+	require.False(t, state.isOkConnection(c1, false))
+	require.True(t, state.isOkConnection(c1, true))
+}
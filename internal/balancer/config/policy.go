@@ -0,0 +1,79 @@
+package config
+
+import "time"
+
+// ConnStats is a snapshot of connection statistics which provided to balancing policy
+type ConnStats struct {
+	NodeID   uint32
+	Address  string
+	Location string
+
+	// InFlight is a count of calls which currently in progress over connection
+	InFlight int64
+
+	// Latency is an exponentially weighted moving average of calls latency.
+	// Zero latency means that connection have no completed calls yet
+	Latency time.Duration
+
+	// ErrorRate is an exponentially weighted moving average of calls failures in range [0, 1]
+	ErrorRate float64
+}
+
+// Candidates is a list of connections for choosing by balancing policy
+type Candidates interface {
+	Len() int
+	Stats(i int) ConnStats
+}
+
+// Policy defines algorithm of choosing connection from candidates
+type Policy interface {
+	// Choose returns index of chosen connection in range [0, candidates.Len())
+	Choose(candidates Candidates) int
+	String() string
+}
+
+// OutlierDetection is a settings of ejection of endpoints with abnormal count of errors
+type OutlierDetection struct {
+	// ConsecutiveErrors is a count of consecutive errors for ejection of endpoint.
+	// Zero value disables ejection by consecutive errors
+	ConsecutiveErrors int
+
+	// FailureRateThreshold is a share of failed calls in range (0, 1] within FailureRateInterval
+	// for ejection of endpoint. Zero value disables ejection by error rate
+	FailureRateThreshold float64
+	// FailureRateMinRequests is a minimal count of calls within FailureRateInterval
+	// for checking of error rate
+	FailureRateMinRequests int
+	// FailureRateInterval is a window of error rate calculation
+	FailureRateInterval time.Duration
+
+	// BaseEjectionTime is a duration of first ejection. Every next ejection
+	// of the same endpoint doubles ejection time up to MaxEjectionTime
+	BaseEjectionTime time.Duration
+	MaxEjectionTime  time.Duration
+
+	// MaxEjectionPercent is a maximum share of endpoints in percents which can be ejected at the same time.
+	// At least one endpoint of two or more can be ejected regardless of this value
+	MaxEjectionPercent int
+
+	// SlowStartWindow is a duration of linear ramp-up of traffic to re-admitted endpoint.
+	// Zero value disables slow start
+	SlowStartWindow time.Duration
+}
+
+// Hedging is a settings of hedged requests: if response of idempotent read call is not received within delay,
+// the same request sends to another endpoint and first received response is used
+type Hedging struct {
+	// Delay is a delay before sending of hedged request.
+	// Delay used as is if Percentile is zero or until enough latency statistics is collected
+	Delay time.Duration
+
+	// Percentile of observed latencies of hedgeable calls in range (0, 1) which used as delay
+	// before sending of hedged request. Zero value means fixed Delay
+	Percentile float64
+
+	// BudgetRatio is a maximum ratio of hedged requests to all hedgeable calls (e.g. 0.1 means 10% extra load)
+	BudgetRatio float64
+	// BudgetBurst is a maximum count of hedged requests which can be sent in a row
+	BudgetBurst int
+}
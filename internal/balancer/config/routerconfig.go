@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/circuitbreaker"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
 )
@@ -14,6 +15,18 @@ type Config struct {
 	AllowFallback bool
 	SingleConn    bool
 	DetectLocalDC bool
+
+	// Policy defines algorithm of choosing connection. Nil policy means random choice
+	Policy Policy
+
+	// OutlierDetection defines ejection of endpoints with abnormal count of errors. Nil means no ejection
+	OutlierDetection *OutlierDetection
+
+	// Hedging defines hedged requests for idempotent read calls. Nil means no hedging
+	Hedging *Hedging
+
+	// CircuitBreaker defines circuit breaker of endpoints. Nil means no circuit breaker
+	CircuitBreaker *circuitbreaker.Config
 }
 
 func (c Config) String() string {
@@ -24,7 +37,12 @@ func (c Config) String() string {
 	buffer := xstring.Buffer()
 	defer buffer.Free()
 
-	buffer.WriteString("RandomChoice{")
+	if c.Policy != nil {
+		buffer.WriteString(c.Policy.String())
+	} else {
+		buffer.WriteString("RandomChoice")
+	}
+	buffer.WriteByte('{')
 
 	buffer.WriteString("DetectLocalDC=")
 	fmt.Fprintf(buffer, "%t", c.DetectLocalDC)
@@ -32,6 +50,18 @@ func (c Config) String() string {
 	buffer.WriteString(",AllowFallback=")
 	fmt.Fprintf(buffer, "%t", c.AllowFallback)
 
+	if c.OutlierDetection != nil {
+		buffer.WriteString(",OutlierDetection=true")
+	}
+
+	if c.Hedging != nil {
+		buffer.WriteString(",Hedging=true")
+	}
+
+	if c.CircuitBreaker != nil {
+		buffer.WriteString(",CircuitBreaker=true")
+	}
+
 	if c.Filter != nil {
 		buffer.WriteString(",Filter=")
 		fmt.Fprint(buffer, c.Filter.String())
@@ -16,6 +16,11 @@ type connectionsState struct {
 	all      []conn.Conn
 
 	rand xrand.Rand
+
+	policy   balancerConfig.Policy
+	stats    *connsStats
+	outliers *outlierDetector
+	breakers *endpointBreakers
 }
 
 func newConnectionsState(
@@ -53,6 +58,9 @@ func (s *connectionsState) GetConnection(ctx context.Context) (_ conn.Conn, fail
 	}
 
 	try := func(conns []conn.Conn) conn.Conn {
+		if c := s.selectConnectionByPolicy(conns); c != nil {
+			return c
+		}
 		c, tryFailed := s.selectRandomConnection(conns, false)
 		failedCount += tryFailed
 
@@ -72,6 +80,27 @@ func (s *connectionsState) GetConnection(ctx context.Context) (_ conn.Conn, fail
 	return c, failedCount
 }
 
+// GetConnectionExcept returns ok connection which differs from excluded connection.
+// Returns nil if no such connection
+func (s *connectionsState) GetConnectionExcept(excluded conn.Conn) conn.Conn {
+	for _, conns := range [][]conn.Conn{s.prefer, s.fallback} {
+		candidates := make([]conn.Conn, 0, len(conns))
+		for _, c := range conns {
+			if c != excluded {
+				candidates = append(candidates, c)
+			}
+		}
+		if c := s.selectConnectionByPolicy(candidates); c != nil {
+			return c
+		}
+		if c, _ := s.selectRandomConnection(candidates, false); c != nil {
+			return c
+		}
+	}
+
+	return nil
+}
+
 func (s *connectionsState) preferConnection(ctx context.Context) conn.Conn {
 	if e, hasPreferEndpoint := ContextEndpoint(ctx); hasPreferEndpoint {
 		c := s.connByNodeID[e.NodeID()]
@@ -83,6 +112,25 @@ func (s *connectionsState) preferConnection(ctx context.Context) conn.Conn {
 	return nil
 }
 
+// selectConnectionByPolicy returns connection chosen by balancing policy.
+// If policy is not defined or chosen connection is not ok - returns nil for fallback to random choice
+func (s *connectionsState) selectConnectionByPolicy(conns []conn.Conn) conn.Conn {
+	if s.policy == nil || s.stats == nil || len(conns) == 0 {
+		return nil
+	}
+
+	i := s.policy.Choose(statsCandidates{conns: conns, stats: s.stats})
+	if i < 0 || i >= len(conns) {
+		return nil
+	}
+
+	if c := conns[i]; s.isOkConnection(c, false) {
+		return c
+	}
+
+	return nil
+}
+
 func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned bool) (c conn.Conn, failedConns int) {
 	connCount := len(conns)
 	if connCount == 0 {
@@ -91,7 +139,7 @@ func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned
 	}
 
 	// fast path
-	if c := conns[s.rand.Int(connCount)]; isOkConnection(c, allowBanned) {
+	if c := conns[s.rand.Int(connCount)]; s.isOkConnection(c, allowBanned) {
 		return c, 0
 	}
 
@@ -106,7 +154,7 @@ func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned
 
 	for _, index := range indexes {
 		c := conns[index]
-		if isOkConnection(c, allowBanned) {
+		if s.isOkConnection(c, allowBanned) {
 			return c, 0
 		}
 		failedConns++
@@ -153,6 +201,25 @@ func sortPreferConnections(
 	return prefer, fallback
 }
 
+// isOkConnection checks connection state, ejection by outlier detector and state of circuit breaker.
+// Ejected connections and connections with open circuit breaker are ok only if banned connections
+// are ok too (last chance for choosing connection)
+func (s *connectionsState) isOkConnection(c conn.Conn, bannedIsOk bool) bool {
+	if !isOkConnection(c, bannedIsOk) {
+		return false
+	}
+
+	if bannedIsOk {
+		return true
+	}
+
+	if s.breakers != nil && !s.breakers.ready(c) {
+		return false
+	}
+
+	return s.outliers == nil || s.outliers.admit(c)
+}
+
 func isOkConnection(c conn.Conn, bannedIsOk bool) bool {
 	switch c.GetState() {
 	case conn.Online, conn.Created, conn.Offline:
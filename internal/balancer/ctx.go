@@ -21,3 +21,24 @@ func ContextEndpoint(ctx context.Context) (e Endpoint, ok bool) {
 
 	return nil, false
 }
+
+type (
+	ctxHedgingKey       struct{}
+	ctxHedgedAttemptKey struct{}
+)
+
+// WithHedging marks call as idempotent read call which can be hedged by balancer.
+// Call bound to node (with endpoint in context) is never hedged: duplicate of call to the same node
+// doesn't help with slow node and other nodes cannot serve node-bound call
+func WithHedging(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxHedgingKey{}, true)
+}
+
+func isHedgingAllowed(ctx context.Context) bool {
+	if _, has := ContextEndpoint(ctx); has {
+		return false
+	}
+	allowed, _ := ctx.Value(ctxHedgingKey{}).(bool)
+
+	return allowed
+}
@@ -0,0 +1,546 @@
+package balancer
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const (
+	// hedgingLatenciesWindow is a count of last latencies of hedgeable calls used for percentile calculation
+	hedgingLatenciesWindow = 1000
+	// hedgingMinLatencies is a minimal count of latencies for using of percentile instead of fixed delay
+	hedgingMinLatencies = 100
+	// hedgingRecalcPeriod is a count of observed latencies between percentile recalculations
+	hedgingRecalcPeriod = 50
+)
+
+// hedging holds state of hedged requests: latencies of hedgeable calls and budget of hedged requests
+type hedging struct {
+	config balancerConfig.Hedging
+
+	mu         sync.Mutex
+	latencies  []time.Duration
+	next       int
+	observed   int
+	percentile time.Duration
+	tokens     float64
+}
+
+func newHedging(config balancerConfig.Hedging) *hedging {
+	return &hedging{
+		config:    config,
+		latencies: make([]time.Duration, 0, hedgingLatenciesWindow),
+		tokens:    float64(config.BudgetBurst),
+	}
+}
+
+// delay returns delay before sending of hedged request. Returns false if delay is not known yet
+func (h *hedging) delay() (time.Duration, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.config.Percentile > 0 && h.percentile > 0 {
+		return h.percentile, true
+	}
+
+	return h.config.Delay, h.config.Delay > 0
+}
+
+// observe accounts latency of successful hedgeable call
+func (h *hedging) observe(latency time.Duration) {
+	if h.config.Percentile <= 0 {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.latencies) < hedgingLatenciesWindow {
+		h.latencies = append(h.latencies, latency)
+	} else {
+		h.latencies[h.next] = latency
+		h.next = (h.next + 1) % hedgingLatenciesWindow
+	}
+	h.observed++
+
+	if len(h.latencies) >= hedgingMinLatencies && (h.percentile == 0 || h.observed%hedgingRecalcPeriod == 0) {
+		sorted := make([]time.Duration, len(h.latencies))
+		copy(sorted, h.latencies)
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i] < sorted[j]
+		})
+		h.percentile = sorted[int(h.config.Percentile*float64(len(sorted)-1))]
+	}
+}
+
+// deposit fills budget of hedged requests on every hedgeable call
+func (h *hedging) deposit() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.tokens += h.config.BudgetRatio
+	if burst := float64(h.config.BudgetBurst); h.tokens > burst {
+		h.tokens = burst
+	}
+}
+
+// withdraw takes token from budget for hedged request. Returns false if budget is exhausted
+func (h *hedging) withdraw() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.tokens < 1 {
+		return false
+	}
+	h.tokens--
+
+	return true
+}
+
+// refund returns unused token to budget
+func (h *hedging) refund() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.tokens++
+}
+
+// hedgedAttempt is a marker of attempt of hedged call in context
+type hedgedAttempt struct {
+	lost atomic.Bool
+}
+
+func withHedgedAttempt(ctx context.Context) (context.Context, *hedgedAttempt) {
+	a := &hedgedAttempt{}
+
+	return context.WithValue(ctx, ctxHedgedAttemptKey{}, a), a
+}
+
+// isLostHedgedAttempt checks that call is an attempt of hedged call which cancelled because of other attempt won.
+// Errors of lost attempts must not be accounted as endpoint failures
+func isLostHedgedAttempt(ctx context.Context) bool {
+	a, has := ctx.Value(ctxHedgedAttemptKey{}).(*hedgedAttempt)
+
+	return has && a.lost.Load()
+}
+
+type hedgedResult struct {
+	hedged bool
+	cc     conn.Conn
+	reply  proto.Message
+	err    error
+}
+
+// hedgedInvoke invokes unary call on chosen connection and sends the same request again
+// if response is not received within hedging delay. First successful response is used and other call is cancelled
+func (b *Balancer) hedgedInvoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply proto.Message,
+	opts ...grpc.CallOption,
+) error {
+	b.hedging.deposit()
+
+	invoke := func(ctx context.Context, cc conn.Conn) error {
+		return cc.Invoke(ctx, method, args, reply, opts...)
+	}
+
+	delay, ok := b.hedging.delay()
+	if !ok {
+		return b.wrapCall(ctx, invoke)
+	}
+
+	primary, err := b.getConn(ctx)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	var (
+		start   = time.Now()
+		results = make(chan hedgedResult, 2)
+		attempt = func(ctx context.Context, cc conn.Conn, hedged bool) (context.CancelFunc, *hedgedAttempt) {
+			ctx, cancel := xcontext.WithCancel(ctx)
+			ctx, a := withHedgedAttempt(ctx)
+			go func() {
+				r := reply.ProtoReflect().New().Interface()
+				err := b.wrapCallOnConn(ctx, cc, func(ctx context.Context, cc conn.Conn) error {
+					return cc.Invoke(ctx, method, args, r, opts...)
+				})
+				results <- hedgedResult{hedged: hedged, cc: cc, reply: r, err: err}
+			}()
+
+			return cancel, a
+		}
+		use = func(r hedgedResult) error {
+			if r.err != nil {
+				return r.err
+			}
+			b.hedging.observe(time.Since(start))
+			proto.Reset(reply)
+			proto.Merge(reply, r.reply)
+
+			return nil
+		}
+	)
+
+	cancelPrimary, primaryAttempt := attempt(ctx, primary, false)
+	defer cancelPrimary()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case r := <-results:
+		return use(r)
+	case <-timer.C:
+	}
+
+	hedged := b.withdrawHedgedConn(primary)
+	if hedged == nil {
+		return use(<-results)
+	}
+
+	onDone := trace.DriverOnBalancerHedge(b.driverConfig.Trace(), &ctx,
+		stack.FunctionID(""),
+		trace.Method(method), primary.Endpoint(), hedged.Endpoint(), delay,
+	)
+
+	cancelHedged, hedgedAttempt := attempt(ctx, hedged, true)
+	defer cancelHedged()
+
+	r := <-results
+	if r.err != nil {
+		// first failed attempt is not final, waiting for other attempt
+		r = <-results
+	}
+
+	// cancel lost attempt
+	if r.hedged {
+		primaryAttempt.lost.Store(true)
+		cancelPrimary()
+	} else {
+		hedgedAttempt.lost.Store(true)
+		cancelHedged()
+	}
+
+	if r.err != nil {
+		onDone(nil, r.err)
+	} else {
+		onDone(r.cc.Endpoint(), nil)
+	}
+
+	return use(r)
+}
+
+// withdrawHedgedConn takes token from budget of hedged requests and returns connection for hedged attempt.
+// Hedged attempt is always sent to connection other than primary. Returns nil if budget is exhausted
+// or there is no other connection
+func (b *Balancer) withdrawHedgedConn(primary conn.Conn) conn.Conn {
+	if !b.hedging.withdraw() {
+		return nil
+	}
+	if hedged := b.connections().GetConnectionExcept(primary); hedged != nil {
+		return hedged
+	}
+	b.hedging.refund()
+
+	return nil
+}
+
+// streamAttempt is an attempt of hedged server stream
+type streamAttempt struct {
+	grpc.ClientStream
+
+	cc      conn.Conn
+	cancel  context.CancelFunc
+	attempt *hedgedAttempt
+}
+
+// hedgedStream is a server stream which sends the same request again if first message of stream
+// is not received within hedging delay. Stream which received first message is used and other stream is cancelled
+type hedgedStream struct {
+	b      *Balancer
+	ctx    context.Context //nolint:containedctx
+	desc   *grpc.StreamDesc
+	method string
+	opts   []grpc.CallOption
+
+	// requests sent to primary stream before receiving of first message
+	requests []interface{}
+	closed   bool
+
+	mu      sync.Mutex
+	primary *streamAttempt
+	winner  *streamAttempt
+}
+
+// hedgedNewStream opens server stream on chosen connection. Hedged stream is opened on receiving of first message
+func (b *Balancer) hedgedNewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	b.hedging.deposit()
+
+	if _, ok := b.hedging.delay(); !ok {
+		return b.newStream(ctx, desc, method, opts...)
+	}
+
+	primary, err := b.getConn(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	s := &hedgedStream{
+		b:      b,
+		ctx:    ctx,
+		desc:   desc,
+		method: method,
+		opts:   opts,
+	}
+
+	s.primary, err = s.open(primary)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return s, nil
+}
+
+func (s *hedgedStream) open(cc conn.Conn) (_ *streamAttempt, err error) {
+	ctx, cancel := xcontext.WithCancel(s.ctx)
+	ctx, a := withHedgedAttempt(ctx)
+
+	var stream grpc.ClientStream
+	err = s.b.wrapCallOnConn(ctx, cc, func(ctx context.Context, cc conn.Conn) error {
+		stream, err = cc.NewStream(ctx, s.desc, s.method, s.opts...)
+
+		return err
+	})
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	return &streamAttempt{
+		ClientStream: stream,
+		cc:           cc,
+		cancel:       cancel,
+		attempt:      a,
+	}, nil
+}
+
+// current returns winner stream or primary stream if winner is not chosen yet
+func (s *hedgedStream) current() *streamAttempt {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.winner != nil {
+		return s.winner
+	}
+
+	return s.primary
+}
+
+func (s *hedgedStream) Header() (metadata.MD, error) {
+	return s.current().Header()
+}
+
+func (s *hedgedStream) Trailer() metadata.MD {
+	return s.current().Trailer()
+}
+
+func (s *hedgedStream) Context() context.Context {
+	return s.current().Context()
+}
+
+func (s *hedgedStream) SendMsg(m interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.winner != nil {
+		return s.winner.SendMsg(m)
+	}
+	s.requests = append(s.requests, m)
+
+	return s.primary.SendMsg(m)
+}
+
+func (s *hedgedStream) CloseSend() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.winner != nil {
+		return s.winner.CloseSend()
+	}
+	s.closed = true
+
+	return s.primary.CloseSend()
+}
+
+func (s *hedgedStream) RecvMsg(m interface{}) (err error) {
+	s.mu.Lock()
+	winner := s.winner
+	s.mu.Unlock()
+
+	if winner == nil {
+		return s.recvFirst(m)
+	}
+
+	defer func() {
+		if err != nil {
+			winner.cancel()
+		}
+	}()
+
+	return winner.RecvMsg(m)
+}
+
+// recvFirst receives first message of stream and chooses winner stream
+func (s *hedgedStream) recvFirst(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		s.setWinner(s.primary)
+
+		return s.RecvMsg(m)
+	}
+
+	var (
+		start   = time.Now()
+		results = make(chan hedgedResult, 2)
+		recv    = func(a *streamAttempt, hedged bool) {
+			r := msg.ProtoReflect().New().Interface()
+			err := a.RecvMsg(r)
+			results <- hedgedResult{hedged: hedged, cc: a.cc, reply: r, err: err}
+		}
+		use = func(r hedgedResult) error {
+			if r.err != nil {
+				s.current().cancel()
+
+				return r.err
+			}
+			s.b.hedging.observe(time.Since(start))
+			proto.Reset(msg)
+			proto.Merge(msg, r.reply)
+
+			return nil
+		}
+	)
+
+	go recv(s.primary, false)
+
+	delay, _ := s.b.hedging.delay()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case r := <-results:
+		s.setWinner(s.primary)
+
+		return use(r)
+	case <-timer.C:
+	}
+
+	var (
+		ctx         = s.ctx
+		hedgedConn  = s.b.withdrawHedgedConn(s.primary.cc)
+		hedged, err = s.openHedged(hedgedConn)
+	)
+	if hedged == nil {
+		if err != nil {
+			s.b.hedging.refund()
+		}
+		s.setWinner(s.primary)
+
+		return use(<-results)
+	}
+
+	onDone := trace.DriverOnBalancerHedge(s.b.driverConfig.Trace(), &ctx,
+		stack.FunctionID(""),
+		trace.Method(s.method), s.primary.cc.Endpoint(), hedged.cc.Endpoint(), delay,
+	)
+
+	go recv(hedged, true)
+
+	r := <-results
+	if r.err != nil {
+		// first failed attempt is not final, waiting for other attempt
+		r = <-results
+	}
+
+	// cancel lost attempt
+	if r.hedged {
+		s.primary.attempt.lost.Store(true)
+		s.primary.cancel()
+		s.setWinner(hedged)
+	} else {
+		hedged.attempt.lost.Store(true)
+		hedged.cancel()
+		s.setWinner(s.primary)
+	}
+
+	if r.err != nil {
+		onDone(nil, r.err)
+	} else {
+		onDone(r.cc.Endpoint(), nil)
+	}
+
+	return use(r)
+}
+
+// openHedged opens hedged stream and sends to it requests which were sent to primary stream
+func (s *hedgedStream) openHedged(cc conn.Conn) (*streamAttempt, error) {
+	if cc == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	hedged, err := s.open(cc)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, m := range s.requests {
+		if err = hedged.SendMsg(m); err != nil {
+			hedged.cancel()
+
+			return nil, err
+		}
+	}
+	if s.closed {
+		if err = hedged.CloseSend(); err != nil {
+			hedged.cancel()
+
+			return nil, err
+		}
+	}
+
+	return hedged, nil
+}
+
+func (s *hedgedStream) setWinner(a *streamAttempt) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.winner = a
+	s.requests = nil
+}
@@ -0,0 +1,455 @@
+package balancer
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type hedgingTestConn struct {
+	*mock.Conn
+
+	invoke func(ctx context.Context, reply interface{}) error
+	calls  atomic.Int64
+}
+
+func (c *hedgingTestConn) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	c.calls.Add(1)
+
+	return &hedgingTestStream{ctx: ctx, recv: c.invoke}, nil
+}
+
+type hedgingTestStream struct {
+	grpc.ClientStream
+
+	ctx    context.Context //nolint:containedctx
+	recv   func(ctx context.Context, reply interface{}) error
+	sent   []interface{}
+	closed bool
+}
+
+func (s *hedgingTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *hedgingTestStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+
+	return nil
+}
+
+func (s *hedgingTestStream) CloseSend() error {
+	s.closed = true
+
+	return nil
+}
+
+func (s *hedgingTestStream) RecvMsg(m interface{}) error {
+	return s.recv(s.ctx, m)
+}
+
+// hedgingTestFilter prefers connection for deterministic choice of primary connection
+type hedgingTestFilter struct {
+	prefer conn.Conn
+}
+
+func (f hedgingTestFilter) Allow(_ balancerConfig.Info, c conn.Conn) bool {
+	return c == f.prefer
+}
+
+func (f hedgingTestFilter) String() string {
+	return "Prefer"
+}
+
+// blockFirst blocks first call until cancelling and replies on other calls
+func blockFirst(value string) func(ctx context.Context, reply interface{}) error {
+	var calls atomic.Int64
+
+	return func(ctx context.Context, reply interface{}) error {
+		if calls.Add(1) == 1 {
+			<-ctx.Done()
+
+			return ctx.Err()
+		}
+
+		return replyWith(value, 0)(ctx, reply)
+	}
+}
+
+func (c *hedgingTestConn) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	c.calls.Add(1)
+
+	return c.invoke(ctx, reply)
+}
+
+func replyWith(value string, latency time.Duration) func(ctx context.Context, reply interface{}) error {
+	return func(ctx context.Context, reply interface{}) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(latency):
+			reply.(*wrapperspb.StringValue).Value = value
+
+			return nil
+		}
+	}
+}
+
+func newTestHedgingBalancer(
+	hedging balancerConfig.Hedging, t *trace.Driver, conns ...conn.Conn,
+) *Balancer {
+	return &Balancer{
+		driverConfig:     config.New(config.WithTrace(*t)),
+		connectionsState: newConnectionsState(conns, hedgingTestFilter{prefer: conns[0]}, balancerConfig.Info{}, true),
+		hedging:          newHedging(hedging),
+	}
+}
+
+func TestHedgingBudget(t *testing.T) {
+	h := newHedging(balancerConfig.Hedging{
+		BudgetRatio: 0.5,
+		BudgetBurst: 2,
+	})
+	require.True(t, h.withdraw())
+	require.True(t, h.withdraw())
+	require.False(t, h.withdraw())
+	h.deposit()
+	require.False(t, h.withdraw())
+	h.deposit()
+	require.True(t, h.withdraw())
+	for i := 0; i < 10; i++ {
+		h.deposit()
+	}
+	require.True(t, h.withdraw())
+	require.True(t, h.withdraw())
+	require.False(t, h.withdraw())
+}
+
+func TestHedgingDelay(t *testing.T) {
+	t.Run("Fixed", func(t *testing.T) {
+		h := newHedging(balancerConfig.Hedging{
+			Delay: 10 * time.Millisecond,
+		})
+		for i := 0; i < hedgingMinLatencies; i++ {
+			h.observe(time.Second)
+		}
+		delay, ok := h.delay()
+		require.True(t, ok)
+		require.Equal(t, 10*time.Millisecond, delay)
+	})
+	t.Run("Percentile", func(t *testing.T) {
+		h := newHedging(balancerConfig.Hedging{
+			Delay:      10 * time.Millisecond,
+			Percentile: 0.9,
+		})
+		for i := 1; i < hedgingMinLatencies; i++ {
+			h.observe(time.Duration(i) * time.Millisecond)
+		}
+		delay, ok := h.delay()
+		require.True(t, ok)
+		require.Equal(t, 10*time.Millisecond, delay)
+		h.observe(hedgingMinLatencies * time.Millisecond)
+		delay, ok = h.delay()
+		require.True(t, ok)
+		require.Equal(t, 90*time.Millisecond, delay)
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		h := newHedging(balancerConfig.Hedging{
+			Percentile: 0.9,
+		})
+		_, ok := h.delay()
+		require.False(t, ok)
+	})
+}
+
+func TestHedgedInvoke(t *testing.T) {
+	hedging := balancerConfig.Hedging{
+		Delay:       10 * time.Millisecond,
+		BudgetRatio: 0.1,
+		BudgetBurst: 1,
+	}
+	t.Run("PrimaryBeforeDelay", func(t *testing.T) {
+		var (
+			c1 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: replyWith("1", 0),
+			}
+			c2 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online},
+				invoke: replyWith("2", 0),
+			}
+			b     = newTestHedgingBalancer(hedging, &trace.Driver{}, c1, c2)
+			reply = &wrapperspb.StringValue{}
+		)
+		err := b.Invoke(WithHedging(context.Background()), "", nil, reply)
+		require.NoError(t, err)
+		require.Equal(t, "1", reply.GetValue())
+		require.EqualValues(t, 0, c2.calls.Load())
+	})
+	t.Run("HedgedWins", func(t *testing.T) {
+		var (
+			primaryErr = make(chan error, 1)
+			c1         = &hedgingTestConn{
+				Conn: &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: func(ctx context.Context, reply interface{}) error {
+					<-ctx.Done()
+					primaryErr <- ctx.Err()
+
+					return ctx.Err()
+				},
+			}
+			c2 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online},
+				invoke: replyWith("2", 0),
+			}
+			winner trace.EndpointInfo
+			b      = newTestHedgingBalancer(hedging, &trace.Driver{
+				OnBalancerHedge: func(info trace.DriverBalancerHedgeStartInfo) func(trace.DriverBalancerHedgeDoneInfo) {
+					require.Equal(t, "1", info.Endpoint.Address())
+					require.Equal(t, "2", info.Hedged.Address())
+
+					return func(info trace.DriverBalancerHedgeDoneInfo) {
+						winner = info.Winner
+					}
+				},
+			}, c1, c2)
+			reply = &wrapperspb.StringValue{}
+		)
+		err := b.Invoke(WithHedging(context.Background()), "", nil, reply)
+		require.NoError(t, err)
+		require.Equal(t, "2", reply.GetValue())
+		require.Equal(t, "2", winner.Address())
+		require.ErrorIs(t, <-primaryErr, context.Canceled)
+		require.Equal(t, conn.Online, c1.GetState())
+	})
+	t.Run("PrimaryFailedAfterDelay", func(t *testing.T) {
+		var (
+			c1 = &hedgingTestConn{
+				Conn: &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: func(ctx context.Context, reply interface{}) error {
+					time.Sleep(20 * time.Millisecond)
+
+					return context.DeadlineExceeded
+				},
+			}
+			c2 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online},
+				invoke: replyWith("2", 50*time.Millisecond),
+			}
+			b     = newTestHedgingBalancer(hedging, &trace.Driver{}, c1, c2)
+			reply = &wrapperspb.StringValue{}
+		)
+		err := b.Invoke(WithHedging(context.Background()), "", nil, reply)
+		require.NoError(t, err)
+		require.Equal(t, "2", reply.GetValue())
+	})
+	t.Run("BudgetExhausted", func(t *testing.T) {
+		var (
+			c1 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: replyWith("1", 20*time.Millisecond),
+			}
+			c2 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online},
+				invoke: replyWith("2", 0),
+			}
+			b   = newTestHedgingBalancer(hedging, &trace.Driver{}, c1, c2)
+			ctx = WithHedging(context.Background())
+		)
+		require.True(t, b.hedging.withdraw())
+		reply := &wrapperspb.StringValue{}
+		err := b.Invoke(ctx, "", nil, reply)
+		require.NoError(t, err)
+		require.Equal(t, "1", reply.GetValue())
+		require.EqualValues(t, 0, c2.calls.Load())
+	})
+	t.Run("NotHedgeable", func(t *testing.T) {
+		var (
+			c1 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: replyWith("1", 20*time.Millisecond),
+			}
+			c2 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online},
+				invoke: replyWith("2", 0),
+			}
+			b     = newTestHedgingBalancer(hedging, &trace.Driver{}, c1, c2)
+			reply = &wrapperspb.StringValue{}
+		)
+		err := b.Invoke(context.Background(), "", nil, reply)
+		require.NoError(t, err)
+		require.Equal(t, "1", reply.GetValue())
+		require.EqualValues(t, 0, c2.calls.Load())
+	})
+	t.Run("HedgedToOtherConn", func(t *testing.T) {
+		var (
+			c1 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: blockFirst("1"),
+			}
+			c2 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online},
+				invoke: replyWith("2", 0),
+			}
+			c3 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "3", NodeIDField: 3, State: conn.Online},
+				invoke: replyWith("3", 0),
+			}
+			hedged trace.EndpointInfo
+			b      = newTestHedgingBalancer(hedging, &trace.Driver{
+				OnBalancerHedge: func(info trace.DriverBalancerHedgeStartInfo) func(trace.DriverBalancerHedgeDoneInfo) {
+					hedged = info.Hedged
+
+					return nil
+				},
+			}, c1, c2, c3)
+			reply = &wrapperspb.StringValue{}
+		)
+		err := b.Invoke(WithHedging(context.Background()), "", nil, reply)
+		require.NoError(t, err)
+		require.NotEqual(t, "1", reply.GetValue())
+		require.NotEqual(t, "1", hedged.Address())
+		require.Equal(t, hedged.Address(), reply.GetValue())
+		require.EqualValues(t, 1, c1.calls.Load())
+		require.EqualValues(t, 1, c2.calls.Load()+c3.calls.Load())
+	})
+	t.Run("SingleConn", func(t *testing.T) {
+		var (
+			c1 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: replyWith("1", 20*time.Millisecond),
+			}
+			b     = newTestHedgingBalancer(hedging, &trace.Driver{}, c1)
+			reply = &wrapperspb.StringValue{}
+		)
+		err := b.Invoke(WithHedging(context.Background()), "", nil, reply)
+		require.NoError(t, err)
+		require.Equal(t, "1", reply.GetValue())
+		require.EqualValues(t, 1, c1.calls.Load())
+		require.True(t, b.hedging.withdraw())
+	})
+	t.Run("SessionBound", func(t *testing.T) {
+		var (
+			c1 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: replyWith("1", 20*time.Millisecond),
+			}
+			c2 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online},
+				invoke: replyWith("2", 0),
+			}
+			b = newTestHedgingBalancer(hedging, &trace.Driver{
+				OnBalancerHedge: func(info trace.DriverBalancerHedgeStartInfo) func(trace.DriverBalancerHedgeDoneInfo) {
+					t.Fatal("session-bound call must not be hedged")
+
+					return nil
+				},
+			}, c2, c1)
+			reply = &wrapperspb.StringValue{}
+		)
+		err := b.Invoke(WithHedging(WithEndpoint(context.Background(), c1.Endpoint())), "", nil, reply)
+		require.NoError(t, err)
+		require.Equal(t, "1", reply.GetValue())
+		require.EqualValues(t, 1, c1.calls.Load())
+		require.EqualValues(t, 0, c2.calls.Load())
+		require.True(t, b.hedging.withdraw())
+	})
+}
+
+func TestHedgedStream(t *testing.T) {
+	var (
+		hedging = balancerConfig.Hedging{
+			Delay:       10 * time.Millisecond,
+			BudgetRatio: 0.1,
+			BudgetBurst: 1,
+		}
+		desc = &grpc.StreamDesc{ServerStreams: true}
+		recv = func(t *testing.T, b *Balancer, ctx context.Context) (string, *hedgingTestStream) {
+			s, err := b.NewStream(ctx, desc, "")
+			require.NoError(t, err)
+			require.NoError(t, s.SendMsg("request"))
+			require.NoError(t, s.CloseSend())
+			reply := &wrapperspb.StringValue{}
+			require.NoError(t, s.RecvMsg(reply))
+
+			return reply.GetValue(), s.(*hedgedStream).current().ClientStream.(*hedgingTestStream)
+		}
+	)
+	t.Run("PrimaryBeforeDelay", func(t *testing.T) {
+		var (
+			c1 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: replyWith("1", 0),
+			}
+			c2 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online},
+				invoke: replyWith("2", 0),
+			}
+			b = newTestHedgingBalancer(hedging, &trace.Driver{}, c1, c2)
+		)
+		value, _ := recv(t, b, WithHedging(context.Background()))
+		require.Equal(t, "1", value)
+		require.EqualValues(t, 0, c2.calls.Load())
+	})
+	t.Run("HedgedWins", func(t *testing.T) {
+		var (
+			c1 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: blockFirst("1"),
+			}
+			c2 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online},
+				invoke: replyWith("2", 0),
+			}
+			b = newTestHedgingBalancer(hedging, &trace.Driver{}, c1, c2)
+		)
+		value, winner := recv(t, b, WithHedging(context.Background()))
+		require.Equal(t, "2", value)
+		require.Equal(t, []interface{}{"request"}, winner.sent)
+		require.True(t, winner.closed)
+		require.Equal(t, conn.Online, c1.GetState())
+	})
+	t.Run("SessionBound", func(t *testing.T) {
+		var (
+			c1 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+				invoke: replyWith("1", 20*time.Millisecond),
+			}
+			c2 = &hedgingTestConn{
+				Conn:   &mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Online},
+				invoke: replyWith("2", 0),
+			}
+			b = newTestHedgingBalancer(hedging, &trace.Driver{}, c2, c1)
+		)
+		s, err := b.NewStream(WithHedging(WithEndpoint(context.Background(), c1.Endpoint())), desc, "")
+		require.NoError(t, err)
+		require.IsType(t, &hedgingTestStream{}, s)
+		reply := &wrapperspb.StringValue{}
+		require.NoError(t, s.RecvMsg(reply))
+		require.Equal(t, "1", reply.GetValue())
+		require.EqualValues(t, 1, c1.calls.Load())
+		require.EqualValues(t, 0, c2.calls.Load())
+	})
+}
@@ -0,0 +1,231 @@
+package balancer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const (
+	ejectReasonConsecutiveErrors = "consecutive_errors"
+	ejectReasonFailureRate       = "failure_rate"
+
+	// slowStartMinWeight is a minimal share of traffic to endpoint at the beginning of slow start
+	slowStartMinWeight = 0.1
+)
+
+// endpointHealth is a state of outlier detection for single connection
+type endpointHealth struct {
+	consecutiveErrors int
+
+	windowStart time.Time
+	requests    int
+	failures    int
+
+	ejected    bool
+	ejections  int
+	admittedAt time.Time
+}
+
+// outlierDetector ejects connections with abnormal count of errors from balancing for a while
+type outlierDetector struct {
+	config balancerConfig.OutlierDetection
+	trace  *trace.Driver
+	clock  clockwork.Clock
+	rand   xrand.Rand
+
+	mu     sync.RWMutex
+	health map[conn.Conn]*endpointHealth
+	total  int
+}
+
+func newOutlierDetector(config balancerConfig.OutlierDetection, t *trace.Driver) *outlierDetector {
+	return &outlierDetector{
+		config: config,
+		trace:  t,
+		clock:  clockwork.NewRealClock(),
+		rand:   xrand.New(xrand.WithLock()),
+		health: make(map[conn.Conn]*endpointHealth),
+	}
+}
+
+// admit checks that connection is not ejected. Re-admitted connection in slow start
+// admitted with probability which grows linearly through slow start window
+func (d *outlierDetector) admit(c conn.Conn) bool {
+	d.mu.RLock()
+	h, has := d.health[c]
+	if !has {
+		d.mu.RUnlock()
+
+		return true
+	}
+	admittedAt := h.admittedAt
+	d.mu.RUnlock()
+
+	if admittedAt.IsZero() {
+		return true
+	}
+
+	elapsed := d.clock.Since(admittedAt)
+	if elapsed < 0 {
+		return false
+	}
+
+	if window := d.config.SlowStartWindow; window > 0 && elapsed < window {
+		weight := float64(elapsed) / float64(window)
+		if weight < slowStartMinWeight {
+			weight = slowStartMinWeight
+		}
+
+		return float64(d.rand.Int64(1<<53))/(1<<53) < weight
+	}
+
+	return true
+}
+
+// report accounts result of call over connection and ejects connection if it is outlier
+func (d *outlierDetector) report(ctx context.Context, c conn.Conn, failed bool) {
+	var (
+		now    = d.clock.Now()
+		events []func()
+	)
+
+	d.mu.Lock()
+	h, has := d.health[c]
+	if !has {
+		h = &endpointHealth{windowStart: now}
+		d.health[c] = h
+	}
+
+	if h.ejected {
+		if now.Before(h.admittedAt) {
+			// call over ejected connection (balancer have no other connections)
+			d.mu.Unlock()
+
+			return
+		}
+		h.ejected = false
+		events = append(events, d.onReadmit(ctx, c))
+	}
+
+	if interval := d.config.FailureRateInterval; interval > 0 && now.Sub(h.windowStart) > interval {
+		h.windowStart, h.requests, h.failures = now, 0, 0
+	}
+	h.requests++
+	if failed {
+		h.consecutiveErrors++
+		h.failures++
+	} else {
+		h.consecutiveErrors = 0
+	}
+
+	if reason := d.ejectReason(h); reason != "" && d.canEject(now) {
+		events = append(events, d.eject(ctx, c, h, now, reason))
+	}
+	d.mu.Unlock()
+
+	for _, event := range events {
+		event()
+	}
+}
+
+func (d *outlierDetector) ejectReason(h *endpointHealth) string {
+	if n := d.config.ConsecutiveErrors; n > 0 && h.consecutiveErrors >= n {
+		return ejectReasonConsecutiveErrors
+	}
+	if threshold := d.config.FailureRateThreshold; threshold > 0 &&
+		h.requests >= d.config.FailureRateMinRequests &&
+		float64(h.failures) >= threshold*float64(h.requests) {
+		return ejectReasonFailureRate
+	}
+
+	return ""
+}
+
+// canEject checks that count of ejected connections less than MaxEjectionPercent of all connections.
+// At least one connection of two or more can be ejected. Must be called under lock
+func (d *outlierDetector) canEject(now time.Time) bool {
+	if d.total < 2 {
+		return false
+	}
+
+	var ejected int
+	for _, h := range d.health {
+		if now.Before(h.admittedAt) {
+			ejected++
+		}
+	}
+	limit := d.total * d.config.MaxEjectionPercent / 100
+	if limit < 1 {
+		limit = 1
+	}
+
+	return ejected < limit
+}
+
+// eject ejects connection and returns trace event for calling without lock. Must be called under lock
+func (d *outlierDetector) eject(
+	ctx context.Context, c conn.Conn, h *endpointHealth, now time.Time, reason string,
+) func() {
+	// ejections multiplier resets if connection was healthy long enough after previous ejection
+	if !h.admittedAt.IsZero() && now.Sub(h.admittedAt) > d.config.MaxEjectionTime {
+		h.ejections = 0
+	}
+	h.ejections++
+
+	duration := d.config.BaseEjectionTime
+	for i := 1; i < h.ejections && duration < d.config.MaxEjectionTime; i++ {
+		duration *= 2
+	}
+	if max := d.config.MaxEjectionTime; max > 0 && duration > max {
+		duration = max
+	}
+
+	h.ejected = true
+	h.admittedAt = now.Add(duration)
+	h.consecutiveErrors, h.windowStart, h.requests, h.failures = 0, h.admittedAt, 0, 0
+
+	ejections := h.ejections
+
+	return func() {
+		trace.DriverOnBalancerEndpointEject(d.trace, &ctx,
+			stack.FunctionID(""),
+			c.Endpoint(), reason, ejections, duration,
+		)()
+	}
+}
+
+func (d *outlierDetector) onReadmit(ctx context.Context, c conn.Conn) func() {
+	return func() {
+		trace.DriverOnBalancerEndpointReadmit(d.trace, &ctx,
+			stack.FunctionID(""),
+			c.Endpoint(), d.config.SlowStartWindow,
+		)()
+	}
+}
+
+// retain drops state of connections which not contains in conns
+func (d *outlierDetector) retain(conns []conn.Conn) {
+	actual := make(map[conn.Conn]struct{}, len(conns))
+	for _, c := range conns {
+		actual[c] = struct{}{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.total = len(conns)
+	for c := range d.health {
+		if _, has := actual[c]; !has {
+			delete(d.health, c)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func newTestOutlierDetector(
+	config balancerConfig.OutlierDetection, conns []conn.Conn,
+) (*outlierDetector, clockwork.FakeClock, *[]string) {
+	var (
+		events []string
+		clock  = clockwork.NewFakeClock()
+		d      = newOutlierDetector(config, &trace.Driver{
+			OnBalancerEndpointEject: func(info trace.DriverBalancerEndpointEjectStartInfo) func(
+				trace.DriverBalancerEndpointEjectDoneInfo,
+			) {
+				events = append(events, "eject "+info.Endpoint.Address()+" "+info.Reason+" "+info.Duration.String())
+
+				return nil
+			},
+			OnBalancerEndpointReadmit: func(info trace.DriverBalancerEndpointReadmitStartInfo) func(
+				trace.DriverBalancerEndpointReadmitDoneInfo,
+			) {
+				events = append(events, "readmit "+info.Endpoint.Address())
+
+				return nil
+			},
+		})
+	)
+	d.clock = clock
+	d.retain(conns)
+
+	return d, clock, &events
+}
+
+func TestOutlierDetectorConsecutiveErrors(t *testing.T) {
+	ctx := context.Background()
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "1", State: conn.Online},
+		&mock.Conn{AddrField: "2", State: conn.Online},
+		&mock.Conn{AddrField: "3", State: conn.Online},
+	}
+	d, clock, events := newTestOutlierDetector(balancerConfig.OutlierDetection{
+		ConsecutiveErrors:  3,
+		BaseEjectionTime:   10 * time.Second,
+		MaxEjectionTime:    25 * time.Second,
+		MaxEjectionPercent: 10,
+	}, conns)
+
+	for _, failed := range []bool{true, true, false, true, true} {
+		d.report(ctx, conns[0], failed)
+	}
+	require.True(t, d.admit(conns[0]))
+
+	d.report(ctx, conns[0], true)
+	require.False(t, d.admit(conns[0]))
+	require.True(t, d.admit(conns[1]))
+
+	// at least one connection can be ejected, but not more than 10% of connections
+	for i := 0; i < 3; i++ {
+		d.report(ctx, conns[1], true)
+	}
+	require.True(t, d.admit(conns[1]))
+
+	clock.Advance(10 * time.Second)
+	require.True(t, d.admit(conns[0]))
+	d.report(ctx, conns[0], true)
+	d.report(ctx, conns[0], true)
+	d.report(ctx, conns[0], true)
+	require.False(t, d.admit(conns[0]))
+
+	clock.Advance(19 * time.Second)
+	require.False(t, d.admit(conns[0]))
+	clock.Advance(time.Second)
+	require.True(t, d.admit(conns[0]))
+	d.report(ctx, conns[0], false)
+
+	// third ejection time is limited by max ejection time
+	for i := 0; i < 3; i++ {
+		d.report(ctx, conns[0], true)
+	}
+
+	require.Equal(t, []string{
+		"eject 1 consecutive_errors 10s",
+		"readmit 1",
+		"eject 1 consecutive_errors 20s",
+		"readmit 1",
+		"eject 1 consecutive_errors 25s",
+	}, *events)
+}
+
+func TestOutlierDetectorFailureRate(t *testing.T) {
+	ctx := context.Background()
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "1", State: conn.Online},
+		&mock.Conn{AddrField: "2", State: conn.Online},
+	}
+	d, clock, events := newTestOutlierDetector(balancerConfig.OutlierDetection{
+		FailureRateThreshold:   0.5,
+		FailureRateMinRequests: 4,
+		FailureRateInterval:    time.Second,
+		BaseEjectionTime:       time.Minute,
+		MaxEjectionTime:        time.Hour,
+	}, conns)
+
+	// window expires before min requests reached
+	d.report(ctx, conns[0], true)
+	d.report(ctx, conns[0], false)
+	d.report(ctx, conns[0], true)
+	clock.Advance(2 * time.Second)
+	d.report(ctx, conns[0], false)
+	d.report(ctx, conns[0], true)
+	d.report(ctx, conns[0], false)
+	require.True(t, d.admit(conns[0]))
+
+	d.report(ctx, conns[0], true)
+	require.False(t, d.admit(conns[0]))
+	require.Equal(t, []string{"eject 1 failure_rate 1m0s"}, *events)
+}
+
+func TestOutlierDetectorSlowStart(t *testing.T) {
+	ctx := context.Background()
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "1", State: conn.Online},
+		&mock.Conn{AddrField: "2", State: conn.Online},
+	}
+	d, clock, _ := newTestOutlierDetector(balancerConfig.OutlierDetection{
+		ConsecutiveErrors:  1,
+		BaseEjectionTime:   time.Second,
+		MaxEjectionTime:    time.Second,
+		MaxEjectionPercent: 50,
+		SlowStartWindow:    10 * time.Second,
+	}, conns)
+	d.report(ctx, conns[0], true)
+	clock.Advance(time.Second)
+
+	admitted := func() (n int) {
+		for i := 0; i < 1000; i++ {
+			if d.admit(conns[0]) {
+				n++
+			}
+		}
+
+		return n
+	}
+	require.InDelta(t, 100, admitted(), 60)
+	clock.Advance(5 * time.Second)
+	require.InDelta(t, 500, admitted(), 100)
+	clock.Advance(5 * time.Second)
+	require.Equal(t, 1000, admitted())
+}
+
+func TestConnectionsStateOutliers(t *testing.T) {
+	ctx := context.Background()
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "1", State: conn.Online},
+		&mock.Conn{AddrField: "2", State: conn.Online},
+	}
+	d, _, _ := newTestOutlierDetector(balancerConfig.OutlierDetection{
+		ConsecutiveErrors:  1,
+		BaseEjectionTime:   time.Minute,
+		MaxEjectionTime:    time.Minute,
+		MaxEjectionPercent: 100,
+	}, conns)
+	s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+	s.outliers = d
+
+	d.report(ctx, conns[0], true)
+	for i := 0; i < 10; i++ {
+		c, _ := s.GetConnection(ctx)
+		require.Equal(t, conns[1], c)
+	}
+
+	// all connections ejected - use ejected connection instead of nothing
+	d.report(ctx, conns[1], true)
+	c, _ := s.GetConnection(ctx)
+	require.NotNil(t, c)
+}
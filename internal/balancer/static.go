@@ -0,0 +1,103 @@
+package balancer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xresolver"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// staticDiscovery is a discovery client over fixed list of addresses
+// with optional resolving of hosts into addresses instead of ListEndpoints call
+//
+// Discover may be called more often than resolveInterval (on each discovery interval or on force
+// of rediscovery), so resolved addresses are cached and hosts are resolved again only after resolveInterval
+type staticDiscovery struct {
+	addresses       []string
+	resolveInterval time.Duration
+	resolve         func(ctx context.Context, t *trace.Driver, address string) ([]string, error)
+	clock           clockwork.Clock
+	trace           *trace.Driver
+
+	mu         sync.Mutex
+	resolved   []endpoint.Endpoint
+	resolvedAt time.Time
+}
+
+func newStaticDiscovery(addresses []string, resolveInterval time.Duration, t *trace.Driver) *staticDiscovery {
+	return &staticDiscovery{
+		addresses:       addresses,
+		resolveInterval: resolveInterval,
+		resolve:         xresolver.Resolve,
+		clock:           clockwork.NewRealClock(),
+		trace:           t,
+	}
+}
+
+func (d *staticDiscovery) Discover(ctx context.Context) ([]endpoint.Endpoint, error) {
+	if d.resolveInterval <= 0 {
+		return toEndpoints(d.addresses), nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.resolved != nil && d.clock.Since(d.resolvedAt) < d.resolveInterval {
+		return append([]endpoint.Endpoint(nil), d.resolved...), nil
+	}
+
+	var (
+		addrs = make([]string, 0, len(d.addresses))
+		errs  []error
+	)
+	for _, address := range d.addresses {
+		resolved, err := d.resolve(ctx, d.trace, address)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+		addrs = append(addrs, resolved...)
+	}
+
+	// partially resolved list of endpoints is better than nothing
+	if len(addrs) == 0 && len(errs) > 0 {
+		// previously resolved endpoints are better than nothing too
+		if d.resolved != nil {
+			return append([]endpoint.Endpoint(nil), d.resolved...), nil
+		}
+
+		return nil, xerrors.WithStackTrace(xerrors.Join(errs...))
+	}
+
+	d.resolved, d.resolvedAt = toEndpoints(addrs), d.clock.Now()
+
+	// caller may reorder endpoints, so cached endpoints are returned as copy
+	return append([]endpoint.Endpoint(nil), d.resolved...), nil
+}
+
+func (d *staticDiscovery) Close(ctx context.Context) error {
+	return nil
+}
+
+func toEndpoints(addrs []string) []endpoint.Endpoint {
+	var (
+		endpoints = make([]endpoint.Endpoint, 0, len(addrs))
+		known     = make(map[string]struct{}, len(addrs))
+	)
+	for _, addr := range addrs {
+		if _, has := known[addr]; has {
+			continue
+		}
+		known[addr] = struct{}{}
+		endpoints = append(endpoints, endpoint.New(addr))
+	}
+
+	return endpoints
+}
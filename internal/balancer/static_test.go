@@ -0,0 +1,150 @@
+package balancer
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func endpointsAddresses(endpoints []endpoint.Endpoint) (addrs []string) {
+	for _, e := range endpoints {
+		addrs = append(addrs, e.Address())
+	}
+
+	return addrs
+}
+
+type testResolver struct {
+	hosts map[string][]string
+	calls int
+}
+
+func (r *testResolver) resolve(ctx context.Context, t *trace.Driver, address string) ([]string, error) {
+	r.calls++
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	ips, has := r.hosts[host]
+	if !has {
+		return nil, errors.New("no such host")
+	}
+	addrs := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		addrs = append(addrs, net.JoinHostPort(ip, port))
+	}
+
+	return addrs, nil
+}
+
+func TestStaticDiscovery(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("AsIs", func(t *testing.T) {
+		d := newStaticDiscovery([]string{"a:2135", "b:2135", "a:2135"}, 0, &trace.Driver{})
+		endpoints, err := d.Discover(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a:2135", "b:2135"}, endpointsAddresses(endpoints))
+	})
+
+	t.Run("Resolve", func(t *testing.T) {
+		r := &testResolver{hosts: map[string][]string{
+			"a": {"10.0.0.1", "10.0.0.2"},
+			"b": {"10.0.0.2"},
+		}}
+		clock := clockwork.NewFakeClock()
+		d := newStaticDiscovery([]string{"a:2135", "b:2135"}, time.Minute, &trace.Driver{})
+		d.resolve, d.clock = r.resolve, clock
+
+		endpoints, err := d.Discover(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"10.0.0.1:2135", "10.0.0.2:2135"}, endpointsAddresses(endpoints))
+		require.Equal(t, 2, r.calls)
+
+		// rediscovery before resolve interval uses previously resolved endpoints
+		r.hosts["b"] = []string{"10.0.0.3"}
+		endpoints, err = d.Discover(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"10.0.0.1:2135", "10.0.0.2:2135"}, endpointsAddresses(endpoints))
+		require.Equal(t, 2, r.calls)
+
+		clock.Advance(time.Minute)
+		endpoints, err = d.Discover(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"10.0.0.1:2135", "10.0.0.2:2135", "10.0.0.3:2135"}, endpointsAddresses(endpoints))
+		require.Equal(t, 4, r.calls)
+
+		// failed resolving keeps previously resolved endpoints
+		r.hosts = nil
+		clock.Advance(time.Minute)
+		endpoints, err = d.Discover(ctx)
+		require.NoError(t, err)
+		require.Len(t, endpoints, 3)
+	})
+
+	t.Run("ResolveFailed", func(t *testing.T) {
+		d := newStaticDiscovery([]string{"without-port"}, time.Minute, &trace.Driver{})
+		d.resolve = (&testResolver{}).resolve
+		_, err := d.Discover(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestStaticEndpointsBalancer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RediscoveryAllowsBannedEndpoint", func(t *testing.T) {
+		cfg := config.New(config.WithStaticEndpoints("a:2135", "b:2135"))
+		pool := conn.NewPool(ctx, cfg)
+		defer func() {
+			_ = pool.Release(ctx)
+		}()
+		b, err := New(ctx, cfg, pool, discoveryConfig.WithInterval(time.Hour))
+		require.NoError(t, err)
+		defer func() {
+			_ = b.Close(ctx)
+		}()
+
+		// static endpoints without DNS resolving are rediscovered too
+		require.NotNil(t, b.discoveryRepeater)
+		require.Len(t, b.connections().all, 2)
+
+		banned := b.connections().all[0]
+		pool.Ban(ctx, banned, errors.New("test"))
+		require.Equal(t, conn.Banned, banned.GetState())
+
+		require.NoError(t, b.clusterDiscoveryAttempt(ctx))
+		require.NotEqual(t, conn.Banned, banned.GetState())
+	})
+
+	t.Run("SingleConn", func(t *testing.T) {
+		cfg := config.New(
+			config.WithStaticEndpoints("a:2135", "b:2135"),
+			config.WithBalancer(balancers.SingleConn()),
+		)
+		pool := conn.NewPool(ctx, cfg)
+		defer func() {
+			_ = pool.Release(ctx)
+		}()
+		b, err := New(ctx, cfg, pool)
+		require.NoError(t, err)
+		defer func() {
+			_ = b.Close(ctx)
+		}()
+
+		require.Len(t, b.connections().all, 1)
+		require.Equal(t, "a:2135", b.connections().all[0].Endpoint().Address())
+	})
+}
@@ -0,0 +1,139 @@
+package balancer
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// statsDecay is a weight of newest observation in moving averages of latency and error rate
+const statsDecay = 0.2
+
+// connStats accumulates statistics of calls over single connection
+type connStats struct {
+	inFlight atomic.Int64
+
+	mu        sync.Mutex
+	latency   float64
+	errorRate float64
+}
+
+func (s *connStats) start() {
+	s.inFlight.Add(1)
+}
+
+func (s *connStats) done(latency time.Duration, failed bool) {
+	s.inFlight.Add(-1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.latency == 0 {
+		s.latency = float64(latency)
+	} else {
+		s.latency += statsDecay * (float64(latency) - s.latency)
+	}
+
+	var failure float64
+	if failed {
+		failure = 1
+	}
+	s.errorRate += statsDecay * (failure - s.errorRate)
+}
+
+func (s *connStats) snapshot(c conn.Conn) balancerConfig.ConnStats {
+	e := c.Endpoint()
+	stats := balancerConfig.ConnStats{
+		NodeID:   e.NodeID(),
+		Address:  e.Address(),
+		Location: e.Location(),
+		InFlight: s.inFlight.Load(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats.Latency = time.Duration(s.latency)
+	stats.ErrorRate = s.errorRate
+
+	return stats
+}
+
+// connsStats holds statistics of connections between discovery updates
+type connsStats struct {
+	mu    sync.RWMutex
+	stats map[conn.Conn]*connStats
+}
+
+func newConnsStats() *connsStats {
+	return &connsStats{
+		stats: make(map[conn.Conn]*connStats),
+	}
+}
+
+func (s *connsStats) get(c conn.Conn) *connStats {
+	s.mu.RLock()
+	stats, has := s.stats[c]
+	s.mu.RUnlock()
+	if has {
+		return stats
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if stats, has = s.stats[c]; !has {
+		stats = &connStats{}
+		s.stats[c] = stats
+	}
+
+	return stats
+}
+
+// retain drops statistics of connections which not contains in conns
+func (s *connsStats) retain(conns []conn.Conn) {
+	actual := make(map[conn.Conn]struct{}, len(conns))
+	for _, c := range conns {
+		actual[c] = struct{}{}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for c := range s.stats {
+		if _, has := actual[c]; !has {
+			delete(s.stats, c)
+		}
+	}
+}
+
+// statsCandidates is a view over connections with their statistics for balancing policy
+type statsCandidates struct {
+	conns []conn.Conn
+	stats *connsStats
+}
+
+func (c statsCandidates) Len() int {
+	return len(c.conns)
+}
+
+func (c statsCandidates) Stats(i int) balancerConfig.ConnStats {
+	return c.stats.get(c.conns[i]).snapshot(c.conns[i])
+}
+
+// isEndpointFailure checks that error caused by endpoint (not by request or client side)
+func isEndpointFailure(err error, excludeCodes ...grpcCodes.Code) bool {
+	if err == nil {
+		return false
+	}
+
+	return xerrors.MustPessimizeEndpoint(err, excludeCodes...) ||
+		xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED, Ydb.StatusIds_UNAVAILABLE)
+}
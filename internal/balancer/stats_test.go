@@ -0,0 +1,75 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+)
+
+type fixedPolicy int
+
+func (p fixedPolicy) Choose(candidates balancerConfig.Candidates) int {
+	return int(p)
+}
+
+func (p fixedPolicy) String() string {
+	return "Fixed"
+}
+
+func TestConnStats(t *testing.T) {
+	var (
+		c     = &mock.Conn{AddrField: "1", NodeIDField: 1, LocationField: "A"}
+		stats = newConnsStats()
+		s     = stats.get(c)
+	)
+	require.Same(t, s, stats.get(c))
+
+	s.start()
+	s.start()
+	require.Equal(t, balancerConfig.ConnStats{
+		NodeID:   1,
+		Address:  "1",
+		Location: "A",
+		InFlight: 2,
+	}, statsCandidates{conns: []conn.Conn{c}, stats: stats}.Stats(0))
+
+	s.done(100*time.Millisecond, false)
+	s.done(200*time.Millisecond, true)
+	snapshot := s.snapshot(c)
+	require.EqualValues(t, 0, snapshot.InFlight)
+	require.Equal(t, 120*time.Millisecond, snapshot.Latency)
+	require.InDelta(t, 0.2, snapshot.ErrorRate, 1e-9)
+
+	stats.retain(nil)
+	require.NotSame(t, s, stats.get(c))
+}
+
+func TestConnectionsStatePolicy(t *testing.T) {
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "1", State: conn.Online},
+		&mock.Conn{AddrField: "2", State: conn.Banned},
+	}
+
+	t.Run("Chosen", func(t *testing.T) {
+		s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+		s.policy, s.stats = fixedPolicy(0), newConnsStats()
+		for i := 0; i < 10; i++ {
+			c, failed := s.GetConnection(context.Background())
+			require.Equal(t, conns[0], c)
+			require.Zero(t, failed)
+		}
+	})
+
+	t.Run("FallbackToRandom", func(t *testing.T) {
+		s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+		s.policy, s.stats = fixedPolicy(1), newConnsStats()
+		c, _ := s.GetConnection(context.Background())
+		require.Equal(t, conns[0], c)
+	})
+}
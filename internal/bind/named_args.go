@@ -0,0 +1,228 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// NamedArgs rewrites named placeholders @name and :name into YQL parameters $name
+// and declares types of parameters by values of named args (sql.Named)
+type NamedArgs struct{}
+
+func (m NamedArgs) blockID() blockID {
+	return blockYQL
+}
+
+//nolint:funlen
+func (m NamedArgs) RewriteQuery(sql string, args ...interface{}) (
+	yql string, newArgs []interface{}, err error,
+) {
+	l := &sqlLexer{
+		src:        sql,
+		stateFn:    namedArgsStateFn,
+		rawStateFn: namedArgsStateFn,
+	}
+
+	for l.stateFn != nil {
+		l.stateFn = l.stateFn(l)
+	}
+
+	named := make(map[string]*params.Parameter, len(args))
+	for i, arg := range args {
+		name, param, err := toNamedParam(arg)
+		if err != nil {
+			return "", nil, xerrors.WithStackTrace(fmt.Errorf("arg #%d: %w", i, err))
+		}
+		if _, has := named[name]; has {
+			return "", nil, xerrors.WithStackTrace(fmt.Errorf("%w: duplicate arg %q", ErrInconsistentArgs, name))
+		}
+		named[name] = param
+	}
+
+	var (
+		buffer = xstring.Buffer()
+		used   = make(map[string]bool, len(named))
+	)
+	defer buffer.Free()
+
+	for _, p := range l.parts {
+		switch p := p.(type) {
+		case string:
+			buffer.WriteString(p)
+		case namedArg:
+			param, has := named[p.name]
+			if !has {
+				return "", nil, xerrors.WithStackTrace(
+					fmt.Errorf("%w: no arg for placeholder %c%s", ErrInconsistentArgs, p.prefix, p.name),
+				)
+			}
+			if !used[p.name] {
+				used[p.name] = true
+				newArgs = append(newArgs, param)
+			}
+			buffer.WriteString(param.Name())
+		}
+	}
+
+	if len(used) != len(named) {
+		unused := make([]string, 0, len(named)-len(used))
+		for name := range named {
+			if !used[name] {
+				unused = append(unused, name)
+			}
+		}
+		sort.Strings(unused)
+
+		return "", nil, xerrors.WithStackTrace(
+			fmt.Errorf("%w: unused args %s", ErrInconsistentArgs, strings.Join(unused, ", ")),
+		)
+	}
+
+	if len(newArgs) == 0 {
+		return buffer.String(), newArgs, nil
+	}
+
+	const prefix = "-- origin query with named args replacement\n"
+
+	yql, newArgs, err = AutoDeclare{}.RewriteQuery(prefix+buffer.String(), newArgs...)
+	if err != nil {
+		return "", nil, xerrors.WithStackTrace(err)
+	}
+
+	return yql, newArgs, nil
+}
+
+// toNamedParam makes parameter from named arg. Name of arg may be with prefix (@, : or $) or without it
+func toNamedParam(arg interface{}) (name string, _ *params.Parameter, _ error) {
+	switch x := arg.(type) {
+	case driver.NamedValue:
+		name, arg = x.Name, x.Value
+	case sql.NamedArg:
+		name, arg = x.Name, x.Value
+	}
+	if p, ok := arg.(*params.Parameter); ok {
+		name = p.Name()
+	}
+	name = strings.TrimLeft(name, "@:$")
+	if name == "" {
+		return "", nil, xerrors.WithStackTrace(errUnnamedParam)
+	}
+	param, err := toYdbParam("$"+name, arg)
+	if err != nil {
+		return "", nil, xerrors.WithStackTrace(err)
+	}
+
+	return name, param, nil
+}
+
+type namedArg struct {
+	prefix rune
+	name   string
+}
+
+func isNamedArgRune(r rune) bool {
+	return isLetter(r) || isNumber(r) || r == '_'
+}
+
+func namedArgsStateFn(l *sqlLexer) stateFn {
+	for {
+		r, width := utf8.DecodeRuneInString(l.src[l.pos:])
+		l.pos += width
+
+		switch r {
+		case '`':
+			return backtickState
+		case '\'':
+			return singleQuoteState
+		case '"':
+			return doubleQuoteState
+		case '@', ':':
+			nextRune, nextWidth := utf8.DecodeRuneInString(l.src[l.pos:])
+			switch {
+			case r == '@' && nextRune == '@':
+				// YQL multiline string @@...@@
+				l.pos += nextWidth
+
+				return rawStringState
+			case r == ':' && nextRune == ':':
+				// postgres cast (::int) and YQL UDF call (Module::Func)
+				l.pos += nextWidth
+			case (isLetter(nextRune) || nextRune == '_') && !l.afterNameRune(l.pos-width):
+				l.parts = append(l.parts, l.src[l.start:l.pos-width])
+				start := l.pos
+				for {
+					nextRune, nextWidth = utf8.DecodeRuneInString(l.src[l.pos:])
+					if !isNamedArgRune(nextRune) {
+						break
+					}
+					l.pos += nextWidth
+				}
+				l.parts = append(l.parts, namedArg{prefix: r, name: l.src[start:l.pos]})
+				l.start = l.pos
+			}
+		case '-':
+			nextRune, width := utf8.DecodeRuneInString(l.src[l.pos:])
+			if nextRune == '-' {
+				l.pos += width
+
+				return oneLineCommentState
+			}
+		case '/':
+			nextRune, width := utf8.DecodeRuneInString(l.src[l.pos:])
+			if nextRune == '*' {
+				l.pos += width
+
+				return multilineCommentState
+			}
+		case utf8.RuneError:
+			if l.pos-l.start > 0 {
+				l.parts = append(l.parts, l.src[l.start:l.pos])
+				l.start = l.pos
+			}
+
+			return nil
+		}
+	}
+}
+
+// afterNameRune reports whether rune before pos is a part of identifier (such as in struct literal <|a:b|>)
+func (l *sqlLexer) afterNameRune(pos int) bool {
+	r, _ := utf8.DecodeLastRuneInString(l.src[:pos])
+
+	return isNamedArgRune(r) || r == '$'
+}
+
+// rawStringState skips YQL multiline string @@...@@ (@@@@ inside is an escaped @@)
+func rawStringState(l *sqlLexer) stateFn {
+	for {
+		r, width := utf8.DecodeRuneInString(l.src[l.pos:])
+		l.pos += width
+
+		switch r {
+		case '@':
+			if !strings.HasPrefix(l.src[l.pos:], "@") {
+				continue
+			}
+			l.pos++
+			if !strings.HasPrefix(l.src[l.pos:], "@@") {
+				return l.rawStateFn
+			}
+			l.pos += 2
+		case utf8.RuneError:
+			if l.pos-l.start > 0 {
+				l.parts = append(l.parts, l.src[l.start:l.pos])
+				l.start = l.pos
+			}
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestNamedArgsBindRewriteQuery(t *testing.T) {
+	b := NamedArgs{}
+	for _, tt := range []struct {
+		sql    string
+		args   []interface{}
+		yql    string
+		params []interface{}
+		err    error
+	}{
+		{
+			sql: `SELECT 1`,
+			yql: `SELECT 1`,
+		},
+		{
+			sql: `SELECT * FROM t WHERE id = @id AND name = :name`,
+			args: []interface{}{
+				sql.Named("id", 100),
+				sql.Named("name", "test"),
+			},
+			yql: `-- bind declares
+DECLARE $id AS Int32;
+DECLARE $name AS Utf8;
+
+-- origin query with named args replacement
+SELECT * FROM t WHERE id = $id AND name = $name`,
+			params: []interface{}{
+				table.ValueParam("$id", types.Int32Value(100)),
+				table.ValueParam("$name", types.TextValue("test")),
+			},
+		},
+		{
+			sql: `SELECT @a, @a + :b_1`,
+			args: []interface{}{
+				driver.NamedValue{Name: "b_1", Ordinal: 1, Value: int64(1)},
+				driver.NamedValue{Name: "a", Ordinal: 2, Value: uint64(2)},
+			},
+			yql: `-- bind declares
+DECLARE $a AS Uint64;
+DECLARE $b_1 AS Int64;
+
+-- origin query with named args replacement
+SELECT $a, $a + $b_1`,
+			params: []interface{}{
+				table.ValueParam("$a", types.Uint64Value(2)),
+				table.ValueParam("$b_1", types.Int64Value(1)),
+			},
+		},
+		{
+			sql: `SELECT @id`,
+			args: []interface{}{
+				table.ValueParam("$id", types.BoolValue(true)),
+			},
+			yql: `-- bind declares
+DECLARE $id AS Bool;
+
+-- origin query with named args replacement
+SELECT $id`,
+			params: []interface{}{
+				table.ValueParam("$id", types.BoolValue(true)),
+			},
+		},
+		{
+			sql: "SELECT '@a', \":a\", `@a`, @@ @a @@, -- @a\n /* :a */ CAST(x AS Int32)::int, Udf::Func(:a), <|b:c|>, " +
+				"user@host, $x:Int32",
+			args: []interface{}{
+				sql.Named("a", 1),
+			},
+			yql: `-- bind declares
+DECLARE $a AS Int32;
+
+-- origin query with named args replacement
+SELECT '@a', ":a", ` + "`@a`" + `, @@ @a @@, -- @a
+ /* :a */ CAST(x AS Int32)::int, Udf::Func($a), <|b:c|>, user@host, $x:Int32`,
+			params: []interface{}{
+				table.ValueParam("$a", types.Int32Value(1)),
+			},
+		},
+		{
+			sql: `SELECT @a`,
+			err: ErrInconsistentArgs,
+		},
+		{
+			sql: `SELECT @a`,
+			args: []interface{}{
+				sql.Named("a", 1),
+				sql.Named("b", 1),
+			},
+			err: ErrInconsistentArgs,
+		},
+		{
+			sql: `SELECT @a`,
+			args: []interface{}{
+				sql.Named("a", 1),
+				sql.Named("@a", 2),
+			},
+			err: ErrInconsistentArgs,
+		},
+		{
+			sql: `SELECT @a`,
+			args: []interface{}{
+				1,
+			},
+			err: errUnnamedParam,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			yql, params, err := b.RewriteQuery(tt.sql, tt.args...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.yql, yql)
+				require.Equal(t, tt.params, params)
+			}
+		})
+	}
+}
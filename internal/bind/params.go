@@ -23,6 +23,12 @@ var (
 
 //nolint:gocyclo
 func toValue(v interface{}) (_ types.Value, err error) {
+	if converter, ok := v.(value.ValueConverter); ok {
+		v, err = converter.ToYDBValue()
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	}
 	if valuer, ok := v.(driver.Valuer); ok {
 		v, err = valuer.Value()
 		if err != nil {
@@ -105,13 +111,6 @@ func toValue(v interface{}) (_ types.Value, err error) {
 		return types.TextValue(x), nil
 	case *string:
 		return types.NullableTextValue(x), nil
-	case []string:
-		items := make([]types.Value, len(x))
-		for i := range x {
-			items[i] = types.TextValue(x[i])
-		}
-
-		return types.ListValue(items...), nil
 	case [16]byte:
 		return types.UUIDValue(x), nil
 	case *[16]byte:
@@ -125,11 +124,17 @@ func toValue(v interface{}) (_ types.Value, err error) {
 	case *time.Duration:
 		return types.NullableIntervalValueFromDuration(x), nil
 	default:
-		return nil, xerrors.WithStackTrace(
-			fmt.Errorf("%T: %w. Create issue for support new type %s",
-				x, errUnsupportedType, supportNewTypeLink(x),
-			),
-		)
+		// structs, slices (including empty ones as typed List), maps and other go types converts by reflection
+		v, err := value.FromGo(x)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(
+				fmt.Errorf("%T: %w: %w. Create issue for support new type %s",
+					x, errUnsupportedType, err, supportNewTypeLink(x),
+				),
+			)
+		}
+
+		return v, nil
 	}
 }
 
@@ -162,7 +167,7 @@ func toYdbParam(name string, value interface{}) (*params.Parameter, error) {
 	}
 	v, err := toValue(value)
 	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
+		return nil, xerrors.WithStackTrace(fmt.Errorf("parameter %q: %w", name, err))
 	}
 	if name == "" {
 		return nil, xerrors.WithStackTrace(errUnnamedParam)
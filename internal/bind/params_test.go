@@ -4,11 +4,13 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/decimal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
@@ -309,6 +311,11 @@ func TestToValue(t *testing.T) {
 			dst: types.NullValue(types.TypeTimestamp),
 			err: nil,
 		},
+		{
+			src: types.Timestamp64ValueFromTime(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)),
+			dst: types.Timestamp64ValueFromTime(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)),
+			err: nil,
+		},
 
 		{
 			src: time.Duration(42),
@@ -325,6 +332,39 @@ func TestToValue(t *testing.T) {
 			dst: types.NullValue(types.TypeInterval),
 			err: nil,
 		},
+		{
+			src: []int64{1, 2},
+			dst: types.ListValue(types.Int64Value(1), types.Int64Value(2)),
+			err: nil,
+		},
+		{
+			src: map[string]int64{"b": 2, "a": 1},
+			dst: types.DictValue(
+				types.DictFieldValue(types.TextValue("a"), types.Int64Value(1)),
+				types.DictFieldValue(types.TextValue("b"), types.Int64Value(2)),
+			),
+			err: nil,
+		},
+		{
+			src: struct {
+				ID    uint64 `sql:"id"`
+				Title string `sql:"title"`
+			}{ID: 1, Title: "test"},
+			dst: types.StructValue(
+				types.StructFieldValue("id", types.Uint64Value(1)),
+				types.StructFieldValue("title", types.TextValue("test")),
+			),
+			err: nil,
+		},
+		{
+			src: decimal.MustParse("12.50", 22, 2),
+			dst: types.DecimalValueFromBigInt(big.NewInt(1250), 22, 2),
+			err: nil,
+		},
+		{
+			src: make(chan int),
+			err: errUnsupportedType,
+		},
 	} {
 		t.Run(fmt.Sprintf("%T(%v)", tt.src, tt.src), func(t *testing.T) {
 			dst, err := toValue(tt.src)
@@ -335,6 +375,20 @@ func TestToValue(t *testing.T) {
 			}
 		})
 	}
+	t.Run("EmptySlice", func(t *testing.T) {
+		for _, tt := range []struct {
+			src interface{}
+			yql string
+		}{
+			{src: []string{}, yql: "List<Utf8>"},
+			{src: []uint64(nil), yql: "List<Uint64>"},
+			{src: map[string]bool{}, yql: "Dict<Utf8,Bool>"},
+		} {
+			dst, err := toValue(tt.src)
+			require.NoError(t, err)
+			require.Equal(t, tt.yql, dst.Type().Yql())
+		}
+	})
 }
 
 func named(name string, value interface{}) driver.NamedValue {
@@ -385,6 +439,12 @@ func TestYdbParam(t *testing.T) {
 			}
 		})
 	}
+	t.Run("FromGoError", func(t *testing.T) {
+		_, err := Params(int(1), struct{ C chan int }{})
+		require.ErrorIs(t, err, errUnsupportedType)
+		require.ErrorContains(t, err, `parameter "$p1"`)
+		require.ErrorContains(t, err, "chan int")
+	})
 }
 
 func TestArgsToParams(t *testing.T) {
@@ -0,0 +1,71 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const pgSyntaxPragma = "--!syntax_pg"
+
+var errNamedPgArg = errors.New("named args are not supported with PostgreSQL syntax, use positional args")
+
+// PgArgs binds args of query in PostgreSQL syntax. Placeholders $1, $2, ... are kept in query text
+// and args are passed as parameters $p1, $p2, ... of postgres types by types of go values
+type PgArgs struct{}
+
+func (m PgArgs) blockID() blockID {
+	return blockPragma
+}
+
+func (m PgArgs) RewriteQuery(query string, args ...interface{}) (
+	yql string, newArgs []interface{}, err error,
+) {
+	for i, arg := range args {
+		param, err := toPgParam("$p"+strconv.Itoa(i+1), arg)
+		if err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+		newArgs = append(newArgs, param)
+	}
+
+	if !strings.HasPrefix(strings.TrimSpace(query), pgSyntaxPragma) {
+		return pgSyntaxPragma + "\n" + query, newArgs, nil
+	}
+
+	return query, newArgs, nil
+}
+
+func toPgParam(name string, arg interface{}) (*params.Parameter, error) {
+	if nv, ok := arg.(driver.NamedValue); ok {
+		if nv.Name != "" {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errNamedPgArg, nv.Name))
+		}
+		if nv.Ordinal > 0 {
+			name = "$p" + strconv.Itoa(nv.Ordinal)
+		}
+		arg = nv.Value
+	}
+	if na, ok := arg.(sql.NamedArg); ok {
+		if na.Name != "" {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errNamedPgArg, na.Name))
+		}
+		arg = na.Value
+	}
+	if p, ok := arg.(*params.Parameter); ok {
+		return p, nil
+	}
+	v, err := value.PgValueFromGo(arg)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return params.Named(name, v), nil
+}
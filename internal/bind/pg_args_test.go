@@ -0,0 +1,102 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestPgArgsBindRewriteQuery(t *testing.T) {
+	b := PgArgs{}
+	for _, tt := range []struct {
+		sql    string
+		args   []interface{}
+		yql    string
+		params []interface{}
+		err    bool
+	}{
+		{
+			sql: `SELECT 1`,
+			yql: "--!syntax_pg\nSELECT 1",
+		},
+		{
+			sql: "--!syntax_pg\nSELECT $1::int4, $2",
+			args: []interface{}{
+				int32(100),
+				"abc",
+			},
+			yql: "--!syntax_pg\nSELECT $1::int4, $2",
+			params: []interface{}{
+				params.Named("$p1", value.PgValue(pg.OIDInt4, "100")),
+				params.Named("$p2", value.PgValue(pg.OIDText, "abc")),
+			},
+		},
+		{
+			sql: `SELECT * FROM t WHERE id = ANY($1) AND deleted_at IS NOT DISTINCT FROM $2`,
+			args: []interface{}{
+				driver.NamedValue{Ordinal: 1, Value: []int64{1, 2}},
+				driver.NamedValue{Ordinal: 2, Value: (*int64)(nil)},
+			},
+			yql: "--!syntax_pg\nSELECT * FROM t WHERE id = ANY($1) AND deleted_at IS NOT DISTINCT FROM $2",
+			params: []interface{}{
+				params.Named("$p1", value.PgValue(pg.OIDInt8Array, `{"1","2"}`)),
+				params.Named("$p2", value.PgNullValue(pg.OIDInt8)),
+			},
+		},
+		{
+			sql: `SELECT $1, $2`,
+			args: []interface{}{
+				sql.Named("", int64(1)),
+				driver.NamedValue{Ordinal: 2, Value: sql.Named("", "a")},
+			},
+			yql: "--!syntax_pg\nSELECT $1, $2",
+			params: []interface{}{
+				params.Named("$p1", value.PgValue(pg.OIDInt8, "1")),
+				params.Named("$p2", value.PgValue(pg.OIDText, "a")),
+			},
+		},
+		{
+			sql: `SELECT $1`,
+			args: []interface{}{
+				params.Named("$p1", value.PgValue(pg.OIDUnknown, "x")),
+			},
+			yql: "--!syntax_pg\nSELECT $1",
+			params: []interface{}{
+				params.Named("$p1", value.PgValue(pg.OIDUnknown, "x")),
+			},
+		},
+		{
+			sql: `SELECT $1`,
+			args: []interface{}{
+				struct{}{},
+			},
+			err: true,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			yql, params, err := b.RewriteQuery(tt.sql, tt.args...)
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.yql, yql)
+				require.Equal(t, tt.params, params)
+			}
+		})
+	}
+	t.Run("NamedArgs", func(t *testing.T) {
+		for _, arg := range []interface{}{
+			sql.Named("p1", true),
+			driver.NamedValue{Name: "id", Ordinal: 1, Value: int64(1)},
+		} {
+			_, _, err := b.RewriteQuery(`SELECT $1`, arg)
+			require.ErrorIs(t, err, errNamedPgArg)
+		}
+	})
+}
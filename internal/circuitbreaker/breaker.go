@@ -0,0 +1,201 @@
+package circuitbreaker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+type State int
+
+const (
+	// Closed state allows all calls
+	Closed = State(iota)
+	// HalfOpen state allows limited count of probe calls after cool-down
+	HalfOpen
+	// Open state rejects all calls until cool-down ends
+	Open
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case HalfOpen:
+		return "half-open"
+	case Open:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// Config is a settings of circuit breaker
+type Config struct {
+	// FailureRateThreshold is a share of failed calls in range (0, 1] within Interval for opening of circuit
+	FailureRateThreshold float64
+	// MinRequests is a minimal count of calls within Interval for checking of failure rate
+	MinRequests int
+	// Interval is a window of failure rate calculation
+	Interval time.Duration
+
+	// CoolDown is a duration of open state before moving to half-open state
+	CoolDown time.Duration
+	// HalfOpenRequests is a count of probe calls in half-open state.
+	// Circuit closes if all probe calls succeeded and opens again on first failed probe call
+	HalfOpenRequests int
+}
+
+// Transition is a change of circuit breaker state
+type Transition struct {
+	From State
+	To   State
+}
+
+// Changed checks that state of circuit breaker was changed
+func (t Transition) Changed() bool {
+	return t.From != t.To
+}
+
+type Option func(b *Breaker)
+
+func WithClock(clock clockwork.Clock) Option {
+	return func(b *Breaker) {
+		b.clock = clock
+	}
+}
+
+// Breaker is a circuit breaker which opens if failure rate of calls crosses threshold
+type Breaker struct {
+	config Config
+	clock  clockwork.Clock
+
+	mu          sync.Mutex
+	state       State
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probes      int
+	successes   int
+}
+
+func New(config Config, opts ...Option) *Breaker {
+	b := &Breaker{
+		config: config,
+		clock:  clockwork.NewRealClock(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(b)
+		}
+	}
+	b.windowStart = b.clock.Now()
+
+	return b
+}
+
+// State returns current state of circuit breaker
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
+
+// Ready checks that call will be allowed by circuit breaker without changing of state
+func (b *Breaker) Ready() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Open:
+		return !b.clock.Now().Before(b.openedAt.Add(b.config.CoolDown))
+	case HalfOpen:
+		return b.probes < b.halfOpenRequests()
+	default:
+		return true
+	}
+}
+
+// Allow checks that call is allowed by circuit breaker.
+// If call is not allowed returns false and time when circuit breaker moves to half-open state
+func (b *Breaker) Allow() (allowed bool, until time.Time, t Transition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.clock.Now()
+	t.From = b.state
+	switch b.state {
+	case Open:
+		until = b.openedAt.Add(b.config.CoolDown)
+		if now.Before(until) {
+			return false, until, Transition{From: Open, To: Open}
+		}
+		b.state, b.probes, b.successes = HalfOpen, 0, 0
+
+		fallthrough
+	case HalfOpen:
+		if b.probes >= b.halfOpenRequests() {
+			return false, now, Transition{From: t.From, To: b.state}
+		}
+		b.probes++
+	case Closed:
+	}
+	t.To = b.state
+
+	return true, time.Time{}, t
+}
+
+// Done accounts result of allowed call
+func (b *Breaker) Done(failed bool) (t Transition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.clock.Now()
+	t.From = b.state
+	switch b.state {
+	case Closed:
+		if interval := b.config.Interval; interval > 0 && now.Sub(b.windowStart) > interval {
+			b.windowStart, b.requests, b.failures = now, 0, 0
+		}
+		b.requests++
+		if failed {
+			b.failures++
+		}
+		if threshold := b.config.FailureRateThreshold; threshold > 0 &&
+			b.requests >= b.config.MinRequests &&
+			float64(b.failures) >= threshold*float64(b.requests) {
+			b.open(now)
+		}
+	case HalfOpen:
+		if failed {
+			b.open(now)
+
+			break
+		}
+		b.successes++
+		if b.successes >= b.halfOpenRequests() {
+			b.state, b.windowStart, b.requests, b.failures = Closed, now, 0, 0
+		}
+	case Open:
+		// result of call which allowed before opening of circuit
+	}
+	t.To = b.state
+
+	return t
+}
+
+// open opens circuit. Must be called under lock
+func (b *Breaker) open(now time.Time) {
+	b.state, b.openedAt = Open, now
+}
+
+func (b *Breaker) halfOpenRequests() int {
+	if b.config.HalfOpenRequests < 1 {
+		return 1
+	}
+
+	return b.config.HalfOpenRequests
+}
@@ -0,0 +1,71 @@
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreaker(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	b := New(Config{
+		FailureRateThreshold: 0.5,
+		MinRequests:          4,
+		Interval:             time.Minute,
+		CoolDown:             10 * time.Second,
+		HalfOpenRequests:     2,
+	}, WithClock(clock))
+
+	for _, failed := range []bool{true, false, true} {
+		allowed, _, _ := b.Allow()
+		require.True(t, allowed)
+		require.False(t, b.Done(failed).Changed())
+	}
+	require.Equal(t, Closed, b.State())
+
+	allowed, _, _ := b.Allow()
+	require.True(t, allowed)
+	require.Equal(t, Transition{From: Closed, To: Open}, b.Done(false))
+	require.False(t, b.Ready())
+
+	allowed, until, _ := b.Allow()
+	require.False(t, allowed)
+	require.Equal(t, clock.Now().Add(10*time.Second), until)
+
+	clock.Advance(10 * time.Second)
+	require.True(t, b.Ready())
+	allowed, _, transition := b.Allow()
+	require.True(t, allowed)
+	require.Equal(t, Transition{From: Open, To: HalfOpen}, transition)
+	allowed, _, _ = b.Allow()
+	require.True(t, allowed)
+	allowed, _, _ = b.Allow()
+	require.False(t, allowed, "probes limit")
+
+	require.Equal(t, Transition{From: HalfOpen, To: Open}, b.Done(true))
+	clock.Advance(10 * time.Second)
+	allowed, _, _ = b.Allow()
+	require.True(t, allowed)
+	require.Equal(t, HalfOpen, b.Done(false).To)
+	allowed, _, _ = b.Allow()
+	require.True(t, allowed)
+	require.Equal(t, Transition{From: HalfOpen, To: Closed}, b.Done(false))
+}
+
+func TestBreakerInterval(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	b := New(Config{
+		FailureRateThreshold: 1,
+		MinRequests:          2,
+		Interval:             time.Second,
+	}, WithClock(clock))
+
+	b.Done(true)
+	clock.Advance(2 * time.Second)
+	b.Done(true)
+	require.Equal(t, Closed, b.State())
+	b.Done(true)
+	require.Equal(t, Open, b.State())
+}
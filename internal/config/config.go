@@ -3,6 +3,7 @@ package config
 import (
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -11,6 +12,9 @@ type Common struct {
 	operationCancelAfter time.Duration
 	disableAutoRetry     bool
 	traceRetry           trace.Retry
+	retryBudget          *retry.Budget
+	circuitBreaker       *retry.CircuitBreaker
+	errorClassifier      retry.ErrorClassifier
 
 	panicCallback func(e interface{})
 }
@@ -48,6 +52,24 @@ func (c *Common) TraceRetry() *trace.Retry {
 	return &c.traceRetry
 }
 
+// RetryBudget returns shared retry budget of retry calls.
+// If nil - retry attempts are not limited with retry budget
+func (c *Common) RetryBudget() *retry.Budget {
+	return c.retryBudget
+}
+
+// CircuitBreaker returns circuit breaker of labeled retry calls.
+// If nil - retry calls are not guarded with circuit breaker
+func (c *Common) CircuitBreaker() *retry.CircuitBreaker {
+	return c.circuitBreaker
+}
+
+// ErrorClassifier returns user-defined classifier of retry errors.
+// If nil - errors are classified by driver
+func (c *Common) ErrorClassifier() retry.ErrorClassifier {
+	return c.errorClassifier
+}
+
 // SetOperationTimeout define the maximum amount of time a YDB server will process
 // an operation. After timeout exceeds YDB will try to cancel operation and
 // regardless of the cancellation appropriate error will be returned to
@@ -81,3 +103,18 @@ func SetAutoRetry(c *Common, autoRetry bool) {
 func SetTraceRetry(c *Common, t *trace.Retry, opts ...trace.RetryComposeOption) {
 	c.traceRetry = *c.traceRetry.Compose(t, opts...)
 }
+
+// SetRetryBudget applies shared retry budget to config
+func SetRetryBudget(c *Common, budget *retry.Budget) {
+	c.retryBudget = budget
+}
+
+// SetCircuitBreaker applies circuit breaker of labeled retry calls to config
+func SetCircuitBreaker(c *Common, circuitBreaker *retry.CircuitBreaker) {
+	c.circuitBreaker = circuitBreaker
+}
+
+// SetErrorClassifier applies user-defined classifier of retry errors to config
+func SetErrorClassifier(c *Common, classifier retry.ErrorClassifier) {
+	c.errorClassifier = classifier
+}
@@ -49,6 +49,9 @@ func (c *Client) CreateNode(ctx context.Context, path string, config coordinatio
 		call, retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 }
 
@@ -93,6 +96,9 @@ func (c *Client) AlterNode(ctx context.Context, path string, config coordination
 		retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 }
 
@@ -136,6 +142,9 @@ func (c *Client) DropNode(ctx context.Context, path string) error {
 		retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 }
 
@@ -181,6 +190,9 @@ func (c *Client) DescribeNode(
 		retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 
 	return entry, config, xerrors.WithStackTrace(err)
@@ -0,0 +1,144 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+const defaultChainCacheTTL = time.Minute
+
+var errNoCredentialsInChain = xerrors.Wrap(errors.New("no credentials in chain provided token"))
+
+var (
+	_ Credentials            = (*Chain)(nil)
+	_ fmt.Stringer           = (*Chain)(nil)
+	_ ChainCredentialsOption = SourceInfoOption("")
+)
+
+// expiringCredentials is implemented by credentials which know expiration time of provided token
+type expiringCredentials interface {
+	tokenWithExpiresAt(ctx context.Context) (token string, expiresAt time.Time, err error)
+}
+
+type ChainCredentialsOption interface {
+	ApplyChainCredentialsOption(c *Chain)
+}
+
+// Chain implements Credentials interface with chain of credentials.
+// Chain tries credentials in order and returns token of first credentials which provided non-empty token.
+// Token of chain caches for cache TTL (but not longer than lifetime of token) and refreshes in background
+// after half of TTL
+type Chain struct {
+	providers []Credentials
+	cacheTTL  time.Duration
+
+	cache      *tokenCache
+	sourceInfo string
+}
+
+func NewChainCredentials(providers []Credentials, opts ...ChainCredentialsOption) *Chain {
+	c := &Chain{
+		providers:  providers,
+		cacheTTL:   defaultChainCacheTTL,
+		sourceInfo: stack.Record(1),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyChainCredentialsOption(c)
+		}
+	}
+	c.cache = newTokenCache(c.fetch, nil, 0)
+
+	return c
+}
+
+// Token implements Credentials.
+func (c *Chain) Token(ctx context.Context) (string, error) {
+	return c.cache.Token(ctx)
+}
+
+func (c *Chain) tokenWithExpiresAt(ctx context.Context) (string, time.Time, error) {
+	return c.cache.tokenWithExpiresAt(ctx)
+}
+
+func (c *Chain) fetch(ctx context.Context) (token string, expiresAt time.Time, _ error) {
+	errs := make([]error, 0, len(c.providers)+1)
+	errs = append(errs, errNoCredentialsInChain)
+	for _, provider := range c.providers {
+		token, tokenExpiresAt, err := providerToken(ctx, provider)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w", provider, err))
+
+			continue
+		}
+		if token == "" {
+			continue
+		}
+
+		// cached token of chain must not outlive token of provider
+		expiresAt = c.cache.clock.Now().Add(c.cacheTTL)
+		if !tokenExpiresAt.IsZero() && tokenExpiresAt.Before(expiresAt) {
+			expiresAt = tokenExpiresAt
+		}
+
+		return token, expiresAt, nil
+	}
+
+	return "", expiresAt, xerrors.WithStackTrace(xerrors.Join(errs...))
+}
+
+// providerToken returns token of provider with expiration time. Expiration time of token of provider
+// which doesn't know it takes from token if token is a JWT. Zero expiresAt means that expiration time is unknown
+func providerToken(ctx context.Context, provider Credentials) (token string, expiresAt time.Time, err error) {
+	if expiring, ok := provider.(expiringCredentials); ok {
+		return expiring.tokenWithExpiresAt(ctx)
+	}
+
+	token, err = provider.Token(ctx)
+	if err != nil {
+		return "", expiresAt, err
+	}
+	if expiresAt, err = parseExpiresAt(token); err != nil {
+		// token is not a JWT
+		return token, time.Time{}, nil
+	}
+
+	return token, expiresAt, nil
+}
+
+func (c *Chain) String() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	buffer.WriteString("Chain{Providers:[")
+	for i, provider := range c.providers {
+		if i != 0 {
+			buffer.WriteByte(',')
+		}
+		fmt.Fprintf(buffer, "%v", provider)
+	}
+	buffer.WriteByte(']')
+	if c.sourceInfo != "" {
+		buffer.WriteString(",From:")
+		fmt.Fprintf(buffer, "%q", c.sourceInfo)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
+
+type cacheTTLOption time.Duration
+
+func (ttl cacheTTLOption) ApplyChainCredentialsOption(c *Chain) {
+	c.cacheTTL = time.Duration(ttl)
+}
+
+// WithCacheTTL sets lifetime of cached token of credentials chain. Default lifetime is one minute
+func WithCacheTTL(ttl time.Duration) cacheTTLOption {
+	return cacheTTLOption(ttl)
+}
@@ -0,0 +1,112 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+type credentialsFunc func(ctx context.Context) (string, error)
+
+func (f credentialsFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+func TestChain(t *testing.T) {
+	ctx := context.Background()
+	t.Run("FirstProvidedToken", func(t *testing.T) {
+		var calls atomic.Int32
+		t.Setenv("TEST_YDB_CHAIN_TOKEN", "")
+		c := NewChainCredentials([]Credentials{
+			NewEnvironCredentials(WithEnvironVariable("TEST_YDB_CHAIN_TOKEN")),
+			NewAnonymousCredentials(),
+			credentialsFunc(func(ctx context.Context) (string, error) {
+				calls.Add(1)
+
+				return "token", nil
+			}),
+			NewAccessTokenCredentials("unused"),
+		})
+		for i := 0; i < 3; i++ {
+			token, err := c.Token(ctx)
+			require.NoError(t, err)
+			require.Equal(t, "token", token)
+		}
+		require.EqualValues(t, 1, calls.Load())
+	})
+	t.Run("Environ", func(t *testing.T) {
+		t.Setenv("TEST_YDB_CHAIN_TOKEN", "env")
+		token, err := NewChainCredentials([]Credentials{
+			NewEnvironCredentials(WithEnvironVariable("TEST_YDB_CHAIN_TOKEN")),
+			NewAccessTokenCredentials("unused"),
+		}).Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "env", token)
+	})
+	t.Run("Metadata", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "metadata",
+				"expires_in":   3600,
+				"token_type":   "Bearer",
+			})
+		}))
+		defer server.Close()
+		c := NewChainCredentials([]Credentials{
+			NewTokenFileCredentials("/not/existing/token"),
+			NewMetadataCredentials(WithMetadataURL(server.URL)),
+		}, WithCacheTTL(2*time.Hour))
+		token, err := c.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "metadata", token)
+		// cached token of chain must not outlive token of metadata
+		require.WithinDuration(t, time.Now().Add(time.Hour), c.cache.expiresAt, time.Minute)
+	})
+	t.Run("JWT", func(t *testing.T) {
+		expiresAt := time.Now().Add(10 * time.Second).Truncate(time.Second)
+		jwtToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		}).SignedString([]byte("secret"))
+		require.NoError(t, err)
+		c := NewChainCredentials([]Credentials{
+			credentialsFunc(func(ctx context.Context) (string, error) {
+				return jwtToken, nil
+			}),
+		})
+		token, err := c.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, jwtToken, token)
+		require.Equal(t, expiresAt, c.cache.expiresAt)
+	})
+	t.Run("CacheTTL", func(t *testing.T) {
+		clock := clockwork.NewFakeClockAt(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+		c := NewChainCredentials([]Credentials{
+			NewAccessTokenCredentials("token"),
+		}, WithCacheTTL(time.Hour))
+		c.cache.clock = clock
+		_, expiresAt, err := c.tokenWithExpiresAt(ctx)
+		require.NoError(t, err)
+		require.Equal(t, clock.Now().Add(time.Hour), expiresAt)
+	})
+	t.Run("NoToken", func(t *testing.T) {
+		errProvider := errors.New("provider error")
+		_, err := NewChainCredentials([]Credentials{
+			credentialsFunc(func(ctx context.Context) (string, error) {
+				return "", errProvider
+			}),
+			NewAnonymousCredentials(),
+		}).Token(ctx)
+		require.ErrorIs(t, err, errNoCredentialsInChain)
+		require.ErrorIs(t, err, errProvider)
+	})
+}
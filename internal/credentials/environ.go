@@ -0,0 +1,80 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// DefaultAccessTokenEnvironVariable is a default name of environment variable with access token
+const DefaultAccessTokenEnvironVariable = "YDB_ACCESS_TOKEN_CREDENTIALS"
+
+var (
+	_ Credentials              = (*Environ)(nil)
+	_ fmt.Stringer             = (*Environ)(nil)
+	_ EnvironCredentialsOption = SourceInfoOption("")
+)
+
+type EnvironCredentialsOption interface {
+	ApplyEnvironCredentialsOption(c *Environ)
+}
+
+// Environ implements Credentials interface with access token from environment variable.
+// Environment variable reads on every request of token
+type Environ struct {
+	variable   string
+	sourceInfo string
+}
+
+func NewEnvironCredentials(opts ...EnvironCredentialsOption) *Environ {
+	c := &Environ{
+		variable:   DefaultAccessTokenEnvironVariable,
+		sourceInfo: stack.Record(1),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyEnvironCredentialsOption(c)
+		}
+	}
+
+	return c
+}
+
+// Token implements Credentials.
+func (c *Environ) Token(_ context.Context) (string, error) {
+	token, has := os.LookupEnv(c.variable)
+	if !has || token == "" {
+		return "", xerrors.WithStackTrace(fmt.Errorf("environment variable '%s' is not defined", c.variable))
+	}
+
+	return token, nil
+}
+
+func (c *Environ) String() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	buffer.WriteString("Environ{Variable:")
+	fmt.Fprintf(buffer, "%q", c.variable)
+	if c.sourceInfo != "" {
+		buffer.WriteString(",From:")
+		fmt.Fprintf(buffer, "%q", c.sourceInfo)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
+
+type environVariableOption string
+
+func (variable environVariableOption) ApplyEnvironCredentialsOption(c *Environ) {
+	c.variable = string(variable)
+}
+
+// WithEnvironVariable sets name of environment variable with access token
+func WithEnvironVariable(variable string) environVariableOption {
+	return environVariableOption(variable)
+}
@@ -0,0 +1,294 @@
+package credentials
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+const (
+	jwtTokenType = "urn:ietf:params:oauth:token-type:jwt"
+
+	defaultJWTTokenTTL = time.Hour
+)
+
+var (
+	errNoPrivateKey          = xerrors.Wrap(errors.New("private key is not defined"))
+	errUnsupportedPrivateKey = xerrors.Wrap(errors.New("unsupported type of private key"))
+)
+
+var (
+	_ TokenSource  = (*JWTTokenSource)(nil)
+	_ fmt.Stringer = (*JWTTokenSource)(nil)
+)
+
+type JWTTokenSourceOption interface {
+	ApplyJWTTokenSourceOption(s *JWTTokenSource) error
+}
+
+// JWTTokenSource is a token source which signs JWT with RSA or ECDSA private key on every request of token
+type JWTTokenSource struct {
+	signingMethod jwt.SigningMethod
+	keyID         string
+	privateKey    crypto.Signer
+	issuer        string
+	subject       string
+	audience      []string
+	id            string
+	tokenTTL      time.Duration
+}
+
+func NewJWTTokenSource(opts ...JWTTokenSourceOption) (*JWTTokenSource, error) {
+	s := &JWTTokenSource{
+		tokenTTL: defaultJWTTokenTTL,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			if err := opt.ApplyJWTTokenSourceOption(s); err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+		}
+	}
+	if s.privateKey == nil {
+		return nil, xerrors.WithStackTrace(errNoPrivateKey)
+	}
+	if s.signingMethod == nil {
+		signingMethod, err := defaultSigningMethod(s.privateKey)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		s.signingMethod = signingMethod
+	}
+
+	return s, nil
+}
+
+// defaultSigningMethod returns RS256 for RSA keys and ES256, ES384 or ES512 for ECDSA keys by curve of key
+func defaultSigningMethod(key crypto.Signer) (jwt.SigningMethod, error) {
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return jwt.SigningMethodRS256, nil
+	case *ecdsa.PrivateKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return jwt.SigningMethodES256, nil
+		case elliptic.P384():
+			return jwt.SigningMethodES384, nil
+		case elliptic.P521():
+			return jwt.SigningMethodES512, nil
+		default:
+			return nil, xerrors.WithStackTrace(
+				fmt.Errorf("%w: ECDSA key with curve %s", errUnsupportedPrivateKey, key.Curve.Params().Name),
+			)
+		}
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errUnsupportedPrivateKey, key))
+	}
+}
+
+func (s *JWTTokenSource) Token() (Token, error) {
+	now := time.Now()
+	claims := jwt.RegisteredClaims{
+		Issuer:    s.issuer,
+		Subject:   s.subject,
+		Audience:  s.audience,
+		ID:        s.id,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(s.tokenTTL)),
+	}
+	t := jwt.NewWithClaims(s.signingMethod, claims)
+	if s.keyID != "" {
+		t.Header["kid"] = s.keyID
+	}
+	token, err := t.SignedString(s.privateKey)
+	if err != nil {
+		return Token{}, xerrors.WithStackTrace(fmt.Errorf("sign JWT failed: %w", err))
+	}
+
+	return Token{
+		Token:     token,
+		TokenType: jwtTokenType,
+	}, nil
+}
+
+func (s *JWTTokenSource) String() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	buffer.WriteString("JWTTokenSource{Method:")
+	fmt.Fprintf(buffer, "%q", s.signingMethod.Alg())
+	buffer.WriteString(",KeyID:")
+	fmt.Fprintf(buffer, "%q", s.keyID)
+	buffer.WriteString(",Issuer:")
+	fmt.Fprintf(buffer, "%q", s.issuer)
+	buffer.WriteString(",Subject:")
+	fmt.Fprintf(buffer, "%q", s.subject)
+	buffer.WriteString(",Audience:")
+	fmt.Fprintf(buffer, "%q", s.audience)
+	buffer.WriteString(",TTL:")
+	fmt.Fprintf(buffer, "%q", s.tokenTTL.String())
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
+
+// parsePrivateKeyPEM parses RSA or ECDSA private key in PKCS#1, SEC 1 or PKCS#8 PEM encoding
+func parsePrivateKeyPEM(content []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, xerrors.WithStackTrace(errors.New("PEM block of private key not found"))
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return key, nil
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return key, nil
+	default:
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		switch key := key.(type) {
+		case *rsa.PrivateKey:
+			return key, nil
+		case *ecdsa.PrivateKey:
+			return key, nil
+		default:
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errUnsupportedPrivateKey, key))
+		}
+	}
+}
+
+type signingMethodOption string
+
+func (method signingMethodOption) ApplyJWTTokenSourceOption(s *JWTTokenSource) error {
+	s.signingMethod = jwt.GetSigningMethod(string(method))
+	if s.signingMethod == nil {
+		return xerrors.WithStackTrace(fmt.Errorf("unknown JWT signing method '%s'", string(method)))
+	}
+
+	return nil
+}
+
+// WithSigningMethod sets JWT signing method (such as RS256, PS256 or ES256).
+// By default, signing method is chosen by type of private key
+func WithSigningMethod(method string) signingMethodOption {
+	return signingMethodOption(method)
+}
+
+type keyIDOption string
+
+func (keyID keyIDOption) ApplyJWTTokenSourceOption(s *JWTTokenSource) error {
+	s.keyID = string(keyID)
+
+	return nil
+}
+
+// WithKeyID sets key ID (kid) header of JWT
+func WithKeyID(keyID string) keyIDOption {
+	return keyIDOption(keyID)
+}
+
+type privateKeyOption struct {
+	key crypto.Signer
+}
+
+func (o privateKeyOption) ApplyJWTTokenSourceOption(s *JWTTokenSource) error {
+	s.privateKey = o.key
+
+	return nil
+}
+
+// WithPrivateKey sets RSA or ECDSA private key for signing of JWT
+func WithPrivateKey(key crypto.Signer) privateKeyOption {
+	return privateKeyOption{key: key}
+}
+
+type privateKeyPEMOption []byte
+
+func (content privateKeyPEMOption) ApplyJWTTokenSourceOption(s *JWTTokenSource) error {
+	key, err := parsePrivateKeyPEM(content)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	s.privateKey = key
+
+	return nil
+}
+
+// WithPrivateKeyPEM sets RSA or ECDSA private key in PEM encoding for signing of JWT
+func WithPrivateKeyPEM(content []byte) privateKeyPEMOption {
+	return content
+}
+
+type issuerOption string
+
+func (issuer issuerOption) ApplyJWTTokenSourceOption(s *JWTTokenSource) error {
+	s.issuer = string(issuer)
+
+	return nil
+}
+
+// WithIssuer sets issuer claim of JWT
+func WithIssuer(issuer string) issuerOption {
+	return issuerOption(issuer)
+}
+
+type subjectOption string
+
+func (subject subjectOption) ApplyJWTTokenSourceOption(s *JWTTokenSource) error {
+	s.subject = string(subject)
+
+	return nil
+}
+
+// WithSubject sets subject claim of JWT
+func WithSubject(subject string) subjectOption {
+	return subjectOption(subject)
+}
+
+type idOption string
+
+func (id idOption) ApplyJWTTokenSourceOption(s *JWTTokenSource) error {
+	s.id = string(id)
+
+	return nil
+}
+
+// WithID sets JWT ID claim
+func WithID(id string) idOption {
+	return idOption(id)
+}
+
+type tokenTTLOption time.Duration
+
+func (ttl tokenTTLOption) ApplyJWTTokenSourceOption(s *JWTTokenSource) error {
+	s.tokenTTL = time.Duration(ttl)
+
+	return nil
+}
+
+// WithTokenTTL sets lifetime of JWT. Default lifetime is one hour
+func WithTokenTTL(ttl time.Duration) tokenTTLOption {
+	return tokenTTLOption(ttl)
+}
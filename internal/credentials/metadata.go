@@ -0,0 +1,125 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// DefaultMetadataURL is a default URL of token of service account in metadata service of virtual machine
+const DefaultMetadataURL = "http://169.254.169.254/computeMetadata/v1/instance/service-accounts/default/token"
+
+var (
+	_ Credentials               = (*Metadata)(nil)
+	_ fmt.Stringer              = (*Metadata)(nil)
+	_ MetadataCredentialsOption = SourceInfoOption("")
+)
+
+type MetadataCredentialsOption interface {
+	ApplyMetadataCredentialsOption(c *Metadata)
+}
+
+// Metadata implements Credentials interface with token of service account from metadata service
+// of virtual machine. Token refreshes in background before expiration
+type Metadata struct {
+	url            string
+	requestTimeout time.Duration
+	httpClient     *http.Client
+
+	cache      *tokenCache
+	sourceInfo string
+}
+
+func NewMetadataCredentials(opts ...MetadataCredentialsOption) *Metadata {
+	c := &Metadata{
+		url:            DefaultMetadataURL,
+		requestTimeout: defaultRequestTimeout,
+		httpClient:     http.DefaultClient,
+		sourceInfo:     stack.Record(1),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyMetadataCredentialsOption(c)
+		}
+	}
+	c.cache = newTokenCache(c.fetch, nil, c.requestTimeout)
+
+	return c
+}
+
+// Token implements Credentials.
+func (c *Metadata) Token(ctx context.Context) (string, error) {
+	return c.cache.Token(ctx)
+}
+
+func (c *Metadata) tokenWithExpiresAt(ctx context.Context) (string, time.Time, error) {
+	return c.cache.tokenWithExpiresAt(ctx)
+}
+
+type metadataTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	TokenType   string `json:"token_type"`
+}
+
+func (c *Metadata) fetch(ctx context.Context) (token string, expiresAt time.Time, _ error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, http.NoBody)
+	if err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(err)
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	now := time.Now()
+	body, err := doTokenRequest(c.httpClient, req)
+	if err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(err)
+	}
+
+	var response metadataTokenResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(
+			fmt.Errorf("parse response of metadata service '%s' failed: %w", c.url, err),
+		)
+	}
+	if response.AccessToken == "" {
+		return "", expiresAt, xerrors.WithStackTrace(
+			fmt.Errorf("empty access token from metadata service '%s'", c.url),
+		)
+	}
+	if response.ExpiresIn > 0 {
+		expiresAt = now.Add(time.Duration(response.ExpiresIn) * time.Second)
+	}
+
+	return response.AccessToken, expiresAt, nil
+}
+
+func (c *Metadata) String() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	buffer.WriteString("Metadata{URL:")
+	fmt.Fprintf(buffer, "%q", c.url)
+	if c.sourceInfo != "" {
+		buffer.WriteString(",From:")
+		fmt.Fprintf(buffer, "%q", c.sourceInfo)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
+
+type metadataURLOption string
+
+func (url metadataURLOption) ApplyMetadataCredentialsOption(c *Metadata) {
+	c.url = string(url)
+}
+
+// WithMetadataURL sets URL of token in metadata service
+func WithMetadataURL(url string) metadataURLOption {
+	return metadataURLOption(url)
+}
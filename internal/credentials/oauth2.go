@@ -0,0 +1,451 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/secret"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+const (
+	defaultOAuth2GrantType          = "urn:ietf:params:oauth:grant-type:token-exchange"
+	defaultOAuth2RequestedTokenType = "urn:ietf:params:oauth:token-type:access_token"
+
+	// maxResponseSize limits size of response of token endpoint
+	maxResponseSize = 1 << 20
+)
+
+var (
+	errEmptyTokenEndpoint = xerrors.Wrap(errors.New("empty token endpoint"))
+	errNoSubjectToken     = xerrors.Wrap(errors.New("subject token source is not defined"))
+)
+
+var (
+	_ Credentials                          = (*OAuth2TokenExchange)(nil)
+	_ fmt.Stringer                         = (*OAuth2TokenExchange)(nil)
+	_ OAuth2TokenExchangeCredentialsOption = SourceInfoOption("")
+)
+
+type OAuth2TokenExchangeCredentialsOption interface {
+	ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error
+}
+
+// OAuth2TokenExchange implements Credentials interface with OAuth 2.0 token exchange (RFC 8693).
+// Subject token (and optional actor token) exchanges for access token at token endpoint.
+// Access token refreshes in background before expiration
+type OAuth2TokenExchange struct {
+	tokenEndpoint      string
+	grantType          string
+	resource           string
+	audience           []string
+	scope              []string
+	requestedTokenType string
+	subjectTokenSource TokenSource
+	actorTokenSource   TokenSource
+	requestTimeout     time.Duration
+	httpClient         *http.Client
+
+	cache      *tokenCache
+	sourceInfo string
+}
+
+func NewOAuth2TokenExchangeCredentials(
+	opts ...OAuth2TokenExchangeCredentialsOption,
+) (*OAuth2TokenExchange, error) {
+	c := &OAuth2TokenExchange{
+		grantType:          defaultOAuth2GrantType,
+		requestedTokenType: defaultOAuth2RequestedTokenType,
+		requestTimeout:     defaultRequestTimeout,
+		httpClient:         http.DefaultClient,
+		sourceInfo:         stack.Record(1),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			if err := opt.ApplyOAuth2CredentialsOption(c); err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+		}
+	}
+	if c.tokenEndpoint == "" {
+		return nil, xerrors.WithStackTrace(errEmptyTokenEndpoint)
+	}
+	if c.subjectTokenSource == nil {
+		return nil, xerrors.WithStackTrace(errNoSubjectToken)
+	}
+	c.cache = newTokenCache(c.exchange, nil, c.requestTimeout)
+
+	return c, nil
+}
+
+// Token implements Credentials.
+func (c *OAuth2TokenExchange) Token(ctx context.Context) (string, error) {
+	return c.cache.Token(ctx)
+}
+
+func (c *OAuth2TokenExchange) tokenWithExpiresAt(ctx context.Context) (string, time.Time, error) {
+	return c.cache.tokenWithExpiresAt(ctx)
+}
+
+type oauth2TokenExchangeResponse struct {
+	AccessToken     string `json:"access_token"`
+	IssuedTokenType string `json:"issued_token_type"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in"`
+	Scope           string `json:"scope"`
+}
+
+func (c *OAuth2TokenExchange) form() (url.Values, error) {
+	form := url.Values{}
+	form.Set("grant_type", c.grantType)
+	form.Set("requested_token_type", c.requestedTokenType)
+	if c.resource != "" {
+		form.Set("resource", c.resource)
+	}
+	for _, audience := range c.audience {
+		form.Add("audience", audience)
+	}
+	if len(c.scope) > 0 {
+		form.Set("scope", strings.Join(c.scope, " "))
+	}
+	subjectToken, err := c.subjectTokenSource.Token()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("get subject token failed: %w", err))
+	}
+	form.Set("subject_token", subjectToken.Token)
+	form.Set("subject_token_type", subjectToken.TokenType)
+	if c.actorTokenSource != nil {
+		actorToken, err := c.actorTokenSource.Token()
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("get actor token failed: %w", err))
+		}
+		form.Set("actor_token", actorToken.Token)
+		form.Set("actor_token_type", actorToken.TokenType)
+	}
+
+	return form, nil
+}
+
+func (c *OAuth2TokenExchange) exchange(ctx context.Context) (token string, expiresAt time.Time, _ error) {
+	form, err := c.form()
+	if err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	now := time.Now()
+	body, err := doTokenRequest(c.httpClient, req)
+	if err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(err)
+	}
+
+	var response oauth2TokenExchangeResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(
+			fmt.Errorf("parse response of token endpoint '%s' failed: %w", c.tokenEndpoint, err),
+		)
+	}
+	if !strings.EqualFold(response.TokenType, "bearer") {
+		return "", expiresAt, xerrors.WithStackTrace(
+			fmt.Errorf("unsupported token type '%s' from token endpoint '%s'", response.TokenType, c.tokenEndpoint),
+		)
+	}
+	if response.AccessToken == "" {
+		return "", expiresAt, xerrors.WithStackTrace(
+			fmt.Errorf("empty access token from token endpoint '%s'", c.tokenEndpoint),
+		)
+	}
+	if response.ExpiresIn > 0 {
+		expiresAt = now.Add(time.Duration(response.ExpiresIn) * time.Second)
+	}
+
+	return "Bearer " + response.AccessToken, expiresAt, nil
+}
+
+// doTokenRequest sends request to token endpoint and returns body of successful response
+func doTokenRequest(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("request to token endpoint '%s' failed: %w", req.URL, err))
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("read response of token endpoint '%s' failed: %w", req.URL, err))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, xerrors.WithStackTrace(
+			fmt.Errorf("token endpoint '%s' responded with status %d: %s", req.URL, resp.StatusCode, body),
+		)
+	}
+
+	return body, nil
+}
+
+func (c *OAuth2TokenExchange) String() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	buffer.WriteString("OAuth2TokenExchange{Endpoint:")
+	fmt.Fprintf(buffer, "%q", c.tokenEndpoint)
+	buffer.WriteString(",GrantType:")
+	fmt.Fprintf(buffer, "%q", c.grantType)
+	if c.resource != "" {
+		buffer.WriteString(",Resource:")
+		fmt.Fprintf(buffer, "%q", c.resource)
+	}
+	if len(c.audience) > 0 {
+		buffer.WriteString(",Audience:")
+		fmt.Fprintf(buffer, "%q", c.audience)
+	}
+	if len(c.scope) > 0 {
+		buffer.WriteString(",Scope:")
+		fmt.Fprintf(buffer, "%q", c.scope)
+	}
+	buffer.WriteString(",RequestedTokenType:")
+	fmt.Fprintf(buffer, "%q", c.requestedTokenType)
+	buffer.WriteString(",SubjectToken:")
+	fmt.Fprintf(buffer, "%v", c.subjectTokenSource)
+	if c.actorTokenSource != nil {
+		buffer.WriteString(",ActorToken:")
+		fmt.Fprintf(buffer, "%v", c.actorTokenSource)
+	}
+	if c.sourceInfo != "" {
+		buffer.WriteString(",From:")
+		fmt.Fprintf(buffer, "%q", c.sourceInfo)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
+
+type tokenEndpointOption string
+
+func (endpoint tokenEndpointOption) ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error {
+	c.tokenEndpoint = string(endpoint)
+
+	return nil
+}
+
+// WithTokenEndpoint sets URL of token exchange endpoint
+func WithTokenEndpoint(endpoint string) tokenEndpointOption {
+	return tokenEndpointOption(endpoint)
+}
+
+type grantTypeOption string
+
+func (grantType grantTypeOption) ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error {
+	c.grantType = string(grantType)
+
+	return nil
+}
+
+// WithGrantType sets grant type of token exchange request.
+// Default grant type is urn:ietf:params:oauth:grant-type:token-exchange
+func WithGrantType(grantType string) grantTypeOption {
+	return grantTypeOption(grantType)
+}
+
+type resourceOption string
+
+func (resource resourceOption) ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error {
+	c.resource = string(resource)
+
+	return nil
+}
+
+// WithResource sets resource of token exchange request
+func WithResource(resource string) resourceOption {
+	return resourceOption(resource)
+}
+
+type scopeOption []string
+
+func (scope scopeOption) ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error {
+	c.scope = append(c.scope, scope...)
+
+	return nil
+}
+
+// WithScope appends scopes of token exchange request
+func WithScope(scope ...string) scopeOption {
+	return scope
+}
+
+type requestedTokenTypeOption string
+
+func (tokenType requestedTokenTypeOption) ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error {
+	c.requestedTokenType = string(tokenType)
+
+	return nil
+}
+
+// WithRequestedTokenType sets type of requested token.
+// Default type is urn:ietf:params:oauth:token-type:access_token
+func WithRequestedTokenType(tokenType string) requestedTokenTypeOption {
+	return requestedTokenTypeOption(tokenType)
+}
+
+type subjectTokenOption struct {
+	source TokenSource
+}
+
+func (o subjectTokenOption) ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error {
+	c.subjectTokenSource = o.source
+
+	return nil
+}
+
+// WithSubjectToken sets source of subject token for token exchange
+func WithSubjectToken(source TokenSource) subjectTokenOption {
+	return subjectTokenOption{source: source}
+}
+
+type actorTokenOption struct {
+	source TokenSource
+}
+
+func (o actorTokenOption) ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error {
+	c.actorTokenSource = o.source
+
+	return nil
+}
+
+// WithActorToken sets source of actor token for token exchange
+func WithActorToken(source TokenSource) actorTokenOption {
+	return actorTokenOption{source: source}
+}
+
+type httpClientOption struct {
+	client *http.Client
+}
+
+func (o httpClientOption) ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error {
+	if o.client != nil {
+		c.httpClient = o.client
+	}
+
+	return nil
+}
+
+func (o httpClientOption) ApplyServiceAccountCredentialsOption(c *ServiceAccount) error {
+	if o.client != nil {
+		c.httpClient = o.client
+	}
+
+	return nil
+}
+
+func (o httpClientOption) ApplyMetadataCredentialsOption(c *Metadata) {
+	if o.client != nil {
+		c.httpClient = o.client
+	}
+}
+
+// WithHTTPClient sets HTTP client for requests to token endpoint
+func WithHTTPClient(client *http.Client) httpClientOption {
+	return httpClientOption{client: client}
+}
+
+type requestTimeoutOption time.Duration
+
+func (timeout requestTimeoutOption) ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error {
+	c.requestTimeout = time.Duration(timeout)
+
+	return nil
+}
+
+func (timeout requestTimeoutOption) ApplyServiceAccountCredentialsOption(c *ServiceAccount) error {
+	c.requestTimeout = time.Duration(timeout)
+
+	return nil
+}
+
+func (timeout requestTimeoutOption) ApplyMetadataCredentialsOption(c *Metadata) {
+	c.requestTimeout = time.Duration(timeout)
+}
+
+// WithRequestTimeout sets timeout of request to token endpoint
+func WithRequestTimeout(timeout time.Duration) requestTimeoutOption {
+	return requestTimeoutOption(timeout)
+}
+
+type audienceOption []string
+
+func (audience audienceOption) ApplyOAuth2CredentialsOption(c *OAuth2TokenExchange) error {
+	c.audience = append(c.audience, audience...)
+
+	return nil
+}
+
+func (audience audienceOption) ApplyJWTTokenSourceOption(s *JWTTokenSource) error {
+	s.audience = append(s.audience, audience...)
+
+	return nil
+}
+
+// WithAudience appends audience of token exchange request or audience claim of JWT
+func WithAudience(audience ...string) audienceOption {
+	return audience
+}
+
+// Token is a token of token source with type of token (such as urn:ietf:params:oauth:token-type:jwt)
+type Token struct {
+	Token     string
+	TokenType string
+}
+
+// TokenSource is a source of subject or actor tokens for OAuth 2.0 token exchange
+type TokenSource interface {
+	Token() (Token, error)
+}
+
+var (
+	_ TokenSource  = (*FixedTokenSource)(nil)
+	_ fmt.Stringer = (*FixedTokenSource)(nil)
+)
+
+// FixedTokenSource is a token source with fixed token
+type FixedTokenSource struct {
+	token Token
+}
+
+func NewFixedTokenSource(token, tokenType string) *FixedTokenSource {
+	return &FixedTokenSource{
+		token: Token{
+			Token:     token,
+			TokenType: tokenType,
+		},
+	}
+}
+
+func (s *FixedTokenSource) Token() (Token, error) {
+	return s.token, nil
+}
+
+func (s *FixedTokenSource) String() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	buffer.WriteString("FixedTokenSource{Token:")
+	fmt.Fprintf(buffer, "%q", secret.Token(s.token.Token))
+	buffer.WriteString(",Type:")
+	fmt.Fprintf(buffer, "%q", s.token.TokenType)
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
@@ -0,0 +1,133 @@
+package credentials
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuth2TokenExchange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, defaultOAuth2GrantType, r.PostForm.Get("grant_type"))
+		require.Equal(t, defaultOAuth2RequestedTokenType, r.PostForm.Get("requested_token_type"))
+		require.Equal(t, []string{"a1", "a2"}, r.PostForm["audience"])
+		require.Equal(t, "s1 s2", r.PostForm.Get("scope"))
+		require.Equal(t, "subject", r.PostForm.Get("subject_token"))
+		require.Equal(t, "urn:ietf:params:oauth:token-type:access_token", r.PostForm.Get("subject_token_type"))
+		require.Empty(t, r.PostForm.Get("actor_token"))
+		if r.URL.Path == "/error" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_request"}`))
+
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":      "access",
+			"issued_token_type": defaultOAuth2RequestedTokenType,
+			"token_type":        "Bearer",
+			"expires_in":        3600,
+		})
+	}))
+	defer server.Close()
+
+	opts := []OAuth2TokenExchangeCredentialsOption{
+		WithAudience("a1", "a2"),
+		WithScope("s1", "s2"),
+		WithSubjectToken(NewFixedTokenSource("subject", "urn:ietf:params:oauth:token-type:access_token")),
+	}
+
+	c, err := NewOAuth2TokenExchangeCredentials(append(opts, WithTokenEndpoint(server.URL))...)
+	require.NoError(t, err)
+	token, err := c.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "Bearer access", token)
+
+	c, err = NewOAuth2TokenExchangeCredentials(append(opts, WithTokenEndpoint(server.URL+"/error"))...)
+	require.NoError(t, err)
+	_, err = c.Token(context.Background())
+	require.ErrorContains(t, err, "invalid_request")
+
+	_, err = NewOAuth2TokenExchangeCredentials(opts...)
+	require.ErrorIs(t, err, errEmptyTokenEndpoint)
+}
+
+func TestJWTTokenSource(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	ecKeyDER, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name      string
+		opts      []JWTTokenSourceOption
+		method    string
+		publicKey interface{}
+	}{
+		{
+			name:      "RSA",
+			opts:      []JWTTokenSourceOption{WithPrivateKey(rsaKey)},
+			method:    "RS256",
+			publicKey: &rsaKey.PublicKey,
+		},
+		{
+			name:      "RSA-PSS",
+			opts:      []JWTTokenSourceOption{WithPrivateKey(rsaKey), WithSigningMethod("PS512")},
+			method:    "PS512",
+			publicKey: &rsaKey.PublicKey,
+		},
+		{
+			name: "ECDSA",
+			opts: []JWTTokenSourceOption{WithPrivateKeyPEM(pem.EncodeToMemory(&pem.Block{
+				Type:  "EC PRIVATE KEY",
+				Bytes: ecKeyDER,
+			}))},
+			method:    "ES384",
+			publicKey: &ecKey.PublicKey,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewJWTTokenSource(append(tt.opts,
+				WithKeyID("key"),
+				WithIssuer("issuer"),
+				WithSubject("subject"),
+				WithAudience("audience"),
+				WithTokenTTL(time.Minute),
+			)...)
+			require.NoError(t, err)
+			token, err := s.Token()
+			require.NoError(t, err)
+			require.Equal(t, jwtTokenType, token.TokenType)
+
+			var claims jwt.RegisteredClaims
+			parsed, err := jwt.ParseWithClaims(token.Token, &claims, func(token *jwt.Token) (interface{}, error) {
+				return tt.publicKey, nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.method, parsed.Method.Alg())
+			require.Equal(t, "key", parsed.Header["kid"])
+			require.Equal(t, "issuer", claims.Issuer)
+			require.Equal(t, "subject", claims.Subject)
+			require.Equal(t, jwt.ClaimStrings{"audience"}, claims.Audience)
+			require.Equal(t, time.Minute, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+		})
+	}
+
+	_, err = NewJWTTokenSource()
+	require.ErrorIs(t, err, errNoPrivateKey)
+}
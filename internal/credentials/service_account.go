@@ -0,0 +1,205 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+const (
+	DefaultIAMEndpoint = "https://iam.api.cloud.yandex.net/iam/v1/tokens"
+
+	serviceAccountJWTTokenTTL = time.Hour
+)
+
+var (
+	_ Credentials                     = (*ServiceAccount)(nil)
+	_ fmt.Stringer                    = (*ServiceAccount)(nil)
+	_ ServiceAccountCredentialsOption = SourceInfoOption("")
+)
+
+type ServiceAccountCredentialsOption interface {
+	ApplyServiceAccountCredentialsOption(c *ServiceAccount) error
+}
+
+// serviceAccountKey is a content of authorized key file of service account
+type serviceAccountKey struct {
+	ID               string `json:"id"`
+	ServiceAccountID string `json:"service_account_id"`
+	PrivateKey       string `json:"private_key"`
+}
+
+// ServiceAccount implements Credentials interface with authorized key of service account.
+// JWT signed with private key of service account exchanges for IAM token at IAM endpoint.
+// IAM token refreshes in background before expiration
+type ServiceAccount struct {
+	serviceAccountID string
+	endpoint         string
+	requestTimeout   time.Duration
+	httpClient       *http.Client
+	jwt              *JWTTokenSource
+
+	cache      *tokenCache
+	sourceInfo string
+}
+
+// NewServiceAccountKeyFileCredentials makes credentials from authorized key file of service account
+func NewServiceAccountKeyFileCredentials(
+	path string, opts ...ServiceAccountCredentialsOption,
+) (*ServiceAccount, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("read service account key file failed: %w", err))
+	}
+
+	return newServiceAccountCredentials(content, stack.Record(1), opts...)
+}
+
+// NewServiceAccountKeyCredentials makes credentials from content of authorized key file of service account
+func NewServiceAccountKeyCredentials(
+	content []byte, opts ...ServiceAccountCredentialsOption,
+) (*ServiceAccount, error) {
+	return newServiceAccountCredentials(content, stack.Record(1), opts...)
+}
+
+func newServiceAccountCredentials(
+	content []byte, sourceInfo string, opts ...ServiceAccountCredentialsOption,
+) (*ServiceAccount, error) {
+	var key serviceAccountKey
+	if err := json.Unmarshal(content, &key); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("parse service account key failed: %w", err))
+	}
+	if key.ID == "" || key.ServiceAccountID == "" || key.PrivateKey == "" {
+		return nil, xerrors.WithStackTrace(
+			errors.New("service account key must contain id, service_account_id and private_key"),
+		)
+	}
+	c := &ServiceAccount{
+		serviceAccountID: key.ServiceAccountID,
+		endpoint:         DefaultIAMEndpoint,
+		requestTimeout:   defaultRequestTimeout,
+		httpClient:       http.DefaultClient,
+		sourceInfo:       sourceInfo,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			if err := opt.ApplyServiceAccountCredentialsOption(c); err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+		}
+	}
+	privateKey, err := parsePrivateKeyPEM([]byte(key.PrivateKey))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("parse private key of service account failed: %w", err))
+	}
+	jwtOpts := []JWTTokenSourceOption{
+		WithPrivateKey(privateKey),
+		WithKeyID(key.ID),
+		WithIssuer(key.ServiceAccountID),
+		WithAudience(c.endpoint),
+		WithTokenTTL(serviceAccountJWTTokenTTL),
+	}
+	if _, isRSA := privateKey.(*rsa.PrivateKey); isRSA {
+		// IAM requires RSA-PSS signature of JWT
+		jwtOpts = append(jwtOpts, WithSigningMethod(jwt.SigningMethodPS256.Alg()))
+	}
+	c.jwt, err = NewJWTTokenSource(jwtOpts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	c.cache = newTokenCache(c.createIAMToken, nil, c.requestTimeout)
+
+	return c, nil
+}
+
+// Token implements Credentials.
+func (c *ServiceAccount) Token(ctx context.Context) (string, error) {
+	return c.cache.Token(ctx)
+}
+
+func (c *ServiceAccount) tokenWithExpiresAt(ctx context.Context) (string, time.Time, error) {
+	return c.cache.tokenWithExpiresAt(ctx)
+}
+
+type createIAMTokenResponse struct {
+	IAMToken  string    `json:"iamToken"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (c *ServiceAccount) createIAMToken(ctx context.Context) (token string, expiresAt time.Time, _ error) {
+	jwtToken, err := c.jwt.Token()
+	if err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(err)
+	}
+	request, err := json.Marshal(map[string]string{
+		"jwt": jwtToken.Token,
+	})
+	if err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(request))
+	if err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := doTokenRequest(c.httpClient, req)
+	if err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(err)
+	}
+
+	var response createIAMTokenResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(
+			fmt.Errorf("parse response of IAM endpoint '%s' failed: %w", c.endpoint, err),
+		)
+	}
+	if response.IAMToken == "" {
+		return "", expiresAt, xerrors.WithStackTrace(
+			fmt.Errorf("empty IAM token from IAM endpoint '%s'", c.endpoint),
+		)
+	}
+
+	return response.IAMToken, response.ExpiresAt, nil
+}
+
+func (c *ServiceAccount) String() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	buffer.WriteString("ServiceAccount{ID:")
+	fmt.Fprintf(buffer, "%q", c.serviceAccountID)
+	buffer.WriteString(",Endpoint:")
+	fmt.Fprintf(buffer, "%q", c.endpoint)
+	if c.sourceInfo != "" {
+		buffer.WriteString(",From:")
+		fmt.Fprintf(buffer, "%q", c.sourceInfo)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
+
+type iamEndpointOption string
+
+func (endpoint iamEndpointOption) ApplyServiceAccountCredentialsOption(c *ServiceAccount) error {
+	c.endpoint = string(endpoint)
+
+	return nil
+}
+
+// WithIAMEndpoint sets URL of IAM endpoint for exchange of JWT for IAM token
+func WithIAMEndpoint(endpoint string) iamEndpointOption {
+	return iamEndpointOption(endpoint)
+}
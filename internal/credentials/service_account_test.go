@@ -0,0 +1,73 @@
+package credentials
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceAccountKeyFileCredentials(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	var endpoint string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			JWT string `json:"jwt"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		var claims jwt.RegisteredClaims
+		token, err := jwt.ParseWithClaims(request.JWT, &claims, func(token *jwt.Token) (interface{}, error) {
+			return &key.PublicKey, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "PS256", token.Method.Alg())
+		require.Equal(t, "key-id", token.Header["kid"])
+		require.Equal(t, "sa-id", claims.Issuer)
+		require.Equal(t, jwt.ClaimStrings{endpoint}, claims.Audience)
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"iamToken":  "iam-token",
+			"expiresAt": time.Now().Add(12 * time.Hour).Format(time.RFC3339Nano),
+		})
+	}))
+	defer server.Close()
+	endpoint = server.URL
+
+	content, err := json.Marshal(map[string]string{
+		"id":                 "key-id",
+		"service_account_id": "sa-id",
+		"private_key": string(pem.EncodeToMemory(&pem.Block{
+			Type:  "PRIVATE KEY",
+			Bytes: keyDER,
+		})),
+	})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+
+	c, err := NewServiceAccountKeyFileCredentials(path, WithIAMEndpoint(endpoint))
+	require.NoError(t, err)
+	token, err := c.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "iam-token", token)
+	require.Contains(t, c.String(), `ID:"sa-id"`)
+
+	_, err = NewServiceAccountKeyCredentials([]byte(`{"id":"key-id"}`))
+	require.Error(t, err)
+}
@@ -14,6 +14,34 @@ func (sourceInfo SourceInfoOption) ApplyAccessTokenCredentialsOption(h *AccessTo
 	h.sourceInfo = string(sourceInfo)
 }
 
+func (sourceInfo SourceInfoOption) ApplyOAuth2CredentialsOption(h *OAuth2TokenExchange) error {
+	h.sourceInfo = string(sourceInfo)
+
+	return nil
+}
+
+func (sourceInfo SourceInfoOption) ApplyServiceAccountCredentialsOption(h *ServiceAccount) error {
+	h.sourceInfo = string(sourceInfo)
+
+	return nil
+}
+
+func (sourceInfo SourceInfoOption) ApplyChainCredentialsOption(h *Chain) {
+	h.sourceInfo = string(sourceInfo)
+}
+
+func (sourceInfo SourceInfoOption) ApplyEnvironCredentialsOption(h *Environ) {
+	h.sourceInfo = string(sourceInfo)
+}
+
+func (sourceInfo SourceInfoOption) ApplyTokenFileCredentialsOption(h *TokenFile) {
+	h.sourceInfo = string(sourceInfo)
+}
+
+func (sourceInfo SourceInfoOption) ApplyMetadataCredentialsOption(h *Metadata) {
+	h.sourceInfo = string(sourceInfo)
+}
+
 // WithSourceInfo option append to credentials object the source info for reporting source info details on error case
 func WithSourceInfo(sourceInfo string) SourceInfoOption {
 	return SourceInfoOption(sourceInfo)
@@ -144,6 +144,9 @@ func parseExpiresAt(raw string) (expiresAt time.Time, err error) {
 	if _, _, err = jwt.NewParser().ParseUnverified(raw, &claims); err != nil {
 		return expiresAt, xerrors.WithStackTrace(err)
 	}
+	if claims.ExpiresAt == nil {
+		return expiresAt, nil
+	}
 
 	return claims.ExpiresAt.Time, nil
 }
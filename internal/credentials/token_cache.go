@@ -0,0 +1,173 @@
+package credentials
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	defaultRequestTimeout = 10 * time.Second
+
+	// minRefreshRetryDelay is a minimal delay between failed background refreshes of token
+	minRefreshRetryDelay = time.Second
+)
+
+// tokenFetcher requests new token and returns it with expiration time.
+// Zero expiresAt means that token never expires
+type tokenFetcher func(ctx context.Context) (token string, expiresAt time.Time, err error)
+
+// tokenFetch is an in-flight request of token
+type tokenFetch struct {
+	done      chan struct{}
+	cancel    context.CancelFunc
+	token     string
+	expiresAt time.Time
+	err       error
+	// stale is true if cache was reset while fetching. Token of stale fetch must not be cached
+	stale bool
+}
+
+// tokenCache caches token of fetcher until expiration.
+// Token refreshes in background after half of token lifetime while cached token is still valid,
+// so callers are not blocked on refresh. Concurrent requests of token are coalesced into single
+// request of fetcher (single-flight)
+type tokenCache struct {
+	fetch          tokenFetcher
+	clock          clockwork.Clock
+	requestTimeout time.Duration
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+	refreshAt time.Time
+	inflight  *tokenFetch
+}
+
+func newTokenCache(fetch tokenFetcher, clock clockwork.Clock, requestTimeout time.Duration) *tokenCache {
+	if clock == nil {
+		clock = clockwork.NewRealClock()
+	}
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	return &tokenCache{
+		fetch:          fetch,
+		clock:          clock,
+		requestTimeout: requestTimeout,
+	}
+}
+
+func (c *tokenCache) Token(ctx context.Context) (string, error) {
+	token, _, err := c.tokenWithExpiresAt(ctx)
+
+	return token, err
+}
+
+// tokenWithExpiresAt returns token with expiration time of token
+func (c *tokenCache) tokenWithExpiresAt(ctx context.Context) (string, time.Time, error) {
+	c.mu.Lock()
+	now := c.clock.Now()
+	if c.token != "" && (c.expiresAt.IsZero() || now.Before(c.expiresAt)) {
+		token, expiresAt := c.token, c.expiresAt
+		if !c.refreshAt.IsZero() && !now.Before(c.refreshAt) && c.inflight == nil {
+			c.startFetch(ctx)
+		}
+		c.mu.Unlock()
+
+		return token, expiresAt, nil
+	}
+	f := c.inflight
+	if f == nil {
+		f = c.startFetch(ctx)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return "", time.Time{}, xerrors.WithStackTrace(ctx.Err())
+	case <-f.done:
+		if f.stale {
+			// cache was reset while fetching, fetched token may be outdated
+			return c.tokenWithExpiresAt(ctx)
+		}
+		if f.err != nil {
+			return "", time.Time{}, xerrors.WithStackTrace(f.err)
+		}
+
+		return f.token, f.expiresAt, nil
+	}
+}
+
+// reset drops cached token and invalidates in-flight request of token,
+// so next request of token waits for fetch of new token
+func (c *tokenCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token, c.expiresAt, c.refreshAt = "", time.Time{}, time.Time{}
+	if f := c.inflight; f != nil {
+		f.stale = true
+		f.cancel()
+		c.inflight = nil
+	}
+}
+
+// startFetch starts request of token which is shared between callers. Must be called under lock
+func (c *tokenCache) startFetch(ctx context.Context) *tokenFetch {
+	// request of token must not be canceled with context of one of waiters
+	ctx, cancel := xcontext.WithTimeout(xcontext.WithoutDeadline(ctx), c.requestTimeout)
+
+	f := &tokenFetch{
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+	c.inflight = f
+
+	go func() {
+		defer cancel()
+		defer close(f.done)
+
+		token, expiresAt, err := c.fetch(ctx)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		f.token, f.expiresAt, f.err = token, expiresAt, err
+		if f.stale {
+			return
+		}
+		c.inflight = nil
+		now := c.clock.Now()
+		if f.err != nil {
+			if c.token != "" {
+				// keep cached token until expiration and retry refresh later
+				c.refreshAt = now.Add(maxDuration(c.expiresAt.Sub(now)/10, minRefreshRetryDelay))
+			}
+
+			return
+		}
+		c.token, c.expiresAt = f.token, f.expiresAt
+		if f.expiresAt.IsZero() {
+			c.refreshAt = time.Time{}
+		} else {
+			c.refreshAt = now.Add(f.expiresAt.Sub(now) / 2)
+		}
+	}()
+
+	return f
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+
+	return b
+}
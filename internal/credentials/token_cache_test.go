@@ -0,0 +1,135 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenCache(t *testing.T) {
+	ctx := context.Background()
+	t.Run("SingleFlight", func(t *testing.T) {
+		var (
+			calls   atomic.Int32
+			release = make(chan struct{})
+			clock   = clockwork.NewFakeClock()
+			cache   = newTokenCache(func(ctx context.Context) (string, time.Time, error) {
+				calls.Add(1)
+				<-release
+
+				return "token", clock.Now().Add(time.Hour), nil
+			}, clock, time.Minute)
+			wg sync.WaitGroup
+		)
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				token, err := cache.Token(ctx)
+				require.NoError(t, err)
+				require.Equal(t, "token", token)
+			}()
+		}
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		require.EqualValues(t, 1, calls.Load())
+	})
+	t.Run("ResetInflight", func(t *testing.T) {
+		var (
+			calls   atomic.Int32
+			started = make(chan struct{})
+			cache   = newTokenCache(func(ctx context.Context) (string, time.Time, error) {
+				if calls.Add(1) == 1 {
+					close(started)
+					<-ctx.Done()
+
+					return "stale", time.Time{}, nil
+				}
+
+				return "fresh", time.Time{}, nil
+			}, nil, time.Minute)
+			result = make(chan string, 1)
+		)
+		go func() {
+			token, err := cache.Token(ctx)
+			require.NoError(t, err)
+			result <- token
+		}()
+		<-started
+		cache.reset()
+		require.Equal(t, "fresh", <-result)
+		token, err := cache.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "fresh", token)
+		require.EqualValues(t, 2, calls.Load())
+	})
+	t.Run("EarlyRefresh", func(t *testing.T) {
+		var (
+			calls atomic.Int32
+			clock = clockwork.NewFakeClock()
+			cache = newTokenCache(func(ctx context.Context) (string, time.Time, error) {
+				n := calls.Add(1)
+
+				return fmt.Sprintf("token-%d", n), clock.Now().Add(time.Hour), nil
+			}, clock, time.Minute)
+		)
+		token, err := cache.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+
+		clock.Advance(20 * time.Minute)
+		token, err = cache.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+		require.EqualValues(t, 1, calls.Load())
+
+		// cached token returns while refresh in background after half of lifetime
+		clock.Advance(20 * time.Minute)
+		token, err = cache.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+		require.Eventually(t, func() bool {
+			token, err = cache.Token(ctx)
+
+			return err == nil && token == "token-2"
+		}, time.Second, time.Millisecond)
+		require.EqualValues(t, 2, calls.Load())
+	})
+	t.Run("FailedRefresh", func(t *testing.T) {
+		var (
+			fail  atomic.Bool
+			clock = clockwork.NewFakeClock()
+			cache = newTokenCache(func(ctx context.Context) (string, time.Time, error) {
+				if fail.Load() {
+					return "", time.Time{}, errors.New("unavailable")
+				}
+
+				return "token", clock.Now().Add(time.Hour), nil
+			}, clock, time.Minute)
+		)
+		_, err := cache.Token(ctx)
+		require.NoError(t, err)
+
+		// cached token returns until expiration on failed refresh
+		fail.Store(true)
+		clock.Advance(40 * time.Minute)
+		token, err := cache.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "token", token)
+
+		clock.Advance(40 * time.Minute)
+		require.Eventually(t, func() bool {
+			_, err = cache.Token(ctx)
+
+			return err != nil
+		}, time.Second, time.Millisecond)
+	})
+}
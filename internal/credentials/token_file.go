@@ -0,0 +1,142 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+const defaultTokenFilePollInterval = 10 * time.Second
+
+var (
+	_ Credentials                = (*TokenFile)(nil)
+	_ fmt.Stringer               = (*TokenFile)(nil)
+	_ TokenFileCredentialsOption = SourceInfoOption("")
+)
+
+type TokenFileCredentialsOption interface {
+	ApplyTokenFileCredentialsOption(c *TokenFile)
+}
+
+// TokenFile implements Credentials interface with token from file (such as projected service account token
+// of Kubernetes or token rendered by Vault agent).
+// TokenFile watches modification time and size of file at most once per poll interval and reloads token
+// if file was rotated. If token is a JWT - token also reloads after half of token lifetime
+type TokenFile struct {
+	path         string
+	pollInterval time.Duration
+	clock        clockwork.Clock
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	modTime   time.Time
+	size      int64
+
+	cache      *tokenCache
+	sourceInfo string
+}
+
+func NewTokenFileCredentials(path string, opts ...TokenFileCredentialsOption) *TokenFile {
+	c := &TokenFile{
+		path:         path,
+		pollInterval: defaultTokenFilePollInterval,
+		clock:        clockwork.NewRealClock(),
+		sourceInfo:   stack.Record(1),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyTokenFileCredentialsOption(c)
+		}
+	}
+	c.cache = newTokenCache(c.read, c.clock, 0)
+
+	return c
+}
+
+// Token implements Credentials.
+func (c *TokenFile) Token(ctx context.Context) (string, error) {
+	c.watch()
+
+	return c.cache.Token(ctx)
+}
+
+func (c *TokenFile) tokenWithExpiresAt(ctx context.Context) (string, time.Time, error) {
+	c.watch()
+
+	return c.cache.tokenWithExpiresAt(ctx)
+}
+
+// watch drops cached token if file was rotated
+func (c *TokenFile) watch() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	if !c.checkedAt.IsZero() && now.Sub(c.checkedAt) < c.pollInterval {
+		return
+	}
+	c.checkedAt = now
+
+	info, err := os.Stat(c.path)
+	if err != nil {
+		// error of reading returns from read of token
+		return
+	}
+	if info.ModTime().Equal(c.modTime) && info.Size() == c.size {
+		return
+	}
+	c.modTime, c.size = info.ModTime(), info.Size()
+	c.cache.reset()
+}
+
+func (c *TokenFile) read(context.Context) (token string, expiresAt time.Time, _ error) {
+	content, err := os.ReadFile(c.path)
+	if err != nil {
+		return "", expiresAt, xerrors.WithStackTrace(fmt.Errorf("read token file failed: %w", err))
+	}
+	token = strings.TrimSpace(string(content))
+	if token == "" {
+		return "", expiresAt, xerrors.WithStackTrace(errors.New("token file is empty"))
+	}
+	if expiresAt, err = parseExpiresAt(token); err != nil {
+		// token is not a JWT and reloads only on rotation of file
+		return token, time.Time{}, nil
+	}
+
+	return token, expiresAt, nil
+}
+
+func (c *TokenFile) String() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	buffer.WriteString("TokenFile{Path:")
+	fmt.Fprintf(buffer, "%q", c.path)
+	if c.sourceInfo != "" {
+		buffer.WriteString(",From:")
+		fmt.Fprintf(buffer, "%q", c.sourceInfo)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
+
+type pollIntervalOption time.Duration
+
+func (interval pollIntervalOption) ApplyTokenFileCredentialsOption(c *TokenFile) {
+	c.pollInterval = time.Duration(interval)
+}
+
+// WithPollInterval sets interval of checks of token file rotation. Default interval is 10 seconds
+func WithPollInterval(interval time.Duration) pollIntervalOption {
+	return pollIntervalOption(interval)
+}
@@ -0,0 +1,43 @@
+package credentials
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenFile(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		path  = filepath.Join(t.TempDir(), "token")
+		clock = clockwork.NewFakeClock()
+	)
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+
+	c := NewTokenFileCredentials(path, WithPollInterval(time.Second))
+	c.clock = clock
+	token, err := c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "first", token)
+
+	// rotation of file
+	require.NoError(t, os.WriteFile(path, []byte("second-token"), 0o600))
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "first", token, "file checks at most once per poll interval")
+
+	clock.Advance(time.Second)
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "second-token", token)
+
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	clock.Advance(time.Second)
+	_, err = c.Token(ctx)
+	require.Error(t, err)
+}
@@ -0,0 +1,352 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Import"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	internalOperation "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/wait"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+var errNilClient = xerrors.Wrap(errors.New("operation client is not initialized"))
+
+// waitBackoff is a backoff of polling in Wait: 50ms, 100ms, ... up to 3.2s
+var waitBackoff = backoff.New(
+	backoff.WithSlotDuration(50*time.Millisecond),
+	backoff.WithCeiling(6),
+)
+
+var _ operation.Client = (*Client)(nil)
+
+type Client struct {
+	config  config.Config
+	service Ydb_Operation_V1.OperationServiceClient
+}
+
+func New(ctx context.Context, cc grpc.ClientConnInterface, config config.Config) (*Client, error) {
+	return &Client{
+		config:  config,
+		service: Ydb_Operation_V1.NewOperationServiceClient(cc),
+	}, nil
+}
+
+func (c *Client) Close(ctx context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, call func(ctx context.Context) error) error {
+	if !c.config.AutoRetry() {
+		return call(ctx)
+	}
+
+	return retry.Retry(ctx, call,
+		retry.WithStackTrace(),
+		retry.WithIdempotent(true),
+		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
+	)
+}
+
+func (c *Client) Get(ctx context.Context, id string) (op *operation.Operation, err error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+	err = c.do(ctx, func(ctx context.Context) (err error) {
+		op, err = c.get(ctx, id)
+
+		return xerrors.WithStackTrace(err)
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return op, nil
+}
+
+func (c *Client) get(ctx context.Context, id string) (*operation.Operation, error) {
+	// not ready and failed operations are valid results of GetOperation call,
+	// so response must not be wrapped into errors on conn layer
+	response, err := c.service.GetOperation(conn.WithoutWrapping(ctx), &Ydb_Operations.GetOperationRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return fromProto(response.GetOperation())
+}
+
+func (c *Client) List(ctx context.Context, kind operation.Kind, opts ...operation.ListOption) (
+	ops []*operation.Operation, nextPageToken string, err error,
+) {
+	if c == nil {
+		return nil, "", xerrors.WithStackTrace(errNilClient)
+	}
+	var listOptions operation.ListOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&listOptions)
+		}
+	}
+	err = c.do(ctx, func(ctx context.Context) (err error) {
+		ops, nextPageToken, err = c.list(ctx, kind, &listOptions)
+
+		return xerrors.WithStackTrace(err)
+	})
+	if err != nil {
+		return nil, "", xerrors.WithStackTrace(err)
+	}
+
+	return ops, nextPageToken, nil
+}
+
+func (c *Client) list(ctx context.Context, kind operation.Kind, opts *operation.ListOptions) (
+	[]*operation.Operation, string, error,
+) {
+	response, err := c.service.ListOperations(ctx, &Ydb_Operations.ListOperationsRequest{
+		Kind:      string(kind),
+		PageSize:  opts.PageSize,
+		PageToken: opts.PageToken,
+	})
+	if err != nil {
+		return nil, "", xerrors.WithStackTrace(err)
+	}
+	if err = statusError(response); err != nil {
+		return nil, "", xerrors.WithStackTrace(err)
+	}
+	ops := make([]*operation.Operation, 0, len(response.GetOperations()))
+	for _, o := range response.GetOperations() {
+		op, err := fromProto(o)
+		if err != nil {
+			return nil, "", xerrors.WithStackTrace(err)
+		}
+		ops = append(ops, op)
+	}
+
+	return ops, response.GetNextPageToken(), nil
+}
+
+func (c *Client) Cancel(ctx context.Context, id string) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	return c.do(ctx, func(ctx context.Context) error {
+		response, err := c.service.CancelOperation(ctx, &Ydb_Operations.CancelOperationRequest{
+			Id: id,
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return xerrors.WithStackTrace(statusError(response))
+	})
+}
+
+func (c *Client) Forget(ctx context.Context, id string) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	return c.do(ctx, func(ctx context.Context) error {
+		response, err := c.service.ForgetOperation(ctx, &Ydb_Operations.ForgetOperationRequest{
+			Id: id,
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return xerrors.WithStackTrace(statusError(response))
+	})
+}
+
+func (c *Client) Wait(ctx context.Context, id string, opts ...operation.WaitOption) (*operation.Operation, error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+	var waitOptions operation.WaitOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&waitOptions)
+		}
+	}
+	for i := 0; ; i++ {
+		op, err := c.Get(ctx, id)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if op.Ready {
+			return op, op.Err
+		}
+		if waitOptions.OnProgress != nil {
+			waitOptions.OnProgress(op)
+		}
+		if err = wait.Wait(ctx, waitBackoff, waitBackoff, backoff.TypeSlow, i); err != nil {
+			return op, xerrors.WithStackTrace(err)
+		}
+	}
+}
+
+func statusError(response internalOperation.Status) error {
+	if response.GetStatus() == Ydb.StatusIds_SUCCESS {
+		return nil
+	}
+
+	return xerrors.Operation(xerrors.FromOperation(response))
+}
+
+func fromProto(o *Ydb_Operations.Operation) (*operation.Operation, error) {
+	op := &operation.Operation{
+		ID:    o.GetId(),
+		Ready: o.GetReady(),
+	}
+	if op.Ready && o.GetStatus() != Ydb.StatusIds_SUCCESS {
+		op.Err = xerrors.Operation(xerrors.FromOperation(o))
+	}
+	if o.GetMetadata() != nil {
+		metadata, err := metadataFromProto(o.GetMetadata())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		op.Metadata = metadata
+	}
+
+	return op, nil
+}
+
+func metadataFromProto(m *anypb.Any) (operation.Metadata, error) {
+	switch {
+	case m.MessageIs((*Ydb_Table.IndexBuildMetadata)(nil)):
+		var metadata Ydb_Table.IndexBuildMetadata
+		if err := m.UnmarshalTo(&metadata); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return &operation.BuildIndexMetadata{
+			Path:     metadata.GetDescription().GetPath(),
+			Index:    metadata.GetDescription().GetIndex().GetName(),
+			State:    operation.BuildIndexState(metadata.GetState()),
+			Progress: metadata.GetProgress(),
+		}, nil
+	case m.MessageIs((*Ydb_Export.ExportToS3Metadata)(nil)):
+		var metadata Ydb_Export.ExportToS3Metadata
+		if err := m.UnmarshalTo(&metadata); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return operation.NewExportMetadata(operation.KindExportToS3,
+			exportProgress(metadata.GetProgress()),
+			exportItemsProgress(metadata.GetItemsProgress())...,
+		), nil
+	case m.MessageIs((*Ydb_Export.ExportToYtMetadata)(nil)):
+		var metadata Ydb_Export.ExportToYtMetadata
+		if err := m.UnmarshalTo(&metadata); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return operation.NewExportMetadata(operation.KindExportToYT,
+			exportProgress(metadata.GetProgress()),
+			exportItemsProgress(metadata.GetItemsProgress())...,
+		), nil
+	case m.MessageIs((*Ydb_Import.ImportFromS3Metadata)(nil)):
+		var metadata Ydb_Import.ImportFromS3Metadata
+		if err := m.UnmarshalTo(&metadata); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		items := make([]operation.TransferItemProgress, 0, len(metadata.GetItemsProgress()))
+		for _, item := range metadata.GetItemsProgress() {
+			items = append(items, itemProgress(item.GetPartsTotal(), item.GetPartsCompleted(),
+				item.GetStartTime(), item.GetEndTime(),
+			))
+		}
+
+		return operation.NewImportMetadata(importProgress(metadata.GetProgress()), items...), nil
+	default:
+		return nil, nil //nolint:nilnil
+	}
+}
+
+func exportItemsProgress(items []*Ydb_Export.ExportItemProgress) []operation.TransferItemProgress {
+	progress := make([]operation.TransferItemProgress, 0, len(items))
+	for _, item := range items {
+		progress = append(progress, itemProgress(item.GetPartsTotal(), item.GetPartsCompleted(),
+			item.GetStartTime(), item.GetEndTime(),
+		))
+	}
+
+	return progress
+}
+
+func itemProgress(total, completed uint32, start, end *timestamppb.Timestamp) operation.TransferItemProgress {
+	item := operation.TransferItemProgress{
+		PartsTotal:     total,
+		PartsCompleted: completed,
+	}
+	if start != nil {
+		item.StartTime = start.AsTime()
+	}
+	if end != nil {
+		item.EndTime = end.AsTime()
+	}
+
+	return item
+}
+
+func exportProgress(p Ydb_Export.ExportProgress_Progress) operation.TransferProgress {
+	switch p {
+	case Ydb_Export.ExportProgress_PROGRESS_PREPARING:
+		return operation.TransferProgressPreparing
+	case Ydb_Export.ExportProgress_PROGRESS_TRANSFER_DATA:
+		return operation.TransferProgressTransferData
+	case Ydb_Export.ExportProgress_PROGRESS_DONE:
+		return operation.TransferProgressDone
+	case Ydb_Export.ExportProgress_PROGRESS_CANCELLATION:
+		return operation.TransferProgressCancellation
+	case Ydb_Export.ExportProgress_PROGRESS_CANCELLED:
+		return operation.TransferProgressCancelled
+	default:
+		return operation.TransferProgressUnspecified
+	}
+}
+
+func importProgress(p Ydb_Import.ImportProgress_Progress) operation.TransferProgress {
+	switch p {
+	case Ydb_Import.ImportProgress_PROGRESS_PREPARING:
+		return operation.TransferProgressPreparing
+	case Ydb_Import.ImportProgress_PROGRESS_TRANSFER_DATA:
+		return operation.TransferProgressTransferData
+	case Ydb_Import.ImportProgress_PROGRESS_BUILD_INDEXES:
+		return operation.TransferProgressBuildIndexes
+	case Ydb_Import.ImportProgress_PROGRESS_DONE:
+		return operation.TransferProgressDone
+	case Ydb_Import.ImportProgress_PROGRESS_CANCELLATION:
+		return operation.TransferProgressCancellation
+	case Ydb_Import.ImportProgress_PROGRESS_CANCELLED:
+		return operation.TransferProgressCancelled
+	default:
+		return operation.TransferProgressUnspecified
+	}
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+)
+
+type serviceMock struct {
+	operations []*Ydb_Operations.Operation
+	gets       int
+	cancelled  []string
+}
+
+func (m *serviceMock) GetOperation(
+	ctx context.Context, in *Ydb_Operations.GetOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.GetOperationResponse, error) {
+	op := m.operations[m.gets]
+	if m.gets < len(m.operations)-1 {
+		m.gets++
+	}
+
+	return &Ydb_Operations.GetOperationResponse{Operation: op}, nil
+}
+
+func (m *serviceMock) CancelOperation(
+	ctx context.Context, in *Ydb_Operations.CancelOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.CancelOperationResponse, error) {
+	m.cancelled = append(m.cancelled, in.GetId())
+
+	return &Ydb_Operations.CancelOperationResponse{Status: Ydb.StatusIds_SUCCESS}, nil
+}
+
+func (m *serviceMock) ForgetOperation(
+	ctx context.Context, in *Ydb_Operations.ForgetOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.ForgetOperationResponse, error) {
+	return &Ydb_Operations.ForgetOperationResponse{Status: Ydb.StatusIds_NOT_FOUND}, nil
+}
+
+func (m *serviceMock) ListOperations(
+	ctx context.Context, in *Ydb_Operations.ListOperationsRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.ListOperationsResponse, error) {
+	if in.GetKind() != string(operation.KindBuildIndex) {
+		return &Ydb_Operations.ListOperationsResponse{Status: Ydb.StatusIds_BAD_REQUEST}, nil
+	}
+
+	return &Ydb_Operations.ListOperationsResponse{
+		Status:        Ydb.StatusIds_SUCCESS,
+		Operations:    m.operations[:in.GetPageSize()],
+		NextPageToken: "next",
+	}, nil
+}
+
+func mustAny(t *testing.T, m proto.Message) *anypb.Any {
+	a, err := anypb.New(m)
+	require.NoError(t, err)
+
+	return a
+}
+
+func buildIndexOperation(
+	t *testing.T, ready bool, state Ydb_Table.IndexBuildState_State, progress float32,
+) *Ydb_Operations.Operation {
+	return &Ydb_Operations.Operation{
+		Id:     "ydb://buildindex/7?id=1",
+		Ready:  ready,
+		Status: Ydb.StatusIds_SUCCESS,
+		Metadata: mustAny(t, &Ydb_Table.IndexBuildMetadata{
+			Description: &Ydb_Table.IndexBuildDescription{
+				Path:  "/local/series",
+				Index: &Ydb_Table.TableIndex{Name: "title_index"},
+			},
+			State:    state,
+			Progress: progress,
+		}),
+	}
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	service := &serviceMock{
+		operations: []*Ydb_Operations.Operation{
+			buildIndexOperation(t, false, Ydb_Table.IndexBuildState_STATE_PREPARING, 0),
+			buildIndexOperation(t, false, Ydb_Table.IndexBuildState_STATE_TRANSFERING_DATA, 50),
+			buildIndexOperation(t, true, Ydb_Table.IndexBuildState_STATE_DONE, 100),
+		},
+	}
+	c := &Client{config: config.New(), service: service}
+
+	t.Run("Get", func(t *testing.T) {
+		service.gets = 0
+		op, err := c.Get(ctx, "ydb://buildindex/7?id=1")
+		require.NoError(t, err)
+		require.False(t, op.Ready)
+		require.NoError(t, op.Err)
+		require.Equal(t, &operation.BuildIndexMetadata{
+			Path:     "/local/series",
+			Index:    "title_index",
+			State:    operation.BuildIndexStatePreparing,
+			Progress: 0,
+		}, op.Metadata)
+	})
+
+	t.Run("Wait", func(t *testing.T) {
+		service.gets = 0
+		var progress []float32
+		op, err := c.Wait(ctx, "ydb://buildindex/7?id=1", operation.WithWaitProgress(func(op *operation.Operation) {
+			progress = append(progress, op.Metadata.(*operation.BuildIndexMetadata).Progress)
+		}))
+		require.NoError(t, err)
+		require.True(t, op.Ready)
+		require.Equal(t, operation.BuildIndexStateDone, op.Metadata.(*operation.BuildIndexMetadata).State)
+		require.Equal(t, []float32{0, 50}, progress)
+	})
+
+	t.Run("WaitFailed", func(t *testing.T) {
+		c := &Client{config: config.New(), service: &serviceMock{
+			operations: []*Ydb_Operations.Operation{{
+				Id:     "ydb://export/6?id=2",
+				Ready:  true,
+				Status: Ydb.StatusIds_CANCELLED,
+				Metadata: mustAny(t, &Ydb_Export.ExportToS3Metadata{
+					Progress: Ydb_Export.ExportProgress_PROGRESS_CANCELLED,
+					ItemsProgress: []*Ydb_Export.ExportItemProgress{
+						{PartsTotal: 4, PartsCompleted: 1},
+					},
+				}),
+			}},
+		}}
+		op, err := c.Wait(ctx, "ydb://export/6?id=2")
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_CANCELLED))
+		require.Equal(t, op.Err, err)
+		metadata, ok := op.Metadata.(*operation.ExportMetadata)
+		require.True(t, ok)
+		require.Equal(t, operation.KindExportToS3, metadata.Kind())
+		require.Equal(t, operation.TransferProgressCancelled, metadata.Progress)
+		require.InDelta(t, 25, metadata.Percent(), 0.001)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		ops, next, err := c.List(ctx, operation.KindBuildIndex, operation.WithPageSize(2))
+		require.NoError(t, err)
+		require.Len(t, ops, 2)
+		require.Equal(t, "next", next)
+
+		_, _, err = c.List(ctx, operation.KindExportToS3)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST))
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		require.NoError(t, c.Cancel(ctx, "ydb://buildindex/7?id=1"))
+		require.Equal(t, []string{"ydb://buildindex/7?id=1"}, service.cancelled)
+	})
+
+	t.Run("Forget", func(t *testing.T) {
+		err := c.Forget(ctx, "ydb://buildindex/7?id=1")
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND))
+	})
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+)
+
+// Config is a configuration of operation client
+type Config struct {
+	config.Common
+}
+
+type Option func(c *Config)
+
+// With applies common configuration params
+func With(config config.Common) Option {
+	return func(c *Config) {
+		c.Common = config
+	}
+}
+
+func New(opts ...Option) Config {
+	c := Config{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&c)
+		}
+	}
+
+	return c
+}
@@ -10,10 +10,30 @@ func (b Builder) Build() *Parameters {
 	return &b.params
 }
 
+// Err returns first error of making of parameter value (such as invalid decimal string).
+// Parameter with invalid value keeps in built parameters, so execution of query with them returns the same error
+func (b Builder) Err() error {
+	return b.params.Err()
+}
+
+// fail adds parameter with error of making of value
+func (b *Builder) fail(name string, err error) {
+	b.params = append(b.params, &Parameter{
+		parent: *b,
+		name:   name,
+		err:    err,
+	})
+}
+
 func (b Builder) Param(name string) *Parameter {
-	return &Parameter{
+	p := &Parameter{
 		parent: b,
 		name:   name,
 		value:  nil,
 	}
+	p.compositeItem = compositeItem[Builder]{item[Builder]{
+		set: p.end,
+	}}
+
+	return p
 }
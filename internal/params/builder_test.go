@@ -518,7 +518,9 @@ func TestBuilder(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			a := allocator.New()
 			defer a.Free()
-			require.Equal(t, paramsToJSON(tt.params), paramsToJSON(tt.builder.ToYDB(a)))
+			params, err := tt.builder.ToYDB(a)
+			require.NoError(t, err)
+			require.Equal(t, paramsToJSON(tt.params), paramsToJSON(params))
 		})
 	}
 }
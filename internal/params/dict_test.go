@@ -378,7 +378,8 @@ func TestDict(t *testing.T) {
 				d, ok := xtest.CallMethod(addedKey, val.method, val.args...)[0].(*dict)
 				require.True(t, ok)
 
-				params := d.EndDict().Build().ToYDB(a)
+				params, err := d.EndDict().Build().ToYDB(a)
+				require.NoError(t, err)
 				require.Equal(t, paramsToJSON(
 					map[string]*Ydb.TypedValue{
 						"$x": {
@@ -420,7 +421,8 @@ func TestDict_AddPairs(t *testing.T) {
 		},
 	}
 
-	params := Builder{}.Param("$x").BeginDict().AddPairs(pairs...).EndDict().Build().ToYDB(a)
+	params, err := Builder{}.Param("$x").BeginDict().AddPairs(pairs...).EndDict().Build().ToYDB(a)
+	require.NoError(t, err)
 
 	require.Equal(t, paramsToJSON(
 		map[string]*Ydb.TypedValue{
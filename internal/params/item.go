@@ -0,0 +1,188 @@
+package params
+
+import (
+	"time"
+
+	ydbDecimal "github.com/ydb-platform/ydb-go-sdk/v3/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// item is a builder of single value (parameter value, list item, struct field, etc.)
+// Item passes value (or error of value making) to parent and returns parent for chaining.
+// Struct fields, tuple items and variant alternatives are plain items, so deeper nested
+// composite values (such as struct inside struct) are set with Any
+type item[T any] struct {
+	set func(v value.Value, err error) T
+}
+
+func decimalValueFromString(s string, precision, scale uint32) (value.Value, error) {
+	v, err := decimal.Parse(s, precision, scale)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return value.DecimalValueFromBigInt(v, precision, scale), nil
+}
+
+func (i item[T]) Text(v string) T {
+	return i.set(value.TextValue(v), nil)
+}
+
+func (i item[T]) Bytes(v []byte) T {
+	return i.set(value.BytesValue(v), nil)
+}
+
+func (i item[T]) Bool(v bool) T {
+	return i.set(value.BoolValue(v), nil)
+}
+
+func (i item[T]) Uint64(v uint64) T {
+	return i.set(value.Uint64Value(v), nil)
+}
+
+func (i item[T]) Int64(v int64) T {
+	return i.set(value.Int64Value(v), nil)
+}
+
+func (i item[T]) Uint32(v uint32) T {
+	return i.set(value.Uint32Value(v), nil)
+}
+
+func (i item[T]) Int32(v int32) T {
+	return i.set(value.Int32Value(v), nil)
+}
+
+func (i item[T]) Uint16(v uint16) T {
+	return i.set(value.Uint16Value(v), nil)
+}
+
+func (i item[T]) Int16(v int16) T {
+	return i.set(value.Int16Value(v), nil)
+}
+
+func (i item[T]) Uint8(v uint8) T {
+	return i.set(value.Uint8Value(v), nil)
+}
+
+func (i item[T]) Int8(v int8) T {
+	return i.set(value.Int8Value(v), nil)
+}
+
+func (i item[T]) Float(v float32) T {
+	return i.set(value.FloatValue(v), nil)
+}
+
+func (i item[T]) Double(v float64) T {
+	return i.set(value.DoubleValue(v), nil)
+}
+
+func (i item[T]) Decimal(v [16]byte, precision, scale uint32) T {
+	return i.set(value.DecimalValue(v, precision, scale), nil)
+}
+
+func (i item[T]) DecimalFromString(v string, precision, scale uint32) T {
+	return i.set(decimalValueFromString(v, precision, scale))
+}
+
+func (i item[T]) DecimalNumber(v ydbDecimal.Decimal) T {
+	return i.set(v.ToYDBValue())
+}
+
+func (i item[T]) Timestamp(v time.Time) T {
+	return i.set(value.TimestampValueFromTime(v), nil)
+}
+
+func (i item[T]) Date(v time.Time) T {
+	return i.set(value.DateValueFromTime(v), nil)
+}
+
+func (i item[T]) Datetime(v time.Time) T {
+	return i.set(value.DatetimeValueFromTime(v), nil)
+}
+
+func (i item[T]) Interval(v time.Duration) T {
+	return i.set(value.IntervalValueFromDuration(v), nil)
+}
+
+func (i item[T]) Date32(v time.Time) T {
+	return i.set(value.Date32ValueFromTime(v), nil)
+}
+
+func (i item[T]) Datetime64(v time.Time) T {
+	return i.set(value.Datetime64ValueFromTime(v), nil)
+}
+
+func (i item[T]) Timestamp64(v time.Time) T {
+	return i.set(value.Timestamp64ValueFromTime(v), nil)
+}
+
+func (i item[T]) Interval64(v time.Duration) T {
+	return i.set(value.Interval64ValueFromDuration(v), nil)
+}
+
+func (i item[T]) TzDate(v time.Time) T {
+	return i.set(value.TzDateValueFromTime(v), nil)
+}
+
+func (i item[T]) TzDatetime(v time.Time) T {
+	return i.set(value.TzDatetimeValueFromTime(v), nil)
+}
+
+func (i item[T]) TzTimestamp(v time.Time) T {
+	return i.set(value.TzTimestampValueFromTime(v), nil)
+}
+
+func (i item[T]) JSON(v string) T {
+	return i.set(value.JSONValue(v), nil)
+}
+
+func (i item[T]) JSONDocument(v string) T {
+	return i.set(value.JSONDocumentValue(v), nil)
+}
+
+func (i item[T]) YSON(v []byte) T {
+	return i.set(value.YSONValue(v), nil)
+}
+
+func (i item[T]) UUID(v [16]byte) T {
+	return i.set(value.UUIDValue(v), nil)
+}
+
+func (i item[T]) DyNumber(v string) T {
+	return i.set(value.DyNumberValue(v), nil)
+}
+
+func (i item[T]) Void() T {
+	return i.set(value.VoidValue(), nil)
+}
+
+// Null makes null value of Optional<t> type
+func (i item[T]) Null(t types.Type) T {
+	return i.set(value.NullValue(t), nil)
+}
+
+// Any sets value which was made outside of builder
+func (i item[T]) Any(v value.Value) T {
+	return i.set(v, nil)
+}
+
+// Optional wraps next value into Optional<...>
+func (i item[T]) Optional() item[T] {
+	return item[T]{
+		set: func(v value.Value, err error) T {
+			if err != nil {
+				return i.set(nil, err)
+			}
+
+			return i.set(value.OptionalValue(v), nil)
+		},
+	}
+}
+
+// compositeItem is an item which value may be a struct, tuple or variant also
+type compositeItem[T any] struct {
+	item[T]
+}
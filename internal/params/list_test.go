@@ -677,7 +677,8 @@ func TestList(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			a := allocator.New()
 			defer a.Free()
-			params := tt.builder.Build().ToYDB(a)
+			params, err := tt.builder.Build().ToYDB(a)
+			require.NoError(t, err)
 			require.Equal(t, paramsToJSON(tt.params), paramsToJSON(params))
 		})
 	}
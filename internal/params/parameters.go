@@ -2,12 +2,12 @@ package params
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
 )
 
@@ -17,9 +17,14 @@ type (
 		Value() value.Value
 	}
 	Parameter struct {
+		// compositeItem makes value of parameter with methods such as Text or BeginStruct
+		compositeItem[Builder]
+
 		parent Builder
 		name   string
 		value  value.Value
+		// err is an error of making of value of parameter
+		err error
 	}
 	Parameters []*Parameter
 )
@@ -52,6 +57,11 @@ func (p *Parameters) String() string {
 			buffer.WriteByte('"')
 			buffer.WriteString(param.name)
 			buffer.WriteString("\":")
+			if param.err != nil {
+				buffer.WriteString("<invalid>")
+
+				continue
+			}
 			buffer.WriteString(param.value.Yql())
 		}
 	}
@@ -60,16 +70,34 @@ func (p *Parameters) String() string {
 	return buffer.String()
 }
 
-func (p *Parameters) ToYDB(a *allocator.Allocator) map[string]*Ydb.TypedValue {
+// ToYDB returns parameters as protobuf values or error of making of value of first invalid parameter
+func (p *Parameters) ToYDB(a *allocator.Allocator) (map[string]*Ydb.TypedValue, error) {
 	if p == nil {
-		return nil
+		return nil, nil
+	}
+	if err := p.Err(); err != nil {
+		return nil, err
 	}
 	parameters := make(map[string]*Ydb.TypedValue, len(*p))
 	for _, param := range *p {
 		parameters[param.name] = value.ToYDB(param.value, a)
 	}
 
-	return parameters
+	return parameters, nil
+}
+
+// Err returns error of making of value of first invalid parameter
+func (p *Parameters) Err() error {
+	if p == nil {
+		return nil
+	}
+	for _, param := range *p {
+		if param.err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("invalid value of parameter %q: %w", param.name, param.err))
+		}
+	}
+
+	return nil
 }
 
 func (p *Parameters) Each(it func(name string, v value.Value)) {
@@ -77,6 +105,9 @@ func (p *Parameters) Each(it func(name string, v value.Value)) {
 		return
 	}
 	for _, p := range *p {
+		if p.err != nil {
+			continue
+		}
 		it(p.name, p.value)
 	}
 }
@@ -95,17 +126,36 @@ func (p *Parameters) Add(params ...NamedValue) {
 	}
 }
 
-func (p *Parameter) BeginOptional() *optional {
-	return &optional{
-		parent: p.parent,
-		name:   p.name,
+// end sets value of parameter (or error of making of value) and adds parameter to builder
+func (p *Parameter) end(v value.Value, err error) Builder {
+	if err != nil {
+		p.parent.fail(p.name, err)
+
+		return p.parent
+	}
+	p.value = v
+	p.parent.params = append(p.parent.params, p)
+
+	return p.parent
+}
+
+func (p *Parameter) BeginOptional() compositeItem[*optional] {
+	o := &optional{
+		parent: p,
 	}
+
+	return compositeItem[*optional]{item[*optional]{
+		set: func(v value.Value, err error) *optional {
+			o.value, o.err = v, err
+
+			return o
+		},
+	}}
 }
 
 func (p *Parameter) BeginList() *list {
 	return &list{
-		parent: p.parent,
-		name:   p.name,
+		parent: p,
 	}
 }
 
@@ -115,172 +165,16 @@ func (p *Parameter) Pg() pgParam {
 
 func (p *Parameter) BeginSet() *set {
 	return &set{
-		parent: p.parent,
-		name:   p.name,
+		parent: p,
 	}
 }
 
 func (p *Parameter) BeginDict() *dict {
 	return &dict{
-		parent: p.parent,
-		name:   p.name,
+		parent: p,
 	}
 }
 
-func (p *Parameter) Text(v string) Builder {
-	p.value = value.TextValue(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Bytes(v []byte) Builder {
-	p.value = value.BytesValue(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Bool(v bool) Builder {
-	p.value = value.BoolValue(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Uint64(v uint64) Builder {
-	p.value = value.Uint64Value(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Int64(v int64) Builder {
-	p.value = value.Int64Value(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Uint32(v uint32) Builder {
-	p.value = value.Uint32Value(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Int32(v int32) Builder {
-	p.value = value.Int32Value(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Uint16(v uint16) Builder {
-	p.value = value.Uint16Value(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Int16(v int16) Builder {
-	p.value = value.Int16Value(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Uint8(v uint8) Builder {
-	p.value = value.Uint8Value(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Int8(v int8) Builder {
-	p.value = value.Int8Value(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Float(v float32) Builder {
-	p.value = value.FloatValue(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Double(v float64) Builder {
-	p.value = value.DoubleValue(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Decimal(v [16]byte, precision, scale uint32) Builder {
-	p.value = value.DecimalValue(v, precision, scale)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Timestamp(v time.Time) Builder {
-	p.value = value.TimestampValueFromTime(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Date(v time.Time) Builder {
-	p.value = value.DateValueFromTime(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Datetime(v time.Time) Builder {
-	p.value = value.DatetimeValueFromTime(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) Interval(v time.Duration) Builder {
-	p.value = value.IntervalValueFromDuration(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) JSON(v string) Builder {
-	p.value = value.JSONValue(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) JSONDocument(v string) Builder {
-	p.value = value.JSONDocumentValue(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) YSON(v []byte) Builder {
-	p.value = value.YSONValue(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
-func (p *Parameter) UUID(v [16]byte) Builder {
-	p.value = value.UUIDValue(v)
-	p.parent.params = append(p.parent.params, p)
-
-	return p.parent
-}
-
 func Declare(p *Parameter) string {
 	return fmt.Sprintf(
 		"DECLARE %s AS %s",
@@ -64,7 +64,9 @@ func TestNil(t *testing.T) {
 			require.Empty(t, visited)
 			a := allocator.New()
 			defer a.Free()
-			require.Empty(t, tt.p.ToYDB(a))
+			params, err := tt.p.ToYDB(a)
+			require.NoError(t, err)
+			require.Empty(t, params)
 		})
 	}
 }
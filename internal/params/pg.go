@@ -2,6 +2,9 @@ package params
 
 import (
 	"strconv"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
@@ -15,6 +18,7 @@ func (p pgParam) Unknown(val string) Builder {
 	return p.Value(pg.OIDUnknown, val)
 }
 
+// Value sets value of postgres type with given OID in text representation
 func (p pgParam) Value(oid uint32, val string) Builder {
 	p.param.value = value.PgValue(oid, val)
 	p.param.parent.params = append(p.param.parent.params, p.param)
@@ -22,6 +26,37 @@ func (p pgParam) Value(oid uint32, val string) Builder {
 	return p.param.parent
 }
 
+// Null sets NULL of postgres type with given OID
+func (p pgParam) Null(oid uint32) Builder {
+	p.param.value = value.PgNullValue(oid)
+	p.param.parent.params = append(p.param.parent.params, p.param)
+
+	return p.param.parent
+}
+
+// FromGo sets value of postgres type by go type (such as pgint8 for int64, pgtext for string,
+// pgtimestamptz for time.Time, _pgint4 for []int32 and NULL for nil pointers)
+func (p pgParam) FromGo(v interface{}) Builder {
+	pv, err := value.PgValueFromGo(v)
+	if err != nil {
+		p.param.parent.fail(p.param.name, err)
+
+		return p.param.parent
+	}
+	p.param.value = pv
+	p.param.parent.params = append(p.param.parent.params, p.param)
+
+	return p.param.parent
+}
+
+func (p pgParam) Bool(val bool) Builder {
+	return p.Value(pg.OIDBool, pg.FormatBool(val))
+}
+
+func (p pgParam) Int2(val int16) Builder {
+	return p.Value(pg.OIDInt2, strconv.FormatInt(int64(val), 10))
+}
+
 func (p pgParam) Int4(val int32) Builder {
 	return p.Value(pg.OIDInt4, strconv.FormatInt(int64(val), 10))
 }
@@ -29,3 +64,78 @@ func (p pgParam) Int4(val int32) Builder {
 func (p pgParam) Int8(val int64) Builder {
 	return p.Value(pg.OIDInt8, strconv.FormatInt(val, 10))
 }
+
+func (p pgParam) Float4(val float32) Builder {
+	return p.Value(pg.OIDFloat4, strconv.FormatFloat(float64(val), 'g', -1, 32))
+}
+
+func (p pgParam) Float8(val float64) Builder {
+	return p.Value(pg.OIDFloat8, strconv.FormatFloat(val, 'g', -1, 64))
+}
+
+// Numeric sets value of type numeric from its text representation (such as "-123.45")
+func (p pgParam) Numeric(val string) Builder {
+	return p.Value(pg.OIDNumeric, val)
+}
+
+func (p pgParam) Text(val string) Builder {
+	return p.Value(pg.OIDText, val)
+}
+
+func (p pgParam) Varchar(val string) Builder {
+	return p.Value(pg.OIDVarchar, val)
+}
+
+func (p pgParam) Bytea(val []byte) Builder {
+	return p.Value(pg.OIDBytea, pg.FormatBytea(val))
+}
+
+func (p pgParam) JSON(val string) Builder {
+	return p.Value(pg.OIDJSON, val)
+}
+
+func (p pgParam) JSONB(val string) Builder {
+	return p.Value(pg.OIDJSONB, val)
+}
+
+func (p pgParam) UUID(val uuid.UUID) Builder {
+	return p.Value(pg.OIDUUID, val.String())
+}
+
+// Date sets date of time in UTC
+func (p pgParam) Date(val time.Time) Builder {
+	return p.Value(pg.OIDDate, val.UTC().Format(pg.DateLayout))
+}
+
+// Timestamp sets timestamp without time zone (time in UTC)
+func (p pgParam) Timestamp(val time.Time) Builder {
+	return p.Value(pg.OIDTimestamp, val.UTC().Format(pg.TimestampLayout))
+}
+
+func (p pgParam) Timestamptz(val time.Time) Builder {
+	return p.Value(pg.OIDTimestampTz, val.Format(pg.TimestampTzLayout))
+}
+
+func (p pgParam) Interval(val time.Duration) Builder {
+	return p.Value(pg.OIDInterval, pg.FormatInterval(val))
+}
+
+func (p pgParam) BoolArray(val []bool) Builder {
+	return p.FromGo(val)
+}
+
+func (p pgParam) Int4Array(val []int32) Builder {
+	return p.FromGo(val)
+}
+
+func (p pgParam) Int8Array(val []int64) Builder {
+	return p.FromGo(val)
+}
+
+func (p pgParam) Float8Array(val []float64) Builder {
+	return p.FromGo(val)
+}
+
+func (p pgParam) TextArray(val []string) Builder {
+	return p.FromGo(val)
+}
@@ -0,0 +1,150 @@
+package params
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestPgParams(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.FixedZone("", 3*60*60))
+	id := uuid.MustParse("6e73b41c-4ede-4d08-9cfb-b7462d9e498b")
+	for _, tt := range []struct {
+		name    string
+		builder Builder
+		oid     uint32
+		value   *Ydb.Value
+	}{
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Bool(true),
+			oid:     pg.OIDBool,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: "t"}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Int2(-1),
+			oid:     pg.OIDInt2,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: "-1"}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Float8(0.25),
+			oid:     pg.OIDFloat8,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: "0.25"}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Numeric("-123.450"),
+			oid:     pg.OIDNumeric,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: "-123.450"}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Text("abc"),
+			oid:     pg.OIDText,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: "abc"}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Bytea([]byte{1, 2}),
+			oid:     pg.OIDBytea,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: `\x0102`}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().JSONB(`{"a":1}`),
+			oid:     pg.OIDJSONB,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: `{"a":1}`}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().UUID(id),
+			oid:     pg.OIDUUID,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: id.String()}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Date(ts),
+			oid:     pg.OIDDate,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: "2024-01-02"}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Timestamp(ts),
+			oid:     pg.OIDTimestamp,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: "2024-01-02 00:04:05.123456"}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Timestamptz(ts),
+			oid:     pg.OIDTimestampTz,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: "2024-01-02 03:04:05.123456+03:00"}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Interval(time.Hour + 500*time.Millisecond),
+			oid:     pg.OIDInterval,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: "01:00:00.5"}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Int4Array([]int32{1, 2}),
+			oid:     pg.OIDInt4Array,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: `{"1","2"}`}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().TextArray([]string{"a", "b c"}),
+			oid:     pg.OIDTextArray,
+			value:   &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: `{"a","b c"}`}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().Null(pg.OIDInt8),
+			oid:     pg.OIDInt8,
+			value:   &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}},
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").Pg().FromGo((*int32)(nil)),
+			oid:     pg.OIDInt4,
+			value:   &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a := allocator.New()
+			defer a.Free()
+			require.NoError(t, tt.builder.Err())
+			params, err := tt.builder.Build().ToYDB(a)
+			require.NoError(t, err)
+			require.Equal(t, paramsToJSON(map[string]*Ydb.TypedValue{
+				"$x": {
+					Type: &Ydb.Type{
+						Type: &Ydb.Type_PgType{
+							PgType: &Ydb.PgType{
+								Oid: tt.oid,
+							},
+						},
+					},
+					Value: tt.value,
+				},
+			}), paramsToJSON(params))
+		})
+	}
+	t.Run("FromGoError", func(t *testing.T) {
+		b := Builder{}.Param("$x").Pg().FromGo(struct{}{})
+		require.Error(t, b.Err())
+		require.Error(t, b.Build().Err())
+		params, err := b.Build().ToYDB(allocator.New())
+		require.Error(t, err)
+		require.Empty(t, params)
+	})
+}
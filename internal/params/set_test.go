@@ -867,7 +867,8 @@ func TestSet(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			a := allocator.New()
 			defer a.Free()
-			params := tt.builder.Build().ToYDB(a)
+			params, err := tt.builder.Build().ToYDB(a)
+			require.NoError(t, err)
 			require.Equal(t, paramsToJSON(tt.params), paramsToJSON(params))
 		})
 	}
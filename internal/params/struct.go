@@ -0,0 +1,49 @@
+package params
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+type structure[T any] struct {
+	end    func(v value.Value, err error) T
+	fields []value.StructValueField
+	err    error
+}
+
+func (i compositeItem[T]) BeginStruct() *structure[T] {
+	return &structure[T]{
+		end: i.set,
+	}
+}
+
+// Field returns builder of value of struct field with given name
+func (s *structure[T]) Field(name string) item[*structure[T]] {
+	return item[*structure[T]]{
+		set: func(v value.Value, err error) *structure[T] {
+			if err != nil {
+				s.err = err
+			} else {
+				s.fields = append(s.fields, value.StructValueField{
+					Name: name,
+					V:    v,
+				})
+			}
+
+			return s
+		},
+	}
+}
+
+func (s *structure[T]) AddFields(fields ...value.StructValueField) *structure[T] {
+	s.fields = append(s.fields, fields...)
+
+	return s
+}
+
+func (s *structure[T]) EndStruct() T {
+	if s.err != nil {
+		return s.end(nil, s.err)
+	}
+
+	return s.end(value.StructValue(s.fields...), nil)
+}
@@ -0,0 +1,189 @@
+package params
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	ydbDecimal "github.com/ydb-platform/ydb-go-sdk/v3/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestComposite(t *testing.T) {
+	now := time.Unix(123, 0)
+	for _, tt := range []struct {
+		name    string
+		builder Builder
+		exp     value.Value
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginStruct().
+				Field("id").Uint64(1).
+				Field("title").Text("test").
+				EndStruct(),
+			exp: value.StructValue(
+				value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+				value.StructValueField{Name: "title", V: value.TextValue("test")},
+			),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginList().
+				Add().BeginStruct().
+				Field("id").Uint64(1).
+				Field("title").Optional().Text("test").
+				EndStruct().
+				Add().BeginStruct().
+				Field("id").Uint64(2).
+				Field("title").Null(types.Text).
+				EndStruct().
+				EndList(),
+			exp: value.ListValue(
+				value.StructValue(
+					value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+					value.StructValueField{Name: "title", V: value.OptionalValue(value.TextValue("test"))},
+				),
+				value.StructValue(
+					value.StructValueField{Name: "id", V: value.Uint64Value(2)},
+					value.StructValueField{Name: "title", V: value.NullValue(types.Text)},
+				),
+			),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginTuple().
+				Add().Int32(1).
+				Add().TzDatetime(now).
+				Add().Any(value.ListValue(value.Int32Value(1))).
+				EndTuple(),
+			exp: value.TupleValue(
+				value.Int32Value(1),
+				value.TzDatetimeValueFromTime(now),
+				value.ListValue(value.Int32Value(1)),
+			),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginOptional().BeginTuple().
+				Add().DyNumber("123").
+				EndTuple().
+				EndOptional(),
+			exp: value.OptionalValue(value.TupleValue(value.DyNumberValue("123"))),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginDict().
+				Add().Text("key").BeginStruct().Field("a").Void().EndStruct().
+				EndDict(),
+			exp: value.DictValue(value.DictValueField{
+				K: value.TextValue("key"),
+				V: value.StructValue(value.StructValueField{Name: "a", V: value.VoidValue()}),
+			}),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginVariant().
+				Tuple(types.Int32, types.Text).
+				Index(1).Text("test").
+				EndVariant(),
+			exp: value.VariantValueTuple(value.TextValue("test"), 1, types.NewTuple(types.Int32, types.Text)),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginVariant().
+				Struct(types.NewStruct(
+					types.StructField{Name: "a", T: types.Int32},
+					types.StructField{Name: "b", T: types.Text},
+				)).
+				Name("b").Text("test").
+				EndVariant(),
+			exp: value.VariantValueStruct(value.TextValue("test"), "b", types.NewStruct(
+				types.StructField{Name: "a", T: types.Int32},
+				types.StructField{Name: "b", T: types.Text},
+			)),
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").DecimalFromString("-12.345", 22, 9),
+			exp:     value.DecimalValueFromBigInt(big.NewInt(-12345000000), 22, 9),
+		},
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").DecimalNumber(ydbDecimal.MustParse("-12.345", 22, 9)),
+			exp:     value.DecimalValueFromBigInt(big.NewInt(-12345000000), 22, 9),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginList().
+				Add().DecimalNumber(ydbDecimal.MustParse("1.5", 22, 2)).
+				EndList(),
+			exp: value.ListValue(value.DecimalValueFromBigInt(big.NewInt(150), 22, 2)),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginSet().
+				Add().TzDate(now).
+				EndSet(),
+			exp: value.SetValue(value.TzDateValueFromTime(now)),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.builder.Err())
+			a := allocator.New()
+			defer a.Free()
+			params, err := tt.builder.Build().ToYDB(a)
+			require.NoError(t, err)
+			require.Equal(t, paramsToJSON(map[string]*Ydb.TypedValue{
+				"$x": value.ToYDB(tt.exp, a),
+			}), paramsToJSON(params))
+		})
+	}
+}
+
+func TestCompositeErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		builder Builder
+	}{
+		{
+			name:    xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").DecimalFromString("abc", 22, 9),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginList().
+				Add().BeginStruct().Field("a").DecimalFromString("1.2.3", 22, 9).EndStruct().
+				EndList(),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginVariant().
+				Tuple(types.Int32).
+				Index(1).Int32(1).
+				EndVariant(),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			builder: Builder{}.Param("$x").BeginVariant().
+				Struct(types.NewStruct(types.StructField{Name: "a", T: types.Int32})).
+				Name("b").Int32(1).
+				EndVariant(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Error(t, tt.builder.Err())
+			// invalid parameter keeps in built parameters for returning of error on execution
+			require.Error(t, tt.builder.Build().Err())
+			params, err := tt.builder.Build().ToYDB(allocator.New())
+			require.Error(t, err)
+			require.Empty(t, params)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package params
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+type tuple[T any] struct {
+	end    func(v value.Value, err error) T
+	values []value.Value
+	err    error
+}
+
+func (i compositeItem[T]) BeginTuple() *tuple[T] {
+	return &tuple[T]{
+		end: i.set,
+	}
+}
+
+// Add returns builder of next tuple item
+func (t *tuple[T]) Add() item[*tuple[T]] {
+	return item[*tuple[T]]{
+		set: func(v value.Value, err error) *tuple[T] {
+			if err != nil {
+				t.err = err
+			} else {
+				t.values = append(t.values, v)
+			}
+
+			return t
+		},
+	}
+}
+
+func (t *tuple[T]) AddItems(items ...value.Value) *tuple[T] {
+	t.values = append(t.values, items...)
+
+	return t
+}
+
+func (t *tuple[T]) EndTuple() T {
+	if t.err != nil {
+		return t.end(nil, t.err)
+	}
+
+	return t.end(value.TupleValue(t.values...), nil)
+}
@@ -0,0 +1,128 @@
+package params
+
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	variant[T any] struct {
+		end func(v value.Value, err error) T
+	}
+	variantTuple[T any] struct {
+		parent *variant[T]
+		t      *types.Tuple
+	}
+	variantStruct[T any] struct {
+		parent *variant[T]
+		t      *types.Struct
+		err    error
+	}
+	variantBuilder[T any] struct {
+		parent *variant[T]
+		value  value.Value
+		err    error
+	}
+)
+
+func (i compositeItem[T]) BeginVariant() *variant[T] {
+	return &variant[T]{
+		end: i.set,
+	}
+}
+
+// Tuple defines variant over tuple with given types of items
+func (v *variant[T]) Tuple(items ...types.Type) *variantTuple[T] {
+	return &variantTuple[T]{
+		parent: v,
+		t:      types.NewTuple(items...),
+	}
+}
+
+// Index returns builder of value of variant alternative with given index
+func (vt *variantTuple[T]) Index(i uint32) item[*variantBuilder[T]] {
+	b := &variantBuilder[T]{
+		parent: vt.parent,
+	}
+
+	return item[*variantBuilder[T]]{
+		set: func(v value.Value, err error) *variantBuilder[T] {
+			switch {
+			case err != nil:
+				b.err = err
+			case int(i) >= len(vt.t.InnerTypes()):
+				b.err = xerrors.WithStackTrace(fmt.Errorf(
+					"variant index %d is out of range of %s", i, vt.t.Yql(),
+				))
+			default:
+				b.value = value.VariantValueTuple(v, i, vt.t)
+			}
+
+			return b
+		},
+	}
+}
+
+// Struct defines variant over struct with given struct type (such as types.Struct(...))
+func (v *variant[T]) Struct(t types.Type) *variantStruct[T] {
+	vs := &variantStruct[T]{
+		parent: v,
+	}
+	switch tt := t.(type) {
+	case *types.Struct:
+		vs.t = tt
+	case *types.VariantStruct:
+		vs.t = tt.Struct
+	default:
+		vs.err = xerrors.WithStackTrace(fmt.Errorf("type %s is not a struct type", t.Yql()))
+	}
+
+	return vs
+}
+
+// Name returns builder of value of variant alternative with given name
+func (vs *variantStruct[T]) Name(name string) item[*variantBuilder[T]] {
+	b := &variantBuilder[T]{
+		parent: vs.parent,
+		err:    vs.err,
+	}
+
+	return item[*variantBuilder[T]]{
+		set: func(v value.Value, err error) *variantBuilder[T] {
+			switch {
+			case b.err != nil:
+			case err != nil:
+				b.err = err
+			case !hasStructField(vs.t, name):
+				b.err = xerrors.WithStackTrace(fmt.Errorf(
+					"variant alternative '%s' not found in %s", name, vs.t.Yql(),
+				))
+			default:
+				b.value = value.VariantValueStruct(v, name, vs.t)
+			}
+
+			return b
+		},
+	}
+}
+
+func hasStructField(t *types.Struct, name string) bool {
+	for _, f := range t.Fields() {
+		if f.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *variantBuilder[T]) EndVariant() T {
+	if b.err != nil {
+		return b.parent.end(nil, b.err)
+	}
+
+	return b.parent.end(b.value, nil)
+}
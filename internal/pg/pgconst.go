@@ -3,7 +3,125 @@ package pg
 const (
 	// https://github.com/postgres/postgres/blob/master/src/include/catalog/pg_type.dat
 
-	OIDInt4    = 23
-	OIDInt8    = 20
-	OIDUnknown = 705
+	OIDBool             = 16
+	OIDBytea            = 17
+	OIDInt8             = 20
+	OIDInt2             = 21
+	OIDInt4             = 23
+	OIDText             = 25
+	OIDOid              = 26
+	OIDJSON             = 114
+	OIDFloat4           = 700
+	OIDFloat8           = 701
+	OIDUnknown          = 705
+	OIDBoolArray        = 1000
+	OIDByteaArray       = 1001
+	OIDInt2Array        = 1005
+	OIDInt4Array        = 1007
+	OIDTextArray        = 1009
+	OIDVarcharArray     = 1015
+	OIDInt8Array        = 1016
+	OIDFloat4Array      = 1021
+	OIDFloat8Array      = 1022
+	OIDVarchar          = 1043
+	OIDDate             = 1082
+	OIDTime             = 1083
+	OIDTimestamp        = 1114
+	OIDTimestampTz      = 1184
+	OIDInterval         = 1186
+	OIDNumeric          = 1700
+	OIDUUID             = 2950
+	OIDJSONB            = 3802
+	OIDJSONArray        = 199
+	OIDDateArray        = 1182
+	OIDTimestampArray   = 1115
+	OIDTimestampTzArray = 1185
+	OIDNumericArray     = 1231
+	OIDUUIDArray        = 2951
+	OIDJSONBArray       = 3807
 )
+
+// types describes known types: name of type (without prefix "pg") and OID of array elements
+var types = map[uint32]struct {
+	name string
+	elem uint32
+}{
+	OIDBool:             {name: "bool"},
+	OIDBytea:            {name: "bytea"},
+	OIDInt8:             {name: "int8"},
+	OIDInt2:             {name: "int2"},
+	OIDInt4:             {name: "int4"},
+	OIDText:             {name: "text"},
+	OIDOid:              {name: "oid"},
+	OIDJSON:             {name: "json"},
+	OIDFloat4:           {name: "float4"},
+	OIDFloat8:           {name: "float8"},
+	OIDUnknown:          {name: "unknown"},
+	OIDVarchar:          {name: "varchar"},
+	OIDDate:             {name: "date"},
+	OIDTime:             {name: "time"},
+	OIDTimestamp:        {name: "timestamp"},
+	OIDTimestampTz:      {name: "timestamptz"},
+	OIDInterval:         {name: "interval"},
+	OIDNumeric:          {name: "numeric"},
+	OIDUUID:             {name: "uuid"},
+	OIDJSONB:            {name: "jsonb"},
+	OIDBoolArray:        {name: "_bool", elem: OIDBool},
+	OIDByteaArray:       {name: "_bytea", elem: OIDBytea},
+	OIDInt2Array:        {name: "_int2", elem: OIDInt2},
+	OIDInt4Array:        {name: "_int4", elem: OIDInt4},
+	OIDInt8Array:        {name: "_int8", elem: OIDInt8},
+	OIDTextArray:        {name: "_text", elem: OIDText},
+	OIDVarcharArray:     {name: "_varchar", elem: OIDVarchar},
+	OIDFloat4Array:      {name: "_float4", elem: OIDFloat4},
+	OIDFloat8Array:      {name: "_float8", elem: OIDFloat8},
+	OIDJSONArray:        {name: "_json", elem: OIDJSON},
+	OIDDateArray:        {name: "_date", elem: OIDDate},
+	OIDTimestampArray:   {name: "_timestamp", elem: OIDTimestamp},
+	OIDTimestampTzArray: {name: "_timestamptz", elem: OIDTimestampTz},
+	OIDNumericArray:     {name: "_numeric", elem: OIDNumeric},
+	OIDUUIDArray:        {name: "_uuid", elem: OIDUUID},
+	OIDJSONBArray:       {name: "_jsonb", elem: OIDJSONB},
+}
+
+// TypeName returns name of type by OID (such as "int4" or "_text" for arrays).
+// Unknown OIDs are named as "unknown"
+func TypeName(oid uint32) string {
+	if t, has := types[oid]; has {
+		return t.name
+	}
+
+	return "unknown"
+}
+
+// TypeOID returns OID of known type by name (such as "int4" or "_text" for arrays)
+func TypeOID(name string) (oid uint32, ok bool) {
+	for oid, t := range types {
+		if t.name == name {
+			return oid, true
+		}
+	}
+
+	return 0, false
+}
+
+// ArrayElem returns OID of elements if oid is a known array type
+func ArrayElem(oid uint32) (elem uint32, ok bool) {
+	t, has := types[oid]
+	if !has || t.elem == 0 {
+		return 0, false
+	}
+
+	return t.elem, true
+}
+
+// ArrayOf returns OID of array type with elements of type elem
+func ArrayOf(elem uint32) (oid uint32, ok bool) {
+	for oid, t := range types {
+		if t.elem == elem && elem != 0 {
+			return oid, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,300 @@
+package pg
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Text representations of values (as postgres input and output functions do)
+
+const (
+	DateLayout        = "2006-01-02"
+	TimestampLayout   = "2006-01-02 15:04:05.999999"
+	TimestampTzLayout = "2006-01-02 15:04:05.999999Z07:00"
+)
+
+var errSyntax = errors.New("pg: invalid syntax")
+
+// FormatBool returns "t" or "f"
+func FormatBool(v bool) string {
+	if v {
+		return "t"
+	}
+
+	return "f"
+}
+
+// ParseBool parses all postgres spellings of boolean (t, true, yes, on, 1 and opposites)
+func ParseBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "t", "true", "y", "yes", "on", "1":
+		return true, nil
+	case "f", "false", "n", "no", "off", "0":
+		return false, nil
+	default:
+		return false, fmt.Errorf("%w: boolean %q", errSyntax, s)
+	}
+}
+
+// FormatBytea returns bytes in hex format (such as \x0102)
+func FormatBytea(v []byte) string {
+	return `\x` + hex.EncodeToString(v)
+}
+
+// ParseBytea parses bytes in hex format (\x0102) or in escape format (abc\001)
+func ParseBytea(s string) ([]byte, error) {
+	if strings.HasPrefix(s, `\x`) {
+		b, err := hex.DecodeString(s[2:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: bytea %q", errSyntax, s)
+		}
+
+		return b, nil
+	}
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b = append(b, s[i])
+
+			continue
+		}
+		switch {
+		case i+1 < len(s) && s[i+1] == '\\':
+			b = append(b, '\\')
+			i++
+		case i+3 < len(s):
+			v, err := strconv.ParseUint(s[i+1:i+4], 8, 8)
+			if err != nil {
+				return nil, fmt.Errorf("%w: bytea %q", errSyntax, s)
+			}
+			b = append(b, byte(v))
+			i += 3
+		default:
+			return nil, fmt.Errorf("%w: bytea %q", errSyntax, s)
+		}
+	}
+
+	return b, nil
+}
+
+// FormatInterval returns duration as interval in postgres format (such as -25:01:02.5)
+func FormatInterval(v time.Duration) string {
+	var sb strings.Builder
+	us := v.Microseconds()
+	if us < 0 {
+		sb.WriteByte('-')
+		us = -us
+	}
+	fmt.Fprintf(&sb, "%02d:%02d:%02d", us/int64(time.Hour/time.Microsecond),
+		us/int64(time.Minute/time.Microsecond)%60, us/int64(time.Second/time.Microsecond)%60,
+	)
+	if frac := us % int64(time.Second/time.Microsecond); frac != 0 {
+		sb.WriteString(strings.TrimRight(fmt.Sprintf(".%06d", frac), "0"))
+	}
+
+	return sb.String()
+}
+
+// ParseInterval parses interval in postgres format (such as "1 day -02:03:04.5" or "3 days").
+// Intervals with months or years cannot be represented as duration and return error
+func ParseInterval(s string) (time.Duration, error) {
+	var (
+		total  time.Duration
+		fields = strings.Fields(s)
+	)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("%w: interval %q", errSyntax, s)
+	}
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if strings.Contains(f, ":") {
+			d, err := parseClock(f)
+			if err != nil {
+				return 0, fmt.Errorf("%w: interval %q", errSyntax, s)
+			}
+			total += d
+
+			continue
+		}
+		n, err := strconv.ParseInt(f, 10, 64)
+		if err != nil || i+1 >= len(fields) {
+			return 0, fmt.Errorf("%w: interval %q", errSyntax, s)
+		}
+		i++
+		switch strings.TrimSuffix(fields[i], "s") {
+		case "day":
+			total += time.Duration(n) * 24 * time.Hour
+		case "hour":
+			total += time.Duration(n) * time.Hour
+		case "min", "minute":
+			total += time.Duration(n) * time.Minute
+		case "sec", "second":
+			total += time.Duration(n) * time.Second
+		default:
+			return 0, fmt.Errorf("%w: interval %q", errSyntax, s)
+		}
+	}
+
+	return total, nil
+}
+
+// parseClock parses [-]HH:MM[:SS[.ffffff]]
+func parseClock(s string) (time.Duration, error) {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, errSyntax
+	}
+	h, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	m, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	d := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute
+	if len(parts) == 3 {
+		sec, err := strconv.ParseFloat(parts[2], 64)
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(sec*float64(time.Second/time.Microsecond)+0.5) * time.Microsecond
+	}
+	if neg {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// ParseDate parses date (such as 2024-01-02) as midnight of UTC
+func ParseDate(s string) (time.Time, error) {
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: date %q", errSyntax, s)
+	}
+
+	return t, nil
+}
+
+// ParseTimestamp parses timestamp without time zone (such as 2024-01-02 03:04:05.123456) as UTC time
+func ParseTimestamp(s string) (time.Time, error) {
+	t, err := time.Parse(TimestampLayout, strings.Replace(s, "T", " ", 1))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: timestamp %q", errSyntax, s)
+	}
+
+	return t, nil
+}
+
+// ParseTimestampTz parses timestamp with time zone (such as 2024-01-02 03:04:05.123456+03)
+func ParseTimestampTz(s string) (time.Time, error) {
+	s = strings.Replace(s, "T", " ", 1)
+	for _, layout := range []string{
+		TimestampTzLayout,
+		"2006-01-02 15:04:05.999999Z07",
+		"2006-01-02 15:04:05.999999Z07:00:00",
+	} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: timestamptz %q", errSyntax, s)
+}
+
+// FormatArray returns one-dimensional array literal (such as {1,NULL,"a b"}) of elements.
+// Nil elements are NULLs
+func FormatArray(items []*string) string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, item := range items {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		if item == nil {
+			sb.WriteString("NULL")
+
+			continue
+		}
+		sb.WriteByte('"')
+		for j := 0; j < len(*item); j++ {
+			if c := (*item)[j]; c == '"' || c == '\\' {
+				sb.WriteByte('\\')
+			}
+			sb.WriteByte((*item)[j])
+		}
+		sb.WriteByte('"')
+	}
+	sb.WriteByte('}')
+
+	return sb.String()
+}
+
+// ParseArray parses one-dimensional array literal. Nil elements are NULLs
+func ParseArray(s string) ([]*string, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("%w: array %q", errSyntax, s)
+	}
+	body := s[1 : len(s)-1]
+	items := make([]*string, 0)
+	if strings.TrimSpace(body) == "" {
+		return items, nil
+	}
+	for i := 0; ; {
+		for i < len(body) && body[i] == ' ' {
+			i++
+		}
+		var (
+			sb     strings.Builder
+			quoted bool
+		)
+		if i < len(body) && body[i] == '"' {
+			quoted = true
+			i++
+			for ; i < len(body) && body[i] != '"'; i++ {
+				if body[i] == '\\' && i+1 < len(body) {
+					i++
+				}
+				sb.WriteByte(body[i])
+			}
+			if i >= len(body) {
+				return nil, fmt.Errorf("%w: array %q", errSyntax, s)
+			}
+			i++
+		} else {
+			for ; i < len(body) && body[i] != ','; i++ {
+				if body[i] == '{' || body[i] == '"' {
+					return nil, fmt.Errorf("%w: array %q (only one-dimensional arrays supported)", errSyntax, s)
+				}
+				sb.WriteByte(body[i])
+			}
+		}
+		item := sb.String()
+		if !quoted {
+			item = strings.TrimSpace(item)
+		}
+		if !quoted && strings.EqualFold(item, "NULL") {
+			items = append(items, nil)
+		} else {
+			items = append(items, &item)
+		}
+		for i < len(body) && body[i] == ' ' {
+			i++
+		}
+		if i >= len(body) {
+			return items, nil
+		}
+		if body[i] != ',' {
+			return nil, fmt.Errorf("%w: array %q", errSyntax, s)
+		}
+		i++
+	}
+}
@@ -0,0 +1,147 @@
+package pg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBool(t *testing.T) {
+	require.Equal(t, "t", FormatBool(true))
+	require.Equal(t, "f", FormatBool(false))
+	for _, s := range []string{"t", "TRUE", "yes", "on", "1"} {
+		v, err := ParseBool(s)
+		require.NoError(t, err)
+		require.True(t, v)
+	}
+	for _, s := range []string{"f", "false", "No", "off", "0"} {
+		v, err := ParseBool(s)
+		require.NoError(t, err)
+		require.False(t, v)
+	}
+	_, err := ParseBool("maybe")
+	require.ErrorIs(t, err, errSyntax)
+}
+
+func TestBytea(t *testing.T) {
+	require.Equal(t, `\x00ff41`, FormatBytea([]byte{0, 0xff, 'A'}))
+	for _, tt := range []struct {
+		s   string
+		exp []byte
+	}{
+		{s: `\x00ff41`, exp: []byte{0, 0xff, 'A'}},
+		{s: `\x`, exp: []byte{}},
+		{s: `ab\\c\001`, exp: []byte{'a', 'b', '\\', 'c', 1}},
+	} {
+		b, err := ParseBytea(tt.s)
+		require.NoError(t, err)
+		require.Equal(t, tt.exp, b)
+	}
+	for _, s := range []string{`\xZZ`, `\x0`, `a\9`} {
+		_, err := ParseBytea(s)
+		require.ErrorIs(t, err, errSyntax, s)
+	}
+}
+
+func TestInterval(t *testing.T) {
+	for _, tt := range []struct {
+		d time.Duration
+		s string
+	}{
+		{d: 0, s: "00:00:00"},
+		{d: 25*time.Hour + time.Minute + 2500*time.Millisecond, s: "25:01:02.5"},
+		{d: -time.Second - time.Microsecond, s: "-00:00:01.000001"},
+	} {
+		require.Equal(t, tt.s, FormatInterval(tt.d))
+		d, err := ParseInterval(tt.s)
+		require.NoError(t, err)
+		require.Equal(t, tt.d, d)
+	}
+	for _, tt := range []struct {
+		s string
+		d time.Duration
+	}{
+		{s: "3 days", d: 72 * time.Hour},
+		{s: "1 day -02:00:00", d: 22 * time.Hour},
+		{s: "1 hour 30 mins", d: 90 * time.Minute},
+	} {
+		d, err := ParseInterval(tt.s)
+		require.NoError(t, err)
+		require.Equal(t, tt.d, d, tt.s)
+	}
+	for _, s := range []string{"", "1 mon", "1 year", "1", "1:2:3:4"} {
+		_, err := ParseInterval(s)
+		require.ErrorIs(t, err, errSyntax, s)
+	}
+}
+
+func TestTime(t *testing.T) {
+	d, err := ParseDate("2024-01-02")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), d)
+
+	ts, err := ParseTimestamp("2024-01-02 03:04:05.123456")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC), ts)
+
+	for _, s := range []string{
+		"2024-01-02 06:04:05.123456+03",
+		"2024-01-02 06:04:05.123456+03:00",
+		"2024-01-02T03:04:05.123456Z",
+	} {
+		tz, err := ParseTimestampTz(s)
+		require.NoError(t, err, s)
+		require.True(t, ts.Equal(tz), s)
+	}
+
+	_, err = ParseDate("2024-13-01")
+	require.ErrorIs(t, err, errSyntax)
+	_, err = ParseTimestamp("yesterday")
+	require.ErrorIs(t, err, errSyntax)
+	_, err = ParseTimestampTz("2024-01-02")
+	require.ErrorIs(t, err, errSyntax)
+}
+
+func TestArray(t *testing.T) {
+	str := func(s string) *string { return &s }
+	items := []*string{str("1"), nil, str(`a "b"\c`), str("")}
+	s := FormatArray(items)
+	require.Equal(t, `{"1",NULL,"a \"b\"\\c",""}`, s)
+	parsed, err := ParseArray(s)
+	require.NoError(t, err)
+	require.Equal(t, items, parsed)
+
+	for _, tt := range []struct {
+		s   string
+		exp []*string
+	}{
+		{s: `{}`, exp: []*string{}},
+		{s: `{1, 2 ,3}`, exp: []*string{str("1"), str("2"), str("3")}},
+		{s: `{a,null,"NULL"}`, exp: []*string{str("a"), nil, str("NULL")}},
+	} {
+		parsed, err := ParseArray(tt.s)
+		require.NoError(t, err)
+		require.Equal(t, tt.exp, parsed, tt.s)
+	}
+	for _, s := range []string{``, `1,2`, `{"a}`, `{{1},{2}}`, `{"a"b}`} {
+		_, err := ParseArray(s)
+		require.ErrorIs(t, err, errSyntax, s)
+	}
+}
+
+func TestTypes(t *testing.T) {
+	require.Equal(t, "int4", TypeName(OIDInt4))
+	require.Equal(t, "_text", TypeName(OIDTextArray))
+	require.Equal(t, "unknown", TypeName(123456))
+	elem, ok := ArrayElem(OIDInt8Array)
+	require.True(t, ok)
+	require.EqualValues(t, OIDInt8, elem)
+	_, ok = ArrayElem(OIDInt8)
+	require.False(t, ok)
+	oid, ok := ArrayOf(OIDUUID)
+	require.True(t, ok)
+	require.EqualValues(t, OIDUUIDArray, oid)
+	_, ok = ArrayOf(OIDUnknown)
+	require.False(t, ok)
+}
@@ -93,7 +93,13 @@ func do(
 
 func (c *Client) Do(ctx context.Context, op query.Operation, opts ...options.DoOption) error {
 	onDone := trace.QueryOnDo(c.config.Trace(), &ctx, stack.FunctionID(""))
-	attempts, err := do(ctx, c.pool, op, c.config.Trace(), opts...)
+	attempts, err := do(ctx, c.pool, op, c.config.Trace(), append([]options.DoOption{
+		options.WithRetryOptions(
+			retry.WithBudget(c.config.RetryBudget()),
+			retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+			retry.WithErrorClassifier(c.config.ErrorClassifier()),
+		),
+	}, opts...)...)
 	onDone(attempts, err)
 
 	return err
@@ -143,7 +149,13 @@ func doTx(
 
 func (c *Client) DoTx(ctx context.Context, op query.TxOperation, opts ...options.DoTxOption) error {
 	onDone := trace.QueryOnDoTx(c.config.Trace(), &ctx, stack.FunctionID(""))
-	attempts, err := doTx(ctx, c.pool, op, c.config.Trace(), opts...)
+	attempts, err := doTx(ctx, c.pool, op, c.config.Trace(), append([]options.DoTxOption{
+		options.WithRetryOptions(
+			retry.WithBudget(c.config.RetryBudget()),
+			retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+			retry.WithErrorClassifier(c.config.ErrorClassifier()),
+		),
+	}, opts...)...)
 	onDone(attempts, err)
 
 	return err
@@ -15,6 +15,7 @@ import (
 	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
@@ -264,3 +265,89 @@ func TestDoTx(t *testing.T) {
 		require.Equal(t, 10, counter)
 	})
 }
+
+func TestDoWithResult(t *testing.T) {
+	ctx := xtest.Context(t)
+	client := &Client{
+		config: config.New(),
+		pool: mustTestPool(ctx, func(ctx context.Context) (*Session, error) {
+			return newTestSession()
+		}),
+	}
+	t.Run("HappyWay", func(t *testing.T) {
+		result, err := query.DoWithResult(ctx, client, func(ctx context.Context, s query.Session) (int, error) {
+			return 42, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 42, result)
+	})
+	t.Run("RetryableError", func(t *testing.T) {
+		counter := 0
+		result, err := query.DoWithResult(ctx, client, func(ctx context.Context, s query.Session) (int, error) {
+			counter++
+			if counter < 3 {
+				return counter, xerrors.Retryable(errors.New(""))
+			}
+
+			return counter * 10, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 30, result)
+	})
+	t.Run("Error", func(t *testing.T) {
+		errNonRetryable := errors.New("non-retryable")
+		result, err := query.DoWithResult(ctx, client, func(ctx context.Context, s query.Session) (int, error) {
+			return 42, errNonRetryable
+		})
+		require.ErrorIs(t, err, errNonRetryable)
+		require.Zero(t, result)
+	})
+}
+
+func TestDoTxWithResult(t *testing.T) {
+	ctx := xtest.Context(t)
+	newClient := func(t *testing.T, commitStatus Ydb.StatusIds_StatusCode) *Client {
+		ctrl := gomock.NewController(t)
+		grpcClient := NewMockQueryServiceClient(ctrl)
+		grpcClient.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.BeginTransactionResponse{
+			Status: Ydb.StatusIds_SUCCESS,
+		}, nil).AnyTimes()
+		grpcClient.EXPECT().RollbackTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.RollbackTransactionResponse{
+			Status: Ydb.StatusIds_SUCCESS,
+		}, nil).AnyTimes()
+		grpcClient.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.CommitTransactionResponse{
+			Status: commitStatus,
+		}, nil).AnyTimes()
+
+		return &Client{
+			config: config.New(),
+			pool: mustTestPool(ctx, func(ctx context.Context) (*Session, error) {
+				return newTestSessionWithClient(grpcClient)
+			}),
+		}
+	}
+	t.Run("RetryableError", func(t *testing.T) {
+		counter := 0
+		result, err := query.DoTxWithResult(ctx, newClient(t, Ydb.StatusIds_SUCCESS),
+			func(ctx context.Context, tx query.TxActor) (int, error) {
+				counter++
+				if counter < 3 {
+					return counter, xerrors.Retryable(errors.New(""))
+				}
+
+				return counter * 10, nil
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, 30, result)
+	})
+	t.Run("CommitError", func(t *testing.T) {
+		result, err := query.DoTxWithResult(ctx, newClient(t, Ydb.StatusIds_BAD_REQUEST),
+			func(ctx context.Context, tx query.TxActor) (int, error) {
+				return 42, nil
+			},
+		)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST))
+		require.Zero(t, result)
+	})
+}
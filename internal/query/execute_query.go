@@ -27,18 +27,24 @@ type executeConfig interface {
 func executeQueryRequest(a *allocator.Allocator, sessionID, q string, cfg executeConfig) (
 	*Ydb_Query.ExecuteQueryRequest,
 	[]grpc.CallOption,
+	error,
 ) {
+	params, err := cfg.Params().ToYDB(a)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
 	request := a.QueryExecuteQueryRequest()
 
 	request.SessionId = sessionID
 	request.ExecMode = Ydb_Query.ExecMode(cfg.ExecMode())
 	request.TxControl = cfg.TxControl().ToYDB(a)
 	request.Query = queryFromText(a, q, Ydb_Query.Syntax(cfg.Syntax()))
-	request.Parameters = cfg.Params().ToYDB(a)
+	request.Parameters = params
 	request.StatsMode = Ydb_Query.StatsMode(cfg.StatsMode())
 	request.ConcurrentResultSets = false
 
-	return request, cfg.CallOptions()
+	return request, cfg.CallOptions(), nil
 }
 
 func queryFromText(
@@ -58,7 +64,10 @@ func execute(ctx context.Context, s *Session, c Ydb_Query_V1.QueryServiceClient,
 	a := allocator.New()
 	defer a.Free()
 
-	request, callOptions := executeQueryRequest(a, s.id, q, cfg)
+	request, callOptions, err := executeQueryRequest(a, s.id, q, cfg)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
 
 	stream, err := c.ExecuteQuery(xcontext.WithoutDeadline(ctx), request, callOptions...)
 	if err != nil {
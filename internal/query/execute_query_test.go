@@ -626,6 +626,15 @@ func TestExecute(t *testing.T) {
 			require.True(t, xerrors.IsTransportError(r.Err(), grpcCodes.Unavailable))
 		})
 	})
+	t.Run("InvalidParameters", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		_, _, err := execute(ctx, &Session{id: "123"}, service, "", options.ExecuteSettings(
+			options.WithParameters(params.Builder{}.Param("$x").DecimalFromString("1.2.3", 22, 9).Build()),
+		))
+		require.Error(t, err)
+	})
 	t.Run("OperationError", func(t *testing.T) {
 		t.Run("OnCall", func(t *testing.T) {
 			ctx := xtest.Context(t)
@@ -1066,7 +1075,8 @@ func TestExecuteQueryRequest(t *testing.T) {
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
-			request, callOptions := executeQueryRequest(a, tt.name, tt.name, options.ExecuteSettings(tt.opts...))
+			request, callOptions, err := executeQueryRequest(a, tt.name, tt.name, options.ExecuteSettings(tt.opts...))
+			require.NoError(t, err)
 			require.Equal(t, request.String(), tt.request.String())
 			require.Equal(t, tt.callOptions, callOptions)
 		})
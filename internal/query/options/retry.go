@@ -10,10 +10,12 @@ var (
 	_ DoOption = idempotentOption{}
 	_ DoOption = labelOption("")
 	_ DoOption = traceOption{}
+	_ DoOption = retryOptionsOption{}
 
 	_ DoTxOption = idempotentOption{}
 	_ DoTxOption = labelOption("")
 	_ DoTxOption = traceOption{}
+	_ DoTxOption = retryOptionsOption{}
 	_ DoTxOption = doTxSettingsOption{}
 )
 
@@ -41,6 +43,7 @@ type (
 	traceOption      struct {
 		t *trace.Query
 	}
+	retryOptionsOption []retry.Option
 	doTxSettingsOption struct {
 		txSettings tx.Settings
 	}
@@ -86,6 +89,14 @@ func (opt labelOption) applyDoTxOption(s *doTxSettings) {
 	s.doOpts = append(s.doOpts, opt)
 }
 
+func (opts retryOptionsOption) applyDoOption(s *doSettings) {
+	s.retryOpts = append(s.retryOpts, opts...)
+}
+
+func (opts retryOptionsOption) applyDoTxOption(s *doTxSettings) {
+	s.doOpts = append(s.doOpts, opts)
+}
+
 func (opt doTxSettingsOption) applyDoTxOption(opts *doTxSettings) {
 	opts.txSettings = opt.txSettings
 }
@@ -102,6 +113,10 @@ func WithLabel(lbl string) labelOption {
 	return labelOption(lbl)
 }
 
+func WithRetryOptions(opts ...retry.Option) retryOptionsOption {
+	return opts
+}
+
 func WithTrace(t *trace.Query) traceOption {
 	return traceOption{t: t}
 }
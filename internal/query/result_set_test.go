@@ -1,6 +1,7 @@
 package query
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 )
 
 func TestResultSetNext(t *testing.T) {
@@ -600,3 +602,81 @@ func TestResultSetNext(t *testing.T) {
 		}
 	})
 }
+
+func TestResultSetWriteJSONLines(t *testing.T) {
+	ctx := xtest.Context(t)
+	parts := []*Ydb_Query.ExecuteQueryResponsePart{
+		{
+			Status:         Ydb.StatusIds_SUCCESS,
+			ResultSetIndex: 0,
+			ResultSet: &Ydb.ResultSet{
+				Columns: []*Ydb.Column{
+					{
+						Name: "id",
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_TypeId{
+								TypeId: Ydb.Type_UINT64,
+							},
+						},
+					},
+					{
+						Name: "title",
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_OptionalType{
+								OptionalType: &Ydb.OptionalType{
+									Item: &Ydb.Type{
+										Type: &Ydb.Type_TypeId{
+											TypeId: Ydb.Type_UTF8,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Rows: []*Ydb.Value{
+					{
+						Items: []*Ydb.Value{{
+							Value: &Ydb.Value_Uint64Value{
+								Uint64Value: 1,
+							},
+						}, {
+							Value: &Ydb.Value_TextValue{
+								TextValue: "test",
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			Status:         Ydb.StatusIds_SUCCESS,
+			ResultSetIndex: 0,
+			ResultSet: &Ydb.ResultSet{
+				Rows: []*Ydb.Value{
+					{
+						Items: []*Ydb.Value{{
+							Value: &Ydb.Value_Uint64Value{
+								Uint64Value: 2,
+							},
+						}, {
+							Value: &Ydb.Value_NullFlagValue{},
+						}},
+					},
+				},
+			},
+		},
+	}
+	next := 1
+	rs := newResultSet(func() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+		if next == len(parts) {
+			return nil, xerrors.WithStackTrace(io.EOF)
+		}
+		next++
+
+		return parts[next-1], nil
+	}, parts[0], nil)
+	var buf bytes.Buffer
+	require.NoError(t, query.WriteJSONLines(ctx, &buf, rs))
+	require.Equal(t, "{\"id\":\"1\",\"title\":\"test\"}\n{\"id\":\"2\",\"title\":null}\n", buf.String())
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
@@ -20,6 +21,7 @@ type row struct {
 	indexedScanner scanner.IndexedScanner
 	namedScanner   scanner.NamedScanner
 	structScanner  scanner.StructScanner
+	jsonMarshaler  json.Marshaler
 }
 
 func newRow(ctx context.Context, columns []*Ydb.Column, v *Ydb.Value, t *trace.Query) (*row, error) {
@@ -31,6 +33,7 @@ func newRow(ctx context.Context, columns []*Ydb.Column, v *Ydb.Value, t *trace.Q
 		indexedScanner: scanner.Indexed(data),
 		namedScanner:   scanner.Named(data),
 		structScanner:  scanner.Struct(data),
+		jsonMarshaler:  data,
 	}, nil
 }
 
@@ -60,3 +63,8 @@ func (r row) ScanStruct(dst interface{}, opts ...scanner.ScanStructOption) (err
 
 	return r.structScanner.ScanStruct(dst, opts...)
 }
+
+// MarshalJSON returns JSON object with columns of row (such as {"id":"1","title":"test"})
+func (r row) MarshalJSON() ([]byte, error) {
+	return r.jsonMarshaler.MarshalJSON()
+}
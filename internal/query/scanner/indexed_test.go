@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 )
 
@@ -47,6 +48,39 @@ func TestIndexed(t *testing.T) {
 				{func(v []byte) *[]byte { return &v }([]byte("test"))},
 			},
 		},
+		{
+			name: "Ydb.Type_PgType",
+			s: Indexed(Data(
+				[]*Ydb.Column{
+					{
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_PgType{
+								PgType: &Ydb.PgType{
+									Oid: pg.OIDInt8,
+								},
+							},
+						},
+					},
+				},
+				[]*Ydb.Value{
+					{
+						Value: &Ydb.Value_TextValue{
+							TextValue: "123",
+						},
+					},
+				},
+			)),
+			dst: [][]interface{}{
+				{func(v int64) *int64 { return &v }(0)},
+				{func(v string) *string { return &v }("")},
+				{func(v *int32) **int32 { return &v }(nil)},
+			},
+			exp: [][]interface{}{
+				{func(v int64) *int64 { return &v }(123)},
+				{func(v string) *string { return &v }("123")},
+				{func(v int32) **int32 { vv := &v; return &vv }(123)},
+			},
+		},
 		{
 			name: "Ydb.Type_STRING",
 			s: Indexed(Data(
@@ -0,0 +1,37 @@
+package scanner
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var _ json.Marshaler = data{}
+
+// MarshalJSON returns JSON object with columns of row in order of columns in result set
+// (see value.MarshalJSON for JSON representation of values)
+func (s data) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i := range s.columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(s.columns[i].GetName())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		v, err := value.MarshalJSON(s.seekByIndex(i))
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
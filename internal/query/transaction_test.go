@@ -230,7 +230,11 @@ func TestTxExecuteSettings(t *testing.T) {
 			require.Equal(t, tt.settings.ExecMode(), settings.ExecMode())
 			require.Equal(t, tt.settings.StatsMode(), settings.StatsMode())
 			require.Equal(t, tt.settings.TxControl().ToYDB(a).String(), settings.TxControl().ToYDB(a).String())
-			require.Equal(t, tt.settings.Params().ToYDB(a), settings.Params().ToYDB(a))
+			expParams, err := tt.settings.Params().ToYDB(a)
+			require.NoError(t, err)
+			params, err := settings.Params().ToYDB(a)
+			require.NoError(t, err)
+			require.Equal(t, expParams, params)
 			require.Equal(t, tt.settings.CallOptions(), settings.CallOptions())
 		})
 	}
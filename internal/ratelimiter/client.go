@@ -63,6 +63,9 @@ func (c *Client) CreateResource(
 		retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 }
 
@@ -112,6 +115,9 @@ func (c *Client) AlterResource(
 		retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 }
 
@@ -161,6 +167,9 @@ func (c *Client) DropResource(
 		retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 }
 
@@ -206,6 +215,9 @@ func (c *Client) ListResource(
 		retry.WithIdempotent(true),
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 
 	return list, err
@@ -265,6 +277,9 @@ func (c *Client) DescribeResource(
 		retry.WithIdempotent(true),
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 
 	return
@@ -333,6 +348,9 @@ func (c *Client) AcquireResource(
 	return retry.Retry(ctx, call,
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 }
 
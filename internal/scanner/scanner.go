@@ -29,6 +29,10 @@ type RawValue interface {
 	Datetime() (v time.Time)
 	Timestamp() (v time.Time)
 	Interval() (v time.Duration)
+	Date32() (v time.Time)
+	Datetime64() (v time.Time)
+	Timestamp64() (v time.Time)
+	Interval64() (v time.Duration)
 	TzDate() (v time.Time)
 	TzDatetime() (v time.Time)
 	TzTimestamp() (v time.Time)
@@ -8,6 +8,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
 	"google.golang.org/grpc"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -64,6 +65,9 @@ func (c *Client) MakeDirectory(ctx context.Context, path string) (finalErr error
 		retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 }
 
@@ -103,6 +107,9 @@ func (c *Client) RemoveDirectory(ctx context.Context, path string) (finalErr err
 		retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 }
 
@@ -142,6 +149,9 @@ func (c *Client) ListDirectory(ctx context.Context, path string) (d scheme.Direc
 		retry.WithIdempotent(true),
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 
 	return d, xerrors.WithStackTrace(err)
@@ -155,7 +165,7 @@ func (c *Client) listDirectory(ctx context.Context, path string) (scheme.Directo
 		result   Ydb_Scheme.ListDirectoryResult
 	)
 	response, err = c.service.ListDirectory(
-		ctx,
+		balancer.WithHedging(ctx),
 		&Ydb_Scheme.ListDirectoryRequest{
 			Path: path,
 			OperationParams: operation.Params(
@@ -205,6 +215,9 @@ func (c *Client) DescribePath(ctx context.Context, path string) (e scheme.Entry,
 		retry.WithIdempotent(true),
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 
 	return e, xerrors.WithStackTrace(err)
@@ -216,7 +229,7 @@ func (c *Client) describePath(ctx context.Context, path string) (e scheme.Entry,
 		result   Ydb_Scheme.DescribePathResult
 	)
 	response, err = c.service.DescribePath(
-		ctx,
+		balancer.WithHedging(ctx),
 		&Ydb_Scheme.DescribePathRequest{
 			Path: path,
 			OperationParams: operation.Params(
@@ -266,6 +279,9 @@ func (c *Client) ModifyPermissions(
 		retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 }
 
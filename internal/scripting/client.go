@@ -60,6 +60,9 @@ func (c *Client) Execute(
 	err = retry.Retry(ctx, call,
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 
 	return r, xerrors.WithStackTrace(err)
@@ -77,8 +80,7 @@ func (c *Client) execute(
 		)
 		a       = allocator.New()
 		request = &Ydb_Scripting.ExecuteYqlRequest{
-			Script:     query,
-			Parameters: parameters.ToYDB(a),
+			Script: query,
 			OperationParams: operation.Params(
 				ctx,
 				c.config.OperationTimeout(),
@@ -93,6 +95,12 @@ func (c *Client) execute(
 		a.Free()
 		onDone(r, err)
 	}()
+
+	request.Parameters, err = parameters.ToYDB(a)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
 	response, err = c.service.ExecuteYql(ctx, request)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -139,6 +147,9 @@ func (c *Client) Explain(
 		retry.WithStackTrace(),
 		retry.WithIdempotent(true),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 
 	return e, xerrors.WithStackTrace(err)
@@ -213,6 +224,9 @@ func (c *Client) StreamExecute(
 	err = retry.Retry(ctx, call,
 		retry.WithStackTrace(),
 		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+		retry.WithErrorClassifier(c.config.ErrorClassifier()),
 	)
 
 	return r, xerrors.WithStackTrace(err)
@@ -230,8 +244,7 @@ func (c *Client) streamExecute(
 		)
 		a       = allocator.New()
 		request = &Ydb_Scripting.ExecuteYqlRequest{
-			Script:     query,
-			Parameters: parameters.ToYDB(a),
+			Script: query,
 			OperationParams: operation.Params(
 				ctx,
 				c.config.OperationTimeout(),
@@ -247,6 +260,11 @@ func (c *Client) streamExecute(
 		}
 	}()
 
+	request.Parameters, err = parameters.ToYDB(a)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
 	ctx, cancel := xcontext.WithCancel(ctx)
 
 	stream, err := c.service.StreamExecuteYql(ctx, request)
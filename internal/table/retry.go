@@ -99,6 +99,9 @@ func (c *Client) retryOptions(opts ...table.Option) *table.Options {
 		),
 		RetryOptions: []retry.Option{
 			retry.WithTrace(c.config.TraceRetry()),
+			retry.WithBudget(c.config.RetryBudget()),
+			retry.WithCircuitBreaker(c.config.CircuitBreaker()),
+			retry.WithErrorClassifier(c.config.ErrorClassifier()),
 		},
 	}
 	for _, opt := range opts {
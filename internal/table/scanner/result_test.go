@@ -13,6 +13,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
@@ -79,6 +80,53 @@ func TestResultAny(t *testing.T) {
 	}
 }
 
+func TestResultPg(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	res := NewUnary(
+		[]*Ydb.ResultSet{
+			NewResultSet(a,
+				WithColumns(
+					options.Column{Name: "id", Type: types.PgType{OID: pg.OIDInt4}},
+					options.Column{Name: "parent", Type: types.PgType{OID: pg.OIDInt8}},
+					options.Column{Name: "tags", Type: types.PgType{OID: pg.OIDTextArray}},
+					options.Column{Name: "created", Type: types.PgType{OID: pg.OIDTimestamp}},
+				),
+				WithValues(
+					value.PgValue(pg.OIDInt4, "1"),
+					value.PgNullValue(pg.OIDInt8),
+					value.PgValue(pg.OIDTextArray, `{a,"b c"}`),
+					value.PgValue(pg.OIDTimestamp, "2024-01-02 03:04:05"),
+					value.PgValue(pg.OIDInt4, "2"),
+					value.PgValue(pg.OIDInt8, "1"),
+					value.PgNullValue(pg.OIDTextArray),
+					value.PgNullValue(pg.OIDTimestamp),
+				),
+			),
+		},
+		nil,
+	)
+	type row struct {
+		id      int32
+		parent  *int64
+		tags    []string
+		created interface{}
+	}
+	var rows []row
+	require.True(t, res.NextResultSet(context.Background()))
+	for res.NextRow() {
+		var r row
+		require.NoError(t, res.Scan(&r.id, &r.parent, &r.tags, &r.created))
+		rows = append(rows, r)
+	}
+	require.NoError(t, res.Err())
+	parent := int64(1)
+	require.Equal(t, []row{
+		{id: 1, tags: []string{"a", "b c"}, created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{id: 2, parent: &parent},
+	}, rows)
+}
+
 func TestResultOUint32(t *testing.T) {
 	for _, test := range []struct {
 		name    string
@@ -195,6 +195,34 @@ func (s *rawConverter) Interval() (v time.Duration) {
 	return value.IntervalToDuration(s.int64())
 }
 
+func (s *rawConverter) Date32() (v time.Time) {
+	s.unwrap()
+
+	return value.Date32ToTime(s.int32())
+}
+
+func (s *rawConverter) Datetime64() (v time.Time) {
+	s.unwrap()
+
+	return value.Datetime64ToTime(s.int64())
+}
+
+func (s *rawConverter) Timestamp64() (v time.Time) {
+	s.unwrap()
+
+	return value.Timestamp64ToTime(s.int64())
+}
+
+func (s *rawConverter) Interval64() (v time.Duration) {
+	s.unwrap()
+	src, err := value.Interval64ToDuration(s.int64())
+	if err != nil {
+		_ = s.errorf(0, "rawConverter.Interval64(): %w", err)
+	}
+
+	return src
+}
+
 func (s *rawConverter) TzDate() (v time.Time) {
 	s.unwrap()
 	if s.isNull() {
@@ -385,6 +385,15 @@ func (s *valueScanner) any() interface{} {
 		x = s.stack.current()
 	}
 
+	if s.isCurrentTypePg() {
+		src, err := value.PgDriverValue(s.value())
+		if err != nil {
+			_ = s.errorf(0, "valueScanner.any(): %w", err)
+		}
+
+		return src
+	}
+
 	t := internalTypes.TypeFromYDB(x.t)
 	p, primitive := t.(internalTypes.Primitive)
 	if !primitive {
@@ -426,6 +435,19 @@ func (s *valueScanner) any() interface{} {
 		return s.int64()
 	case internalTypes.Interval:
 		return value.IntervalToDuration(s.int64())
+	case internalTypes.Date32:
+		return value.Date32ToTime(s.int32())
+	case internalTypes.Datetime64:
+		return value.Datetime64ToTime(s.int64())
+	case internalTypes.Timestamp64:
+		return value.Timestamp64ToTime(s.int64())
+	case internalTypes.Interval64:
+		src, err := value.Interval64ToDuration(s.int64())
+		if err != nil {
+			_ = s.errorf(0, "valueScanner.any(): %w", err)
+		}
+
+		return src
 	case internalTypes.TzDate:
 		src, err := value.TzDateToTime(s.text())
 		if err != nil {
@@ -474,6 +496,26 @@ func (s *valueScanner) isCurrentTypeOptional() bool {
 	return isOptional(c.t)
 }
 
+func (s *valueScanner) isCurrentTypePg() bool {
+	_, isPg := s.stack.currentType().(*Ydb.Type_PgType)
+
+	return isPg
+}
+
+// scanPg casts value of postgres type into destination. Scanners and generic destinations
+// (interface{}, value.Value) are not handled by scanPg
+func (s *valueScanner) scanPg(v interface{}) (ok bool) {
+	switch v.(type) {
+	case scanner.Scanner, sql.Scanner, *interface{}, *value.Value:
+		return false
+	}
+	if err := value.CastTo(s.value(), v); err != nil {
+		_ = s.errorf(0, "scan postgres value failed: %w", err)
+	}
+
+	return true
+}
+
 func (s *valueScanner) isNull() bool {
 	_, yes := s.stack.currentValue().(*Ydb.Value_NullFlagValue)
 
@@ -717,6 +759,12 @@ func (s *valueScanner) setTime(dst *time.Time) {
 		*dst = value.DatetimeToTime(s.uint32())
 	case Ydb.Type_TIMESTAMP:
 		*dst = value.TimestampToTime(s.uint64())
+	case internalTypes.TypeIDDate32:
+		*dst = value.Date32ToTime(s.int32())
+	case internalTypes.TypeIDDatetime64:
+		*dst = value.Datetime64ToTime(s.int64())
+	case internalTypes.TypeIDTimestamp64:
+		*dst = value.Timestamp64ToTime(s.int64())
 	case Ydb.Type_TZ_DATE:
 		src, err := value.TzDateToTime(s.text())
 		if err != nil {
@@ -740,6 +788,21 @@ func (s *valueScanner) setTime(dst *time.Time) {
 	}
 }
 
+func (s *valueScanner) setDuration(dst *time.Duration) {
+	switch t := s.stack.current().t.GetTypeId(); t {
+	case Ydb.Type_INTERVAL:
+		*dst = value.IntervalToDuration(s.int64())
+	case internalTypes.TypeIDInterval64:
+		src, err := value.Interval64ToDuration(s.int64())
+		if err != nil {
+			_ = s.errorf(0, "valueScanner.setDuration(): %w", err)
+		}
+		*dst = src
+	default:
+		_ = s.errorf(0, "valueScanner.setDuration(): incorrect source types %s", t)
+	}
+}
+
 func (s *valueScanner) setString(dst *string) {
 	switch t := s.stack.current().t.GetTypeId(); t {
 	case Ydb.Type_UUID:
@@ -811,6 +874,9 @@ func (s *valueScanner) trySetByteArray(v interface{}, optional, def bool) bool {
 
 //nolint:gocyclo
 func (s *valueScanner) scanRequired(v interface{}) {
+	if s.isCurrentTypePg() && s.scanPg(v) {
+		return
+	}
 	switch v := v.(type) {
 	case *bool:
 		*v = s.bool()
@@ -841,7 +907,7 @@ func (s *valueScanner) scanRequired(v interface{}) {
 	case *time.Time:
 		s.setTime(v)
 	case *time.Duration:
-		*v = value.IntervalToDuration(s.int64())
+		s.setDuration(v)
 	case *string:
 		s.setString(v)
 	case *[]byte:
@@ -1002,7 +1068,9 @@ func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool)
 		if s.isNull() {
 			*v = nil
 		} else {
-			src := value.IntervalToDuration(s.int64())
+			s.unwrap()
+			var src time.Duration
+			s.setDuration(&src)
 			*v = &src
 		}
 	case **string:
@@ -54,6 +54,10 @@ type session struct {
 	statusMtx    sync.RWMutex
 	closeOnce    sync.Once
 	nodeID       atomic.Uint32
+
+	// readService is a table service client for calls which don't depend on state of session (ReadRows).
+	// Calls over readService are not bound to node of session, so they can be hedged to other node
+	readService Ydb_Table_V1.TableServiceClient
 }
 
 func (s *session) LastUsage() time.Time {
@@ -160,6 +164,11 @@ func newSession(ctx context.Context, cc grpc.ClientConnInterface, config *config
 			},
 		),
 	)
+	s.readService = Ydb_Table_V1.NewTableServiceClient(
+		conn.WithBeforeFunc(cc, func() {
+			s.lastUsage.Store(time.Now().Unix())
+		}),
+	)
 
 	return s, nil
 }
@@ -725,9 +734,13 @@ func (s *session) Execute(
 	)
 	defer a.Free()
 
+	request.Parameters, err = parameters.ToYDB(a)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
 	request.SessionId = s.id
 	request.TxControl = txControl.Desc()
-	request.Parameters = parameters.ToYDB(a)
 	request.Query = q.toYDB(a)
 	request.QueryCachePolicy = a.TableQueryCachePolicy()
 	request.QueryCachePolicy.KeepInCache = len(request.Parameters) > 0
@@ -1075,7 +1088,7 @@ func (s *session) ReadRows(
 		}
 	}
 
-	response, err = s.tableService.ReadRows(ctx, &request)
+	response, err = s.readService.ReadRows(balancerContext.WithHedging(ctx), &request)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -1113,9 +1126,8 @@ func (s *session) StreamExecuteScanQuery(
 			s, q, parameters,
 		)
 		request = Ydb_Table.ExecuteScanQueryRequest{
-			Query:      q.toYDB(a),
-			Parameters: parameters.ToYDB(a),
-			Mode:       Ydb_Table.ExecuteScanQueryRequest_MODE_EXEC, // set default
+			Query: q.toYDB(a),
+			Mode:  Ydb_Table.ExecuteScanQueryRequest_MODE_EXEC, // set default
 		}
 		stream      Ydb_Table_V1.TableService_StreamExecuteScanQueryClient
 		callOptions []grpc.CallOption
@@ -1127,6 +1139,11 @@ func (s *session) StreamExecuteScanQuery(
 		}
 	}()
 
+	request.Parameters, err = parameters.ToYDB(a)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
 	for _, opt := range opts {
 		if opt != nil {
 			callOptions = append(callOptions, opt.ApplyExecuteScanQueryOption((*options.ExecuteScanQueryDesc)(&request))...)
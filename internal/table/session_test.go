@@ -17,9 +17,11 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	balancerContext "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
@@ -763,3 +765,56 @@ func Test_copyTables(t *testing.T) {
 		})
 	}
 }
+
+// endpointRecorder records whether calls are bound to node of session
+type endpointRecorder struct {
+	grpc.ClientConnInterface
+
+	bound map[string]bool
+}
+
+func (r *endpointRecorder) Invoke(
+	ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption,
+) error {
+	_, r.bound[method] = balancerContext.ContextEndpoint(ctx)
+	switch reply := reply.(type) {
+	case *Ydb_Table.CreateSessionResponse:
+		result, err := anypb.New(&Ydb_Table.CreateSessionResult{SessionId: "ydb://session/1?node_id=1"})
+		if err != nil {
+			return err
+		}
+		reply.Operation = &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+			Result: result,
+		}
+	case *Ydb_Table.DeleteSessionResponse:
+		reply.Operation = &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+		}
+	case *Ydb_Table.ReadRowsResponse:
+		reply.Status = Ydb.StatusIds_SUCCESS
+	default:
+		return fmt.Errorf("method '%s' not implemented", method)
+	}
+
+	return nil
+}
+
+func TestSessionReadRowsNotBoundToNode(t *testing.T) {
+	ctx := xtest.Context(t)
+	r := &endpointRecorder{bound: make(map[string]bool)}
+	s, err := newSession(ctx, r, config.New())
+	require.NoError(t, err)
+
+	_, err = s.ReadRows(ctx, "table", value.ListValue(value.StructValue(
+		value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+	)))
+	require.NoError(t, err)
+	require.NoError(t, s.Close(ctx))
+
+	// ReadRows doesn't depend on session state, so it can be sent (and hedged) to any node
+	require.False(t, r.bound[Ydb_Table_V1.TableService_ReadRows_FullMethodName])
+	require.True(t, r.bound[Ydb_Table_V1.TableService_DeleteSession_FullMethodName])
+}
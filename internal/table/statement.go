@@ -42,9 +42,13 @@ func (s *statement) Execute(
 	)
 	defer a.Free()
 
+	request.Parameters, err = parameters.ToYDB(a)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
 	request.SessionId = s.session.id
 	request.TxControl = txControl.Desc()
-	request.Parameters = parameters.ToYDB(a)
 	request.Query = s.query.toYDB(a)
 	request.QueryCachePolicy = a.TableQueryCachePolicy()
 	request.QueryCachePolicy.KeepInCache = len(request.Parameters) > 0
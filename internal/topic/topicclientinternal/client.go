@@ -87,6 +87,9 @@ func (c *Client) Alter(ctx context.Context, path string, opts ...topicoptions.Al
 		return retry.Retry(ctx, call,
 			retry.WithIdempotent(true),
 			retry.WithTrace(c.cfg.TraceRetry()),
+			retry.WithBudget(c.cfg.RetryBudget()),
+			retry.WithCircuitBreaker(c.cfg.CircuitBreaker()),
+			retry.WithErrorClassifier(c.cfg.ErrorClassifier()),
 		)
 	}
 
@@ -119,6 +122,9 @@ func (c *Client) Create(
 		return retry.Retry(ctx, call,
 			retry.WithIdempotent(true),
 			retry.WithTrace(c.cfg.TraceRetry()),
+			retry.WithBudget(c.cfg.RetryBudget()),
+			retry.WithCircuitBreaker(c.cfg.CircuitBreaker()),
+			retry.WithErrorClassifier(c.cfg.ErrorClassifier()),
 		)
 	}
 
@@ -156,6 +162,9 @@ func (c *Client) Describe(
 		err = retry.Retry(ctx, call,
 			retry.WithIdempotent(true),
 			retry.WithTrace(c.cfg.TraceRetry()),
+			retry.WithBudget(c.cfg.RetryBudget()),
+			retry.WithCircuitBreaker(c.cfg.CircuitBreaker()),
+			retry.WithErrorClassifier(c.cfg.ErrorClassifier()),
 		)
 	} else {
 		err = call(ctx)
@@ -192,6 +201,9 @@ func (c *Client) Drop(ctx context.Context, path string, opts ...topicoptions.Dro
 		return retry.Retry(ctx, call,
 			retry.WithIdempotent(true),
 			retry.WithTrace(c.cfg.TraceRetry()),
+			retry.WithBudget(c.cfg.RetryBudget()),
+			retry.WithCircuitBreaker(c.cfg.CircuitBreaker()),
+			retry.WithErrorClassifier(c.cfg.ErrorClassifier()),
 		)
 	}
 
@@ -0,0 +1,432 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errParseType = errors.New("parse type failed")
+
+var primitiveByName = func() map[string]Primitive {
+	m := make(map[string]Primitive, len(primitiveString)+2)
+	for p, name := range primitiveString {
+		if Primitive(p) != Unknown {
+			m[strings.ToLower(name)] = Primitive(p)
+		}
+	}
+	// aliases of YQL
+	m["bytes"] = Bytes
+	m["text"] = Text
+
+	return m
+}()
+
+// pgTypeByName returns postgres type by name of type (such as pgint4 or _pgtext for arrays)
+func pgTypeByName(name string) (PgType, bool) {
+	var pgName string
+	switch {
+	case strings.HasPrefix(name, "_pg"):
+		pgName = "_" + name[len("_pg"):]
+	case strings.HasPrefix(name, "pg"):
+		pgName = name[len("pg"):]
+	default:
+		return PgType{}, false
+	}
+	oid, has := pg.TypeOID(pgName)
+	if !has {
+		return PgType{}, false
+	}
+
+	return PgType{OID: oid}, true
+}
+
+// Parse parses string representation of type (such as result of Type.Yql()).
+// Names of types are case-insensitive. Names of struct members and tags may be quoted.
+// Suffix '?' means Optional<...> (Int32? is the same as Optional<Int32>)
+func Parse(s string) (Type, error) {
+	p := typeParser{
+		s: s,
+	}
+	t, err := p.parseType()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	p.skipSpaces()
+	if p.pos < len(p.s) {
+		return nil, xerrors.WithStackTrace(p.errorf("unexpected '%c'", p.s[p.pos]))
+	}
+
+	return t, nil
+}
+
+type typeParser struct {
+	s   string
+	pos int
+}
+
+func (p *typeParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %q: %s at position %d", errParseType, p.s, fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *typeParser) skipSpaces() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// peek returns next non-space byte or zero byte on end of string
+func (p *typeParser) peek() byte {
+	p.skipSpaces()
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+
+	return 0
+}
+
+func (p *typeParser) expect(c byte) error {
+	if p.peek() != c {
+		if p.pos < len(p.s) {
+			return p.errorf("expected '%c' instead of '%c'", c, p.s[p.pos])
+		}
+
+		return p.errorf("expected '%c' instead of end of string", c)
+	}
+	p.pos++
+
+	return nil
+}
+
+func isIdentByte(c byte, first bool) bool {
+	switch {
+	case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		return true
+	case c >= '0' && c <= '9':
+		return !first
+	default:
+		return false
+	}
+}
+
+func (p *typeParser) ident() (string, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && isIdentByte(p.s[p.pos], p.pos == start) {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", p.errorf("expected name")
+	}
+
+	return p.s[start:p.pos], nil
+}
+
+// singleQuoteUnescaper unescapes \' and \\ of name quoted with ' in one pass
+var singleQuoteUnescaper = strings.NewReplacer(`\'`, `'`, `\\`, `\`)
+
+// name returns identifier or quoted with ', " or ` string
+func (p *typeParser) name() (string, error) {
+	switch quote := p.peek(); quote {
+	case '\'', '"':
+		start := p.pos
+		p.pos++
+		for p.pos < len(p.s) && p.s[p.pos] != quote {
+			if p.s[p.pos] == '\\' {
+				p.pos++
+			}
+			p.pos++
+		}
+		if p.pos >= len(p.s) {
+			return "", p.errorf("unterminated quoted name")
+		}
+		p.pos++
+		if quote == '\'' {
+			return singleQuoteUnescaper.Replace(p.s[start+1 : p.pos-1]), nil
+		}
+		name, err := strconv.Unquote(p.s[start:p.pos])
+		if err != nil {
+			return "", p.errorf("%v", err)
+		}
+
+		return name, nil
+	case '`':
+		p.pos++
+		end := strings.IndexByte(p.s[p.pos:], '`')
+		if end < 0 {
+			return "", p.errorf("unterminated quoted name")
+		}
+		name := p.s[p.pos : p.pos+end]
+		p.pos += end + 1
+
+		return name, nil
+	default:
+		return p.ident()
+	}
+}
+
+func (p *typeParser) uint32() (uint32, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	v, err := strconv.ParseUint(p.s[start:p.pos], 10, 32)
+	if err != nil {
+		p.pos = start
+
+		return 0, p.errorf("expected number")
+	}
+
+	return uint32(v), nil
+}
+
+func (p *typeParser) parseType() (t Type, err error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(name) {
+	case "optional":
+		t, err = p.parseOneArg(func(t Type) Type { return NewOptional(t) })
+	case "list":
+		t, err = p.parseOneArg(func(t Type) Type { return NewList(t) })
+	case "set":
+		t, err = p.parseOneArg(func(t Type) Type { return NewSet(t) })
+	case "dict":
+		t, err = p.parseDict()
+	case "tuple":
+		t, err = p.parseTuple()
+	case "struct":
+		t, err = p.parseStruct()
+	case "variant":
+		t, err = p.parseVariant()
+	case "tagged":
+		t, err = p.parseTagged()
+	case "decimal":
+		t, err = p.parseDecimal()
+	case "void":
+		t = NewVoid()
+	case "null":
+		t = NewNull()
+	case "emptylist":
+		t = NewEmptyList()
+	case "emptydict":
+		t = NewEmptyDict()
+	default:
+		if pgType, has := pgTypeByName(strings.ToLower(name)); has {
+			t = pgType
+
+			break
+		}
+		primitive, has := primitiveByName[strings.ToLower(name)]
+		if !has {
+			p.pos -= len(name)
+
+			return nil, p.errorf("unknown type '%s'", name)
+		}
+		t = primitive
+	}
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == '?' {
+		p.pos++
+		t = NewOptional(t)
+	}
+
+	return t, nil
+}
+
+func (p *typeParser) parseOneArg(makeType func(t Type) Type) (Type, error) {
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	t, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect('>'); err != nil {
+		return nil, err
+	}
+
+	return makeType(t), nil
+}
+
+func (p *typeParser) parseDict() (Type, error) {
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	k, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(','); err != nil {
+		return nil, err
+	}
+	v, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect('>'); err != nil {
+		return nil, err
+	}
+
+	return NewDict(k, v), nil
+}
+
+// parseList parses comma separated list of items in angle brackets
+func (p *typeParser) parseList(parseItem func() error) error {
+	if err := p.expect('<'); err != nil {
+		return err
+	}
+	if p.peek() == '>' {
+		p.pos++
+
+		return nil
+	}
+	for {
+		if err := parseItem(); err != nil {
+			return err
+		}
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '>':
+			p.pos++
+
+			return nil
+		default:
+			return p.expect('>')
+		}
+	}
+}
+
+func (p *typeParser) parseTypes() (items []Type, _ error) {
+	err := p.parseList(func() error {
+		t, err := p.parseType()
+		if err != nil {
+			return err
+		}
+		items = append(items, t)
+
+		return nil
+	})
+
+	return items, err
+}
+
+func (p *typeParser) parseTuple() (Type, error) {
+	items, err := p.parseTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTuple(items...), nil
+}
+
+func (p *typeParser) parseFields() (fields []StructField, _ error) {
+	err := p.parseList(func() error {
+		name, err := p.name()
+		if err != nil {
+			return err
+		}
+		if err = p.expect(':'); err != nil {
+			return err
+		}
+		t, err := p.parseType()
+		if err != nil {
+			return err
+		}
+		fields = append(fields, StructField{
+			Name: name,
+			T:    t,
+		})
+
+		return nil
+	})
+
+	return fields, err
+}
+
+func (p *typeParser) parseStruct() (Type, error) {
+	fields, err := p.parseFields()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStruct(fields...), nil
+}
+
+// parseVariant parses Variant<T1,T2,...> as variant over tuple and Variant<name1:T1,...> as variant over struct
+func (p *typeParser) parseVariant() (Type, error) {
+	start := p.pos
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	_, err := p.name()
+	isStruct := err == nil && p.peek() == ':'
+	p.pos = start
+	if isStruct {
+		fields, err := p.parseFields()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewVariantStruct(fields...), nil
+	}
+	items, err := p.parseTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewVariantTuple(items...), nil
+}
+
+func (p *typeParser) parseTagged() (Type, error) {
+	if err := p.expect('<'); err != nil {
+		return nil, err
+	}
+	t, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(','); err != nil {
+		return nil, err
+	}
+	tag, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect('>'); err != nil {
+		return nil, err
+	}
+
+	return NewTagged(t, tag), nil
+}
+
+func (p *typeParser) parseDecimal() (Type, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	precision, err := p.uint32()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(','); err != nil {
+		return nil, err
+	}
+	scale, err := p.uint32()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(')'); err != nil {
+		return nil, err
+	}
+
+	return NewDecimal(precision, scale), nil
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	for _, tt := range []Type{
+		NewVoid(),
+		NewNull(),
+		NewEmptyList(),
+		NewEmptyDict(),
+		Bool,
+		Int8,
+		Uint64,
+		Double,
+		Date,
+		TzTimestamp,
+		Bytes,
+		Text,
+		YSON,
+		JSON,
+		UUID,
+		JSONDocument,
+		DyNumber,
+		Date32,
+		Interval64,
+		NewDecimal(22, 9),
+		NewOptional(Text),
+		NewOptional(NewOptional(Int32)),
+		NewList(NewStruct(
+			StructField{Name: "id", T: Uint64},
+			StructField{Name: "name", T: NewOptional(Text)},
+		)),
+		NewSet(Int64),
+		NewDict(Text, NewList(NewDecimal(35, 10))),
+		NewTuple(),
+		NewTuple(Int32, NewTuple(Text, Bool)),
+		NewStruct(),
+		NewStruct(StructField{Name: "with space", T: Int32}),
+		NewVariantTuple(Int32, Text),
+		NewVariantStruct(
+			StructField{Name: "a", T: Int32},
+			StructField{Name: "b", T: NewOptional(Text)},
+		),
+		NewOptional(NewTagged(NewStruct(StructField{Name: "a", T: Int32}), "my_tag")),
+		NewTagged(Int32, `a'b`),
+		NewTagged(Int32, `a\`),
+		NewTagged(Int32, `\'\\`),
+		PgType{OID: pg.OIDInt4},
+		PgType{OID: pg.OIDTextArray},
+		NewOptional(PgType{OID: pg.OIDTimestampTz}),
+		NewList(PgType{OID: pg.OIDJSONB}),
+	} {
+		t.Run(tt.Yql(), func(t *testing.T) {
+			parsed, err := Parse(tt.Yql())
+			require.NoError(t, err)
+			require.True(t, Equal(tt, parsed), parsed.Yql())
+			require.Equal(t, tt.Yql(), parsed.Yql())
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		s   string
+		exp Type
+	}{
+		{
+			s:   "List<Struct<id:Uint64,name:Optional<Utf8>>>",
+			exp: NewList(NewStruct(StructField{Name: "id", T: Uint64}, StructField{Name: "name", T: NewOptional(Text)})),
+		},
+		{
+			s:   " list < struct < `id` : uint64 , \"name\" : text? > > ",
+			exp: NewList(NewStruct(StructField{Name: "id", T: Uint64}, StructField{Name: "name", T: NewOptional(Text)})),
+		},
+		{
+			s:   "Int32??",
+			exp: NewOptional(NewOptional(Int32)),
+		},
+		{
+			s:   "Decimal( 22 , 9 )",
+			exp: NewDecimal(22, 9),
+		},
+		{
+			s:   "String",
+			exp: Bytes,
+		},
+		{
+			s:   "Bytes",
+			exp: Bytes,
+		},
+		{
+			s:   "Variant<Int32>",
+			exp: NewVariantTuple(Int32),
+		},
+		{
+			s:   "Tagged<Utf8,tag>",
+			exp: NewTagged(Text, "tag"),
+		},
+		{
+			s:   `Tagged<Int32,'a\'b'>`,
+			exp: NewTagged(Int32, "a'b"),
+		},
+		{
+			s:   "PgInt8",
+			exp: PgType{OID: pg.OIDInt8},
+		},
+		{
+			s:   "Struct<a:_pgint4?>",
+			exp: NewStruct(StructField{Name: "a", T: NewOptional(PgType{OID: pg.OIDInt4Array})}),
+		},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			parsed, err := Parse(tt.s)
+			require.NoError(t, err)
+			require.True(t, Equal(tt.exp, parsed), parsed.Yql())
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"Unknown",
+		"Int32 Int32",
+		"List<Int32",
+		"List<>",
+		"Dict<Int32>",
+		"Struct<a Int32>",
+		"Struct<'a:Int32>",
+		"Decimal(22)",
+		"Decimal(a,b)",
+		"Tagged<Int32>",
+		"Optional<Int32>>",
+		"pgnotexisting",
+		"_pgnotexisting",
+	} {
+		t.Run(s, func(t *testing.T) {
+			_, err := Parse(s)
+			require.ErrorIs(t, err, errParseType)
+		})
+	}
+}
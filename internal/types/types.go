@@ -2,10 +2,12 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
 )
 
@@ -66,14 +68,23 @@ func TypeFromYDB(x *Ydb.Type) Type {
 			panic("ydb: unknown variant type")
 		}
 
+	case *Ydb.Type_TaggedType:
+		return NewTagged(TypeFromYDB(v.TaggedType.GetType()), v.TaggedType.GetTag())
+
 	case *Ydb.Type_VoidType:
 		return NewVoid()
 
 	case *Ydb.Type_NullType:
 		return NewNull()
 
+	case *Ydb.Type_EmptyListType:
+		return NewEmptyList()
+
+	case *Ydb.Type_EmptyDictType:
+		return NewEmptyDict()
+
 	case *Ydb.Type_PgType:
-		return &PgType{
+		return PgType{
 			OID: x.GetPgType().GetOid(),
 		}
 
@@ -134,6 +145,14 @@ func primitiveTypeFromYDB(t Ydb.Type_PrimitiveTypeId) Type {
 		return JSONDocument
 	case Ydb.Type_DYNUMBER:
 		return DyNumber
+	case TypeIDDate32:
+		return Date32
+	case TypeIDDatetime64:
+		return Datetime64
+	case TypeIDTimestamp64:
+		return Timestamp64
+	case TypeIDInterval64:
+		return Interval64
 	default:
 		panic("ydb: unexpected type")
 	}
@@ -470,6 +489,59 @@ func NewOptional(t Type) Optional {
 	}
 }
 
+// tagEscaper escapes tag for quoting with ' (Parse unescapes it back)
+var tagEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+type Tagged struct {
+	innerType Type
+	tag       string
+}
+
+func (v *Tagged) InnerType() Type {
+	return v.innerType
+}
+
+func (v *Tagged) Tag() string {
+	return v.tag
+}
+
+func (v *Tagged) String() string {
+	return v.Yql()
+}
+
+func (v *Tagged) Yql() string {
+	return "Tagged<" + v.innerType.Yql() + ",'" + tagEscaper.Replace(v.tag) + "'>"
+}
+
+func (v *Tagged) equalsTo(rhs Type) bool {
+	vv, ok := rhs.(*Tagged)
+	if !ok {
+		return false
+	}
+
+	return v.tag == vv.tag && v.innerType.equalsTo(vv.innerType)
+}
+
+func (v *Tagged) ToYDB(a *allocator.Allocator) *Ydb.Type {
+	t := a.Type()
+
+	t.Type = &Ydb.Type_TaggedType{
+		TaggedType: &Ydb.TaggedType{
+			Tag:  v.tag,
+			Type: v.innerType.ToYDB(a),
+		},
+	}
+
+	return t
+}
+
+func NewTagged(t Type, tag string) *Tagged {
+	return &Tagged{
+		innerType: t,
+		tag:       tag,
+	}
+}
+
 type PgType struct {
 	OID uint32
 }
@@ -479,7 +551,13 @@ func (v PgType) String() string {
 }
 
 func (v PgType) Yql() string {
-	return "pgunknown"
+	name := pg.TypeName(v.OID)
+	if strings.HasPrefix(name, "_") {
+		// arrays are named as _pgint4
+		return "_pg" + name[1:]
+	}
+
+	return "pg" + name
 }
 
 func (v PgType) ToYDB(a *allocator.Allocator) *Ydb.Type {
@@ -538,6 +616,18 @@ const (
 	UUID
 	JSONDocument
 	DyNumber
+	Date32
+	Datetime64
+	Timestamp64
+	Interval64
+)
+
+// Type ids of date and time types with extended range (before 1970 and after 2106)
+const (
+	TypeIDDate32      = Ydb.Type_PrimitiveTypeId(0x0040)
+	TypeIDDatetime64  = Ydb.Type_PrimitiveTypeId(0x0041)
+	TypeIDTimestamp64 = Ydb.Type_PrimitiveTypeId(0x0042)
+	TypeIDInterval64  = Ydb.Type_PrimitiveTypeId(0x0043)
 )
 
 var primitive = [...]*Ydb.Type{
@@ -566,6 +656,10 @@ var primitive = [...]*Ydb.Type{
 	UUID:         {Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UUID}},
 	JSONDocument: {Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_JSON_DOCUMENT}},
 	DyNumber:     {Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_DYNUMBER}},
+	Date32:       {Type: &Ydb.Type_TypeId{TypeId: TypeIDDate32}},
+	Datetime64:   {Type: &Ydb.Type_TypeId{TypeId: TypeIDDatetime64}},
+	Timestamp64:  {Type: &Ydb.Type_TypeId{TypeId: TypeIDTimestamp64}},
+	Interval64:   {Type: &Ydb.Type_TypeId{TypeId: TypeIDInterval64}},
 }
 
 var primitiveString = [...]string{
@@ -595,6 +689,10 @@ var primitiveString = [...]string{
 	UUID:         "Uuid",
 	JSONDocument: "JsonDocument",
 	DyNumber:     "DyNumber",
+	Date32:       "Date32",
+	Datetime64:   "Datetime64",
+	Timestamp64:  "Timestamp64",
+	Interval64:   "Interval64",
 }
 
 func (v Primitive) equalsTo(rhs Type) bool {
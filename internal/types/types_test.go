@@ -273,6 +273,14 @@ func TestTypeToString(t *testing.T) {
 			t: PgType{OID: pg.OIDUnknown},
 			s: "pgunknown",
 		},
+		{
+			t: PgType{OID: pg.OIDInt4},
+			s: "pgint4",
+		},
+		{
+			t: PgType{OID: pg.OIDTextArray},
+			s: "_pgtext",
+		},
 	} {
 		t.Run(tt.s, func(t *testing.T) {
 			if got := tt.t.Yql(); got != tt.s {
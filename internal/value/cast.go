@@ -1,5 +1,23 @@
 package value
 
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
 func CastTo(v Value, dst interface{}) error {
+	if scanner, has := dst.(ValueScanner); has {
+		if optional, isOptional := v.(*optionalValue); isOptional {
+			if optional.value == nil {
+				return xerrors.WithStackTrace(fmt.Errorf("%w NULL to '%T' destination", ErrCannotCast, dst))
+			}
+
+			return CastTo(optional.value, dst)
+		}
+
+		return scanner.ScanYDBValue(v)
+	}
+
 	return v.castTo(dst)
 }
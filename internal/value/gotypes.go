@@ -0,0 +1,797 @@
+package value
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// GoStructTagName is a name of struct tag with name of member of struct value (such as `sql:"id"`).
+// Field with tag `sql:"-"` is skipped. Field without tag is mapped to member with name of field.
+// Fields of embedded struct without tag are mapped to members of outer struct value
+const GoStructTagName = "sql"
+
+var (
+	errFromGo = errors.New("cannot convert go value")
+	errToGo   = errors.New("cannot convert value to go")
+)
+
+type (
+	// ValueConverter is an interface of custom go types which converts itself into YDB value
+	ValueConverter interface {
+		ToYDBValue() (Value, error)
+	}
+	// ValueScanner is an interface of custom go types which fills itself from YDB value
+	ValueScanner interface {
+		ScanYDBValue(v Value) error
+	}
+)
+
+var (
+	typeOfTime           = reflect.TypeOf(time.Time{})
+	typeOfDuration       = reflect.TypeOf(time.Duration(0))
+	typeOfUUID           = reflect.TypeOf(uuid.UUID{})
+	typeOfUUIDBytes      = reflect.TypeOf([16]byte{})
+	typeOfJSON           = reflect.TypeOf(json.RawMessage{})
+	typeOfDecimal        = reflect.TypeOf(decimal.Decimal{})
+	typeOfBigInt         = reflect.TypeOf(big.Int{})
+	typeOfEmptyStruct    = reflect.TypeOf(struct{}{})
+	typeOfValue          = reflect.TypeOf((*Value)(nil)).Elem()
+	typeOfValueScanner   = reflect.TypeOf((*ValueScanner)(nil)).Elem()
+	typeOfValueConverter = reflect.TypeOf((*ValueConverter)(nil)).Elem()
+	typeOfDriverValuer   = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// defaultDecimalType is a type of null decimal values (precision and scale of go decimal
+// are known only from value)
+var defaultDecimalType = types.NewDecimal(22, 9)
+
+type goStructField struct {
+	index []int
+	name  string
+}
+
+// goStructFields returns exported fields of struct type with names of struct members.
+// Fields of embedded structs (without tag) are promoted into outer struct as in encoding/json:
+// field of outer struct hides promoted field with the same name, the shallowest promoted field
+// wins and promoted fields with the same name at the same depth are an error
+//
+//nolint:funlen
+func goStructFields(t reflect.Type) (fields []goStructField, _ error) {
+	var promoted []goStructField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup(GoStructTagName)
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && tag == "" {
+			switch {
+			case f.Type.Kind() == reflect.Struct:
+				inner, err := goStructFields(f.Type)
+				if err != nil {
+					return nil, err
+				}
+				for _, innerField := range inner {
+					promoted = append(promoted, goStructField{
+						index: append([]int{i}, innerField.index...),
+						name:  innerField.name,
+					})
+				}
+
+				continue
+			case f.Type.Kind() == reflect.Pointer && f.Type.Elem().Kind() == reflect.Struct:
+				return nil, fmt.Errorf("embedded pointer to struct %s of %s is not supported", f.Type, t)
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		if hasTag && tag != "" {
+			name = tag
+		}
+		fields = append(fields, goStructField{
+			index: []int{i},
+			name:  name,
+		})
+	}
+	if len(promoted) == 0 {
+		return fields, nil
+	}
+
+	known := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		known[f.name] = struct{}{}
+	}
+	shallowest := make(map[string]goStructField, len(promoted))
+	ambiguous := make(map[string]bool)
+	for _, f := range promoted {
+		if _, has := known[f.name]; has {
+			continue
+		}
+		prev, has := shallowest[f.name]
+		switch {
+		case !has || len(f.index) < len(prev.index):
+			shallowest[f.name], ambiguous[f.name] = f, false
+		case len(f.index) == len(prev.index):
+			ambiguous[f.name] = true
+		}
+	}
+	for _, f := range promoted {
+		winner, has := shallowest[f.name]
+		if !has || len(winner.index) != len(f.index) {
+			continue
+		}
+		if ambiguous[f.name] {
+			return nil, fmt.Errorf("ambiguous field '%s' of embedded structs of %s", f.name, t)
+		}
+		fields = append(fields, f)
+		delete(shallowest, f.name)
+	}
+
+	return fields, nil
+}
+
+// TypeFromGo returns YDB type for go type of value.
+// Go int and uint are mapped to Int64 and Uint64, pointers to Optional, slices and arrays to List
+// (except []byte as String), maps to Dict (except map[K]struct{} as Set) and structs to Struct.
+// Decimal is mapped to Decimal(22,9) (FromGo takes precision and scale of non-null values from value).
+// Type of ValueConverter is a type of value which converter makes from zero value
+//
+//nolint:funlen,gocyclo
+func TypeFromGo(t reflect.Type) (types.Type, error) {
+	switch t {
+	case typeOfTime:
+		return types.Timestamp, nil
+	case typeOfDuration:
+		return types.Interval, nil
+	case typeOfUUID, typeOfUUIDBytes:
+		return types.UUID, nil
+	case typeOfJSON:
+		return types.JSON, nil
+	case typeOfDecimal:
+		return defaultDecimalType, nil
+	}
+	if reflect.PointerTo(t).Implements(typeOfValueConverter) {
+		// type of values of converter is a type of value converted from zero value
+		v, err := reflect.New(t).Interface().(ValueConverter).ToYDBValue() //nolint:forcetypeassert
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s: %w", errFromGo, t, err))
+		}
+
+		return v.Type(), nil
+	}
+	if t.Implements(typeOfDriverValuer) {
+		return nil, xerrors.WithStackTrace(fmt.Errorf(
+			"%w: type of values of go type %s is known only from values", errFromGo, t,
+		))
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return types.Bool, nil
+	case reflect.Int8:
+		return types.Int8, nil
+	case reflect.Int16:
+		return types.Int16, nil
+	case reflect.Int32:
+		return types.Int32, nil
+	case reflect.Int, reflect.Int64:
+		return types.Int64, nil
+	case reflect.Uint8:
+		return types.Uint8, nil
+	case reflect.Uint16:
+		return types.Uint16, nil
+	case reflect.Uint32:
+		return types.Uint32, nil
+	case reflect.Uint, reflect.Uint64:
+		return types.Uint64, nil
+	case reflect.Float32:
+		return types.Float, nil
+	case reflect.Float64:
+		return types.Double, nil
+	case reflect.String:
+		return types.Text, nil
+	case reflect.Pointer:
+		inner, err := TypeFromGo(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewOptional(inner), nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return types.Bytes, nil
+		}
+		item, err := TypeFromGo(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewList(item), nil
+	case reflect.Map:
+		k, err := TypeFromGo(t.Key())
+		if err != nil {
+			return nil, err
+		}
+		if t.Elem() == typeOfEmptyStruct {
+			return types.NewSet(k), nil
+		}
+		v, err := TypeFromGo(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewDict(k, v), nil
+	case reflect.Struct:
+		goFields, err := goStructFields(t)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %w", errFromGo, err))
+		}
+		fields := make([]types.StructField, 0, len(goFields))
+		for _, f := range goFields {
+			ft, err := TypeFromGo(t.FieldByIndex(f.index).Type)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, types.StructField{
+				Name: f.name,
+				T:    ft,
+			})
+		}
+		sort.Slice(fields, func(i, j int) bool {
+			return fields[i].Name < fields[j].Name
+		})
+
+		return types.NewStruct(fields...), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: cannot infer YDB type of go type %s", errFromGo, t))
+	}
+}
+
+// FromGo makes YDB value from go value. Value, ValueConverter and driver.Valuer are converted by itself,
+// time.Time converted to Timestamp (or Timestamp64 for times before 1970), time.Duration to Interval,
+// uuid.UUID to Uuid, json.RawMessage to Json, decimal to Decimal and other types mapped as TypeFromGo
+// describes. Nil pointers are converted to null values, untyped nil to Void
+func FromGo(v interface{}) (Value, error) {
+	value, err := fromGo(reflect.ValueOf(v))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return value, nil
+}
+
+func isNilPointer(rv reflect.Value) bool {
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+//nolint:funlen,gocyclo
+func fromGo(rv reflect.Value) (Value, error) {
+	if !rv.IsValid() {
+		return VoidValue(), nil
+	}
+	if rv.Kind() == reflect.Interface {
+		return fromGo(rv.Elem())
+	}
+	if rv.Kind() != reflect.Pointer && rv.CanAddr() {
+		if converter, ok := rv.Addr().Interface().(ValueConverter); ok {
+			return converter.ToYDBValue()
+		}
+	}
+	if rv.CanInterface() && !isNilPointer(rv) {
+		switch x := rv.Interface().(type) {
+		case Value:
+			return x, nil
+		case ValueConverter:
+			return x.ToYDBValue()
+		case time.Time:
+			if x.Before(time.Unix(0, 0)) {
+				return Timestamp64ValueFromTime(x), nil
+			}
+
+			return TimestampValueFromTime(x), nil
+		case time.Duration:
+			return IntervalValueFromDuration(x), nil
+		case uuid.UUID:
+			return UUIDValue(x), nil
+		case [16]byte:
+			return UUIDValue(x), nil
+		case json.RawMessage:
+			return JSONValue(string(x)), nil
+		case decimal.Decimal:
+			return DecimalValue(x.Bytes, x.Precision, x.Scale), nil
+		case driver.Valuer:
+			v, err := x.Value()
+			if err != nil {
+				return nil, fmt.Errorf("%w: driver.Valuer error: %w", errFromGo, err)
+			}
+
+			return fromGo(reflect.ValueOf(v))
+		}
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		return BoolValue(rv.Bool()), nil
+	case reflect.Int8:
+		return Int8Value(int8(rv.Int())), nil
+	case reflect.Int16:
+		return Int16Value(int16(rv.Int())), nil
+	case reflect.Int32:
+		return Int32Value(int32(rv.Int())), nil
+	case reflect.Int, reflect.Int64:
+		return Int64Value(rv.Int()), nil
+	case reflect.Uint8:
+		return Uint8Value(uint8(rv.Uint())), nil
+	case reflect.Uint16:
+		return Uint16Value(uint16(rv.Uint())), nil
+	case reflect.Uint32:
+		return Uint32Value(uint32(rv.Uint())), nil
+	case reflect.Uint, reflect.Uint64:
+		return Uint64Value(rv.Uint()), nil
+	case reflect.Float32:
+		return FloatValue(float32(rv.Float())), nil
+	case reflect.Float64:
+		return DoubleValue(rv.Float()), nil
+	case reflect.String:
+		return TextValue(rv.String()), nil
+	case reflect.Pointer:
+		if rv.IsNil() {
+			t, err := TypeFromGo(rv.Type().Elem())
+			if err != nil {
+				return nil, err
+			}
+
+			return NullValue(t), nil
+		}
+		v, err := fromGo(rv.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return OptionalValue(v), nil
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+
+			return BytesValue(b), nil
+		}
+		items, err := itemsFromGo(rv)
+		if err != nil {
+			return nil, err
+		}
+		if t, err := TypeFromGo(rv.Type()); err == nil {
+			return &listValue{t: t, items: items}, nil
+		}
+
+		return ListValue(items...), nil
+	case reflect.Map:
+		return mapFromGo(rv)
+	case reflect.Struct:
+		return structFromGo(rv)
+	default:
+		return nil, fmt.Errorf("%w: unsupported go type %s", errFromGo, rv.Type())
+	}
+}
+
+func itemsFromGo(rv reflect.Value) ([]Value, error) {
+	items := make([]Value, rv.Len())
+	for i := range items {
+		item, err := fromGo(rv.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+
+	return items, nil
+}
+
+func mapFromGo(rv reflect.Value) (Value, error) {
+	t, typeErr := TypeFromGo(rv.Type())
+	if rv.Type().Elem() == typeOfEmptyStruct {
+		items := make([]Value, 0, rv.Len())
+		for iter := rv.MapRange(); iter.Next(); {
+			k, err := fromGo(iter.Key())
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, k)
+		}
+		if typeErr != nil {
+			return SetValue(items...), nil
+		}
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].Yql() < items[j].Yql()
+		})
+
+		return &setValue{t: t, items: items}, nil
+	}
+	values := make([]DictValueField, 0, rv.Len())
+	for iter := rv.MapRange(); iter.Next(); {
+		k, err := fromGo(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		v, err := fromGo(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, DictValueField{K: k, V: v})
+	}
+	if typeErr != nil {
+		return DictValue(values...), nil
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].K.Yql() < values[j].K.Yql()
+	})
+
+	return &dictValue{t: t, values: values}, nil
+}
+
+func structFromGo(rv reflect.Value) (Value, error) {
+	goFields, err := goStructFields(rv.Type())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errFromGo, err)
+	}
+	fields := make([]StructValueField, 0, len(goFields))
+	for _, f := range goFields {
+		v, err := fromGo(rv.FieldByIndex(f.index))
+		if err != nil {
+			return nil, fmt.Errorf("%w: field %s.%s: %w", errFromGo, rv.Type(), rv.Type().FieldByIndex(f.index).Name, err)
+		}
+		fields = append(fields, StructValueField{
+			Name: f.name,
+			V:    v,
+		})
+	}
+
+	return StructValue(fields...), nil
+}
+
+// ToGo stores YDB value into go value pointed by dst. Optional values are stored into pointers (null as nil)
+// or into values (null as zero value), List, Set and Tuple into slices and arrays, Dict into maps, Set also
+// into map[K]struct{}, Struct into structs (by tag, see GoStructTagName) and map[string]T. Destination
+// interface{} receives natural go representation of value
+func ToGo(v Value, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: destination %T is not a non-nil pointer", errToGo, dst))
+	}
+	if err := toGo(v, rv.Elem()); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// naturalGoType returns go type which receives value of YDB type with ToGo into interface{}
+//
+//nolint:funlen,gocyclo
+func naturalGoType(t types.Type) reflect.Type {
+	switch tt := t.(type) {
+	case types.Primitive:
+		switch tt {
+		case types.Bool:
+			return reflect.TypeOf(false)
+		case types.Int8:
+			return reflect.TypeOf(int8(0))
+		case types.Int16:
+			return reflect.TypeOf(int16(0))
+		case types.Int32:
+			return reflect.TypeOf(int32(0))
+		case types.Int64:
+			return reflect.TypeOf(int64(0))
+		case types.Uint8:
+			return reflect.TypeOf(uint8(0))
+		case types.Uint16:
+			return reflect.TypeOf(uint16(0))
+		case types.Uint32:
+			return reflect.TypeOf(uint32(0))
+		case types.Uint64:
+			return reflect.TypeOf(uint64(0))
+		case types.Float:
+			return reflect.TypeOf(float32(0))
+		case types.Double:
+			return reflect.TypeOf(float64(0))
+		case types.Bytes, types.YSON:
+			return reflect.TypeOf([]byte(nil))
+		case types.JSON, types.JSONDocument:
+			return typeOfJSON
+		case types.UUID:
+			return typeOfUUID
+		case types.Date, types.Date32, types.Datetime, types.Datetime64, types.Timestamp, types.Timestamp64:
+			return typeOfTime
+		case types.Interval, types.Interval64:
+			return typeOfDuration
+		default:
+			return reflect.TypeOf("")
+		}
+	case *types.Decimal:
+		return typeOfDecimal
+	case types.Optional:
+		return reflect.PointerTo(naturalGoType(tt.InnerType()))
+	case *types.List:
+		return reflect.SliceOf(naturalGoType(tt.ItemType()))
+	case *types.Set:
+		if k := naturalGoType(tt.ItemType()); k.Comparable() {
+			return reflect.MapOf(k, typeOfEmptyStruct)
+		}
+	case *types.Dict:
+		if k := naturalGoType(tt.KeyType()); k.Comparable() {
+			return reflect.MapOf(k, naturalGoType(tt.ValueType()))
+		}
+	case *types.Struct:
+		return reflect.TypeOf(map[string]interface{}(nil))
+	case *types.Tagged:
+		return naturalGoType(tt.InnerType())
+	}
+
+	return reflect.TypeOf([]interface{}(nil))
+}
+
+//nolint:funlen,gocyclo
+func toGo(v Value, rv reflect.Value) error {
+	if rv.Type() == typeOfValue {
+		rv.Set(reflect.ValueOf(v))
+
+		return nil
+	}
+	switch vv := v.(type) {
+	case *optionalValue:
+		if rv.Kind() == reflect.Pointer {
+			if vv.value == nil {
+				rv.SetZero()
+
+				return nil
+			}
+			if rv.IsNil() {
+				rv.Set(reflect.New(rv.Type().Elem()))
+			}
+
+			return toGo(vv.value, rv.Elem())
+		}
+		if vv.value == nil {
+			rv.SetZero()
+
+			return nil
+		}
+
+		return toGo(vv.value, rv)
+	case voidValue:
+		rv.SetZero()
+
+		return nil
+	case *variantValue:
+		return toGo(vv.value, rv)
+	}
+	if rv.CanAddr() && rv.Addr().Type().Implements(typeOfValueScanner) {
+		return rv.Addr().Interface().(ValueScanner).ScanYDBValue(v) //nolint:forcetypeassert
+	}
+	if rv.Kind() == reflect.Pointer && rv.Type() != reflect.PointerTo(typeOfBigInt) {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+
+		return toGo(v, rv.Elem())
+	}
+	if rv.Kind() == reflect.Interface {
+		if rv.NumMethod() > 0 {
+			return fmt.Errorf("%w: cannot store %s into %s", errToGo, v.Type().Yql(), rv.Type())
+		}
+		dst := reflect.New(naturalGoType(v.Type())).Elem()
+		if err := toGo(v, dst); err != nil {
+			return err
+		}
+		rv.Set(dst)
+
+		return nil
+	}
+	switch vv := v.(type) {
+	case *listValue:
+		return itemsToGo(v, vv.items, rv)
+	case *setValue:
+		if rv.Kind() == reflect.Map && rv.Type().Elem() == typeOfEmptyStruct {
+			m := reflect.MakeMapWithSize(rv.Type(), len(vv.items))
+			for _, item := range vv.items {
+				k := reflect.New(rv.Type().Key()).Elem()
+				if err := toGo(item, k); err != nil {
+					return err
+				}
+				m.SetMapIndex(k, reflect.Zero(typeOfEmptyStruct))
+			}
+			rv.Set(m)
+
+			return nil
+		}
+
+		return itemsToGo(v, vv.items, rv)
+	case *tupleValue:
+		return itemsToGo(v, vv.items, rv)
+	case *dictValue:
+		return dictToGo(vv, rv)
+	case *structValue:
+		return structToGo(vv, rv)
+	case *decimalValue:
+		return decimalToGo(vv, rv)
+	}
+	if rv.CanAddr() {
+		if err := v.castTo(rv.Addr().Interface()); err == nil {
+			return nil
+		}
+	}
+
+	return convertToGo(v, rv)
+}
+
+// convertToGo stores value into go value of named type (such as uuid.UUID or type MyString string)
+// with basic type of the same kind
+func convertToGo(v Value, rv reflect.Value) error {
+	var basic reflect.Type
+	switch rv.Kind() {
+	case reflect.Bool:
+		basic = reflect.TypeOf(false)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		basic = reflect.TypeOf(int64(0))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		basic = reflect.TypeOf(uint64(0))
+	case reflect.Float32, reflect.Float64:
+		basic = reflect.TypeOf(float64(0))
+	case reflect.String:
+		basic = reflect.TypeOf("")
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			basic = reflect.TypeOf([]byte(nil))
+		}
+	case reflect.Array:
+		if rv.Type().ConvertibleTo(typeOfUUIDBytes) {
+			basic = typeOfUUIDBytes
+		}
+	case reflect.Struct:
+		if rv.Type().ConvertibleTo(typeOfTime) {
+			basic = typeOfTime
+		}
+	}
+	if basic == nil || basic == rv.Type() {
+		return fmt.Errorf("%w: cannot store %s into %s", errToGo, v.Type().Yql(), rv.Type())
+	}
+	tmp := reflect.New(basic)
+	if err := v.castTo(tmp.Interface()); err != nil {
+		return fmt.Errorf("%w: cannot store %s into %s: %w", errToGo, v.Type().Yql(), rv.Type(), err)
+	}
+	converted := tmp.Elem().Convert(rv.Type())
+	if isInteger(basic.Kind()) && !converted.Convert(basic).Equal(tmp.Elem()) {
+		return fmt.Errorf("%w: value %s overflows %s", errToGo, v.Yql(), rv.Type())
+	}
+	rv.Set(converted)
+
+	return nil
+}
+
+func itemsToGo(v Value, items []Value, rv reflect.Value) error {
+	switch rv.Kind() {
+	case reflect.Slice:
+		s := reflect.MakeSlice(rv.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := toGo(item, s.Index(i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(s)
+
+		return nil
+	case reflect.Array:
+		if rv.Len() != len(items) {
+			return fmt.Errorf("%w: cannot store %d items of %s into %s", errToGo, len(items), v.Type().Yql(), rv.Type())
+		}
+		for i, item := range items {
+			if err := toGo(item, rv.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: cannot store %s into %s", errToGo, v.Type().Yql(), rv.Type())
+	}
+}
+
+func dictToGo(v *dictValue, rv reflect.Value) error {
+	if rv.Kind() != reflect.Map {
+		return fmt.Errorf("%w: cannot store %s into %s", errToGo, v.Type().Yql(), rv.Type())
+	}
+	m := reflect.MakeMapWithSize(rv.Type(), len(v.values))
+	for i := range v.values {
+		k := reflect.New(rv.Type().Key()).Elem()
+		if err := toGo(v.values[i].K, k); err != nil {
+			return err
+		}
+		e := reflect.New(rv.Type().Elem()).Elem()
+		if err := toGo(v.values[i].V, e); err != nil {
+			return err
+		}
+		m.SetMapIndex(k, e)
+	}
+	rv.Set(m)
+
+	return nil
+}
+
+func structToGo(v *structValue, rv reflect.Value) error {
+	switch {
+	case rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String:
+		m := reflect.MakeMapWithSize(rv.Type(), len(v.fields))
+		for i := range v.fields {
+			e := reflect.New(rv.Type().Elem()).Elem()
+			if err := toGo(v.fields[i].V, e); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(v.fields[i].Name).Convert(rv.Type().Key()), e)
+		}
+		rv.Set(m)
+
+		return nil
+	case rv.Kind() == reflect.Struct:
+		goFields, err := goStructFields(rv.Type())
+		if err != nil {
+			return fmt.Errorf("%w: %w", errToGo, err)
+		}
+		index := make(map[string][]int, len(goFields))
+		for _, f := range goFields {
+			index[f.name] = f.index
+		}
+		for i := range v.fields {
+			fieldIndex, has := index[v.fields[i].Name]
+			if !has {
+				return fmt.Errorf("%w: member '%s' of %s not found in %s",
+					errToGo, v.fields[i].Name, v.Type().Yql(), rv.Type(),
+				)
+			}
+			if err := toGo(v.fields[i].V, rv.FieldByIndex(fieldIndex)); err != nil {
+				return fmt.Errorf("%w: member '%s': %w", errToGo, v.fields[i].Name, err)
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: cannot store %s into %s", errToGo, v.Type().Yql(), rv.Type())
+	}
+}
+
+func decimalToGo(v *decimalValue, rv reflect.Value) error {
+	precision, scale := v.innerType.Precision(), v.innerType.Scale()
+	switch {
+	case rv.Type() == typeOfDecimal:
+		rv.Set(reflect.ValueOf(decimal.Decimal{
+			Bytes:     v.value,
+			Precision: precision,
+			Scale:     scale,
+		}))
+	case rv.Type() == typeOfBigInt:
+		rv.Set(reflect.ValueOf(decimal.FromInt128(v.value, precision, scale)).Elem())
+	case rv.Kind() == reflect.String:
+		rv.SetString(decimal.Format(decimal.FromInt128(v.value, precision, scale), precision, scale))
+	case rv.Type() == reflect.TypeOf([16]byte{}):
+		rv.Set(reflect.ValueOf(v.value))
+	default:
+		return fmt.Errorf("%w: cannot store %s into %s", errToGo, v.Type().Yql(), rv.Type())
+	}
+
+	return nil
+}
+
+func isInteger(kind reflect.Kind) bool {
+	return kind == reflect.Int64 || kind == reflect.Uint64
+}
@@ -0,0 +1,259 @@
+package value
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+type testGoName string
+
+type testGoRow struct {
+	ID       uint64            `sql:"id"`
+	Title    *string           `sql:"title"`
+	Tags     []string          `sql:"tags"`
+	Attrs    map[string]int32  `sql:"attrs"`
+	Roles    map[int8]struct{} `sql:"roles"`
+	Name     testGoName        `sql:"name"`
+	Created  time.Time         `sql:"created"`
+	Deleted  *time.Time        `sql:"deleted"`
+	TTL      time.Duration     `sql:"ttl"`
+	UUID     uuid.UUID         `sql:"uuid"`
+	Doc      json.RawMessage   `sql:"doc"`
+	Price    decimal.Decimal   `sql:"price"`
+	Internal string            `sql:"-"`
+	private  int
+}
+
+type testGoAudit struct {
+	Created time.Time `sql:"created"`
+	Updated time.Time `sql:"updated"`
+}
+
+type testGoEmbedded struct {
+	testGoAudit
+	ID      uint64    `sql:"id"`
+	Updated time.Time `sql:"modified"`
+}
+
+type testGoConverter struct {
+	s string
+}
+
+func (c testGoConverter) ToYDBValue() (Value, error) {
+	return TextValue("converted:" + c.s), nil
+}
+
+func (c *testGoConverter) ScanYDBValue(v Value) error {
+	return CastTo(v, &c.s)
+}
+
+func TestFromGo(t *testing.T) {
+	title := "test"
+	created := time.Unix(1700000000, 123000).UTC()
+	price := decimal.Decimal{Bytes: decimal.BigIntToByte(big.NewInt(123450000000), 22, 9), Precision: 22, Scale: 9}
+	id := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	for _, tt := range []struct {
+		name string
+		src  interface{}
+		exp  Value
+	}{
+		{name: "nil", src: nil, exp: VoidValue()},
+		{name: "int", src: 1, exp: Int64Value(1)},
+		{name: "uint16", src: uint16(1), exp: Uint16Value(1)},
+		{name: "named", src: testGoName("a"), exp: TextValue("a")},
+		{name: "bytes", src: []byte("a"), exp: BytesValue([]byte("a"))},
+		{name: "nil pointer", src: (*int32)(nil), exp: NullValue(types.Int32)},
+		{name: "pointer", src: &title, exp: OptionalValue(TextValue("test"))},
+		{name: "empty slice", src: []int32{}, exp: &listValue{t: types.NewList(types.Int32)}},
+		{name: "slice", src: []int32{1, 2}, exp: ListValue(Int32Value(1), Int32Value(2))},
+		{name: "slice of interfaces", src: []interface{}{"a", "b"}, exp: ListValue(TextValue("a"), TextValue("b"))},
+		{
+			name: "map",
+			src:  map[string]int32{"b": 2, "a": 1},
+			exp: DictValue(
+				DictValueField{K: TextValue("a"), V: Int32Value(1)},
+				DictValueField{K: TextValue("b"), V: Int32Value(2)},
+			),
+		},
+		{name: "set", src: map[int8]struct{}{2: {}, 1: {}}, exp: SetValue(Int8Value(1), Int8Value(2))},
+		{name: "converter", src: testGoConverter{s: "a"}, exp: TextValue("converted:a")},
+		{
+			name: "converter in struct",
+			src:  struct{ C testGoConverter }{C: testGoConverter{s: "a"}},
+			exp:  StructValue(StructValueField{Name: "C", V: TextValue("converted:a")}),
+		},
+		{
+			name: "struct",
+			src: testGoRow{
+				ID:       1,
+				Title:    &title,
+				Tags:     []string{"a"},
+				Attrs:    map[string]int32{"a": 1},
+				Roles:    map[int8]struct{}{1: {}},
+				Name:     "name",
+				Created:  created,
+				TTL:      time.Second,
+				UUID:     id,
+				Doc:      json.RawMessage(`{"a":1}`),
+				Price:    price,
+				Internal: "skipped",
+			},
+			exp: StructValue(
+				StructValueField{Name: "id", V: Uint64Value(1)},
+				StructValueField{Name: "title", V: OptionalValue(TextValue("test"))},
+				StructValueField{Name: "tags", V: ListValue(TextValue("a"))},
+				StructValueField{Name: "attrs", V: DictValue(DictValueField{K: TextValue("a"), V: Int32Value(1)})},
+				StructValueField{Name: "roles", V: SetValue(Int8Value(1))},
+				StructValueField{Name: "name", V: TextValue("name")},
+				StructValueField{Name: "created", V: TimestampValueFromTime(created)},
+				StructValueField{Name: "deleted", V: NullValue(types.Timestamp)},
+				StructValueField{Name: "ttl", V: IntervalValueFromDuration(time.Second)},
+				StructValueField{Name: "uuid", V: UUIDValue(id)},
+				StructValueField{Name: "doc", V: JSONValue(`{"a":1}`)},
+				StructValueField{Name: "price", V: DecimalValue(price.Bytes, 22, 9)},
+			),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := FromGo(tt.src)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp.Yql(), v.Yql())
+			require.True(t, types.Equal(tt.exp.Type(), v.Type()), v.Type().Yql())
+			if tt.src != nil {
+				typ, err := TypeFromGo(reflect.TypeOf(tt.src))
+				if err == nil {
+					require.True(t, types.Equal(typ, v.Type()), typ.Yql())
+				}
+			}
+		})
+	}
+}
+
+func TestFromGoErrors(t *testing.T) {
+	for _, src := range []interface{}{
+		make(chan int),
+		func() {},
+		[]func(){nil},
+		(*interface{})(nil),
+		struct{ *testGoAudit }{},
+		struct {
+			testGoAudit
+			testGoRow
+		}{},
+	} {
+		t.Run(reflect.TypeOf(src).String(), func(t *testing.T) {
+			_, err := FromGo(src)
+			require.ErrorIs(t, err, errFromGo)
+		})
+	}
+}
+
+func TestToGo(t *testing.T) {
+	title := "test"
+	created := time.Unix(1700000000, 123000)
+	src := testGoRow{
+		ID:      1,
+		Title:   &title,
+		Tags:    []string{"a", "b"},
+		Attrs:   map[string]int32{"a": 1},
+		Roles:   map[int8]struct{}{1: {}, 2: {}},
+		Name:    "name",
+		Created: created,
+		TTL:     time.Second,
+		UUID:    uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		Doc:     json.RawMessage(`{"a":1}`),
+		Price:   decimal.Decimal{Bytes: decimal.BigIntToByte(big.NewInt(12345), 22, 2), Precision: 22, Scale: 2},
+	}
+	v, err := FromGo(src)
+	require.NoError(t, err)
+	t.Run("struct", func(t *testing.T) {
+		var dst testGoRow
+		require.NoError(t, ToGo(v, &dst))
+		require.Equal(t, src, dst)
+	})
+	t.Run("list of structs", func(t *testing.T) {
+		var dst []*testGoRow
+		require.NoError(t, ToGo(ListValue(v, v), &dst))
+		require.Equal(t, []*testGoRow{&src, &src}, dst)
+	})
+	t.Run("interface", func(t *testing.T) {
+		var dst interface{}
+		require.NoError(t, ToGo(StructValue(
+			StructValueField{Name: "id", V: Uint64Value(1)},
+			StructValueField{Name: "tags", V: ListValue(TextValue("a"))},
+			StructValueField{Name: "title", V: NullValue(types.Text)},
+		), &dst))
+		require.Equal(t, map[string]interface{}{
+			"id":    uint64(1),
+			"tags":  []string{"a"},
+			"title": nil,
+		}, dst)
+	})
+	t.Run("null", func(t *testing.T) {
+		dst := 1
+		require.NoError(t, ToGo(NullValue(types.Int32), &dst))
+		require.Equal(t, 0, dst)
+	})
+	t.Run("tuple", func(t *testing.T) {
+		var dst [2]interface{}
+		require.NoError(t, ToGo(TupleValue(Int32Value(1), TextValue("a")), &dst))
+		require.Equal(t, [2]interface{}{int32(1), "a"}, dst)
+	})
+	t.Run("decimal", func(t *testing.T) {
+		var dst string
+		require.NoError(t, ToGo(DecimalValueFromBigInt(big.NewInt(-12345), 22, 2), &dst))
+		require.Equal(t, "-123.45", dst)
+	})
+	t.Run("scanner", func(t *testing.T) {
+		var dst testGoConverter
+		require.NoError(t, ToGo(TextValue("a"), &dst))
+		require.Equal(t, "a", dst.s)
+	})
+	t.Run("embedded struct", func(t *testing.T) {
+		src := testGoEmbedded{
+			testGoAudit: testGoAudit{Created: created, Updated: created.Add(time.Hour)},
+			ID:          1,
+			Updated:     created.Add(time.Minute),
+		}
+		v, err := FromGo(src)
+		require.NoError(t, err)
+		require.Equal(t, "Struct<'created':Timestamp,'id':Uint64,'modified':Timestamp,'updated':Timestamp>", v.Type().Yql())
+		typ, err := TypeFromGo(reflect.TypeOf(src))
+		require.NoError(t, err)
+		require.True(t, types.Equal(typ, v.Type()), typ.Yql())
+		var dst testGoEmbedded
+		require.NoError(t, ToGo(v, &dst))
+		require.Equal(t, src, dst)
+	})
+}
+
+func TestToGoErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		v    Value
+		dst  interface{}
+	}{
+		{name: "not a pointer", v: Int32Value(1), dst: 1},
+		{name: "overflow", v: Int32Value(1000), dst: new(int8)},
+		{name: "list into int", v: ListValue(Int32Value(1)), dst: new(int)},
+		{name: "array length", v: ListValue(Int32Value(1)), dst: new([2]int32)},
+		{
+			name: "unknown member",
+			v:    StructValue(StructValueField{Name: "unknown", V: Int32Value(1)}),
+			dst:  new(testGoRow),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, ToGo(tt.v, tt.dst), errToGo)
+		})
+	}
+}
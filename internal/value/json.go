@@ -0,0 +1,906 @@
+package value
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errJSON = errors.New("json")
+
+// MarshalJSON returns JSON representation of value:
+//
+//   - Bool, Int8, Int16, Int32, Uint8, Uint16, Uint32 - JSON booleans and numbers
+//   - Int64, Uint64 - JSON strings with decimal number (safe for JavaScript clients)
+//   - Float, Double - JSON numbers, non-finite values as strings "NaN", "Infinity" and "-Infinity"
+//   - Decimal, DyNumber - JSON strings with decimal number
+//   - String (bytes), Yson - JSON strings with base64 (standard encoding)
+//   - Utf8, Uuid, TzDate, TzDatetime, TzTimestamp - JSON strings
+//   - Json, JsonDocument - embedded JSON values
+//   - Date, Date32 - JSON strings with ISO 8601 date (2006-01-02)
+//   - Datetime, Datetime64 - JSON strings with ISO 8601 date and time in UTC (2006-01-02T15:04:05Z)
+//   - Timestamp, Timestamp64 - JSON strings with ISO 8601 date and time in UTC with microseconds
+//   - Interval, Interval64 - JSON strings with ISO 8601 duration (-P1DT2H3M4.000005S)
+//   - Optional - null for null value, otherwise JSON representation of inner value.
+//     Non-null value of Optional<Optional<T>> wraps into JSON array with single item
+//   - List, Set, Tuple - JSON arrays
+//   - Struct - JSON objects with struct members in order of struct type
+//   - Dict - JSON arrays of key-value pairs ([[key, value], ...])
+//   - Variant - JSON arrays with two items: name of member (for variant over struct)
+//     or index of item (for variant over tuple) and value of alternative
+//   - Void, Null - null
+//   - Tagged - JSON representation of inner value
+func MarshalJSON(v Value) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, v); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) {
+	b, _ := json.Marshal(s) //nolint:errchkjson
+	buf.Write(b)
+}
+
+func writeJSONFloat(buf *bytes.Buffer, f float64, bitSize int) {
+	switch {
+	case math.IsNaN(f):
+		buf.WriteString(`"NaN"`)
+	case math.IsInf(f, 1):
+		buf.WriteString(`"Infinity"`)
+	case math.IsInf(f, -1):
+		buf.WriteString(`"-Infinity"`)
+	default:
+		buf.WriteString(strconv.FormatFloat(f, 'g', -1, bitSize))
+	}
+}
+
+//nolint:funlen,gocyclo
+func writeJSON(buf *bytes.Buffer, v Value) error {
+	switch vv := v.(type) {
+	case boolValue:
+		buf.WriteString(strconv.FormatBool(bool(vv)))
+	case int8Value:
+		buf.WriteString(strconv.FormatInt(int64(vv), 10))
+	case int16Value:
+		buf.WriteString(strconv.FormatInt(int64(vv), 10))
+	case int32Value:
+		buf.WriteString(strconv.FormatInt(int64(vv), 10))
+	case int64Value:
+		writeJSONString(buf, strconv.FormatInt(int64(vv), 10))
+	case uint8Value:
+		buf.WriteString(strconv.FormatUint(uint64(vv), 10))
+	case uint16Value:
+		buf.WriteString(strconv.FormatUint(uint64(vv), 10))
+	case uint32Value:
+		buf.WriteString(strconv.FormatUint(uint64(vv), 10))
+	case uint64Value:
+		writeJSONString(buf, strconv.FormatUint(uint64(vv), 10))
+	case *floatValue:
+		writeJSONFloat(buf, float64(vv.value), 32)
+	case *doubleValue:
+		writeJSONFloat(buf, vv.value, 64)
+	case *decimalValue:
+		writeJSONString(buf, decimal.Format(
+			decimal.FromInt128(vv.value, vv.Precision(), vv.Scale()), vv.Precision(), vv.Scale(),
+		))
+	case dyNumberValue:
+		writeJSONString(buf, string(vv))
+	case bytesValue:
+		writeJSONString(buf, base64.StdEncoding.EncodeToString(vv))
+	case ysonValue:
+		writeJSONString(buf, base64.StdEncoding.EncodeToString(vv))
+	case textValue:
+		writeJSONString(buf, string(vv))
+	case *uuidValue:
+		writeJSONString(buf, uuid.UUID(vv.value).String())
+	case tzDateValue:
+		writeJSONString(buf, string(vv))
+	case tzDatetimeValue:
+		writeJSONString(buf, string(vv))
+	case tzTimestampValue:
+		writeJSONString(buf, string(vv))
+	case jsonValue:
+		return writeJSONRaw(buf, string(vv))
+	case jsonDocumentValue:
+		return writeJSONRaw(buf, string(vv))
+	case dateValue:
+		writeJSONString(buf, DateToTime(uint32(vv)).UTC().Format(LayoutDate))
+	case date32Value:
+		writeJSONString(buf, Date32ToTime(int32(vv)).UTC().Format(LayoutDate))
+	case datetimeValue:
+		writeJSONString(buf, DatetimeToTime(uint32(vv)).UTC().Format(LayoutDatetime))
+	case datetime64Value:
+		writeJSONString(buf, Datetime64ToTime(int64(vv)).UTC().Format(LayoutDatetime))
+	case timestampValue:
+		writeJSONString(buf, TimestampToTime(uint64(vv)).UTC().Format(LayoutTimestamp))
+	case timestamp64Value:
+		writeJSONString(buf, Timestamp64ToTime(int64(vv)).UTC().Format(LayoutTimestamp))
+	case intervalValue:
+		writeJSONString(buf, formatISO8601Duration(int64(vv)))
+	case interval64Value:
+		writeJSONString(buf, formatISO8601Duration(int64(vv)))
+	case *optionalValue:
+		return writeJSONOptional(buf, vv)
+	case *listValue:
+		return writeJSONArray(buf, vv.items)
+	case *setValue:
+		return writeJSONArray(buf, vv.items)
+	case *tupleValue:
+		return writeJSONArray(buf, vv.items)
+	case *structValue:
+		return writeJSONStruct(buf, vv)
+	case *dictValue:
+		return writeJSONDict(buf, vv)
+	case *variantValue:
+		return writeJSONVariant(buf, vv)
+	case voidValue:
+		buf.WriteString("null")
+	case pgValue:
+		if vv.null {
+			buf.WriteString("null")
+		} else {
+			writeJSONString(buf, vv.val)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported value %T of type %s", errJSON, v, v.Type().Yql())
+	}
+
+	return nil
+}
+
+func writeJSONRaw(buf *bytes.Buffer, s string) error {
+	if !json.Valid([]byte(s)) {
+		return fmt.Errorf("%w: invalid JSON value %q", errJSON, s)
+	}
+	buf.WriteString(s)
+
+	return nil
+}
+
+func writeJSONOptional(buf *bytes.Buffer, v *optionalValue) error {
+	if v.value == nil {
+		buf.WriteString("null")
+
+		return nil
+	}
+	if _, isOptional := v.value.Type().(types.Optional); !isOptional {
+		return writeJSON(buf, v.value)
+	}
+	buf.WriteByte('[')
+	if err := writeJSON(buf, v.value); err != nil {
+		return err
+	}
+	buf.WriteByte(']')
+
+	return nil
+}
+
+func writeJSONArray(buf *bytes.Buffer, items []Value) error {
+	buf.WriteByte('[')
+	for i, item := range items {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeJSON(buf, item); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+
+	return nil
+}
+
+func writeJSONStruct(buf *bytes.Buffer, v *structValue) error {
+	buf.WriteByte('{')
+	for i := range v.fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeJSONString(buf, v.fields[i].Name)
+		buf.WriteByte(':')
+		if err := writeJSON(buf, v.fields[i].V); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+
+	return nil
+}
+
+func writeJSONDict(buf *bytes.Buffer, v *dictValue) error {
+	buf.WriteByte('[')
+	for i := range v.values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('[')
+		if err := writeJSON(buf, v.values[i].K); err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+		if err := writeJSON(buf, v.values[i].V); err != nil {
+			return err
+		}
+		buf.WriteByte(']')
+	}
+	buf.WriteByte(']')
+
+	return nil
+}
+
+func writeJSONVariant(buf *bytes.Buffer, v *variantValue) error {
+	buf.WriteByte('[')
+	name, idx := v.Variant()
+	if _, isStruct := v.innerType.(*types.VariantStruct); isStruct {
+		writeJSONString(buf, name)
+	} else {
+		buf.WriteString(strconv.FormatUint(uint64(idx), 10))
+	}
+	buf.WriteByte(',')
+	if err := writeJSON(buf, v.value); err != nil {
+		return err
+	}
+	buf.WriteByte(']')
+
+	return nil
+}
+
+// formatISO8601Duration formats interval in microseconds as ISO 8601 duration
+func formatISO8601Duration(us int64) string {
+	var sb strings.Builder
+	abs := uint64(us)
+	if us < 0 {
+		sb.WriteByte('-')
+		abs = uint64(-us)
+	}
+	sb.WriteByte('P')
+	const (
+		usPerSecond = uint64(time.Second / time.Microsecond)
+		usPerMinute = 60 * usPerSecond
+		usPerHour   = 60 * usPerMinute
+		usPerDay    = 24 * usPerHour
+	)
+	if days := abs / usPerDay; days > 0 {
+		abs -= days * usPerDay
+		sb.WriteString(strconv.FormatUint(days, 10))
+		sb.WriteByte('D')
+	}
+	if abs == 0 {
+		if sb.Len() == 1 || (sb.Len() == 2 && us < 0) {
+			sb.WriteString("T0S")
+		}
+
+		return sb.String()
+	}
+	sb.WriteByte('T')
+	if hours := abs / usPerHour; hours > 0 {
+		abs -= hours * usPerHour
+		sb.WriteString(strconv.FormatUint(hours, 10))
+		sb.WriteByte('H')
+	}
+	if minutes := abs / usPerMinute; minutes > 0 {
+		abs -= minutes * usPerMinute
+		sb.WriteString(strconv.FormatUint(minutes, 10))
+		sb.WriteByte('M')
+	}
+	if abs > 0 {
+		sb.WriteString(strconv.FormatUint(abs/usPerSecond, 10))
+		if frac := abs % usPerSecond; frac > 0 {
+			fmt.Fprintf(&sb, ".%06d", frac)
+		}
+		sb.WriteByte('S')
+	}
+
+	return sb.String()
+}
+
+// parseISO8601Duration parses ISO 8601 duration with days, hours, minutes and seconds (with fraction up to
+// microseconds) and returns interval in microseconds
+func parseISO8601Duration(s string) (int64, error) {
+	src := s
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) == 1 {
+		return 0, fmt.Errorf("%w: invalid ISO 8601 duration %q", errJSON, src)
+	}
+	s = s[1:]
+	var (
+		us     uint64
+		inTime bool
+	)
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return 0, fmt.Errorf("%w: invalid ISO 8601 duration %q", errJSON, src)
+			}
+			inTime = true
+			s = s[1:]
+
+			continue
+		}
+		end := strings.IndexAny(s, "DHMS")
+		if end <= 0 {
+			return 0, fmt.Errorf("%w: invalid ISO 8601 duration %q", errJSON, src)
+		}
+		number, unit := s[:end], s[end]
+		s = s[end+1:]
+		var frac uint64
+		if dot := strings.IndexByte(number, '.'); dot >= 0 {
+			if unit != 'S' || len(number)-dot-1 > 6 || len(number)-dot-1 == 0 {
+				return 0, fmt.Errorf("%w: invalid ISO 8601 duration %q", errJSON, src)
+			}
+			fracDigits := number[dot+1:]
+			f, err := strconv.ParseUint(fracDigits+strings.Repeat("0", 6-len(fracDigits)), 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("%w: invalid ISO 8601 duration %q: %w", errJSON, src, err)
+			}
+			frac, number = f, number[:dot]
+		}
+		n, err := strconv.ParseUint(number, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid ISO 8601 duration %q: %w", errJSON, src, err)
+		}
+		var multiplier uint64
+		switch {
+		case unit == 'D' && !inTime:
+			multiplier = uint64(24 * time.Hour / time.Microsecond)
+		case unit == 'H' && inTime:
+			multiplier = uint64(time.Hour / time.Microsecond)
+		case unit == 'M' && inTime:
+			multiplier = uint64(time.Minute / time.Microsecond)
+		case unit == 'S' && inTime:
+			multiplier = uint64(time.Second / time.Microsecond)
+		default:
+			return 0, fmt.Errorf("%w: invalid ISO 8601 duration %q", errJSON, src)
+		}
+		if n > (math.MaxInt64-us-frac)/multiplier {
+			return 0, fmt.Errorf("%w: ISO 8601 duration %q overflows", errJSON, src)
+		}
+		us += n*multiplier + frac
+	}
+	if neg {
+		return -int64(us), nil
+	}
+
+	return int64(us), nil
+}
+
+// UnmarshalJSON makes value of given type from JSON representation (see MarshalJSON)
+func UnmarshalJSON(t types.Type, data []byte) (Value, error) {
+	v, err := fromJSON(t, bytes.TrimSpace(data))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return v, nil
+}
+
+func isJSONNull(data []byte) bool {
+	return bytes.Equal(data, []byte("null"))
+}
+
+func unmarshalJSONTo(t types.Type, data []byte, dst interface{}) error {
+	if err := json.Unmarshal(data, dst); err != nil {
+		return fmt.Errorf("%w: cannot unmarshal %s into %s: %w", errJSON, data, t.Yql(), err)
+	}
+
+	return nil
+}
+
+// jsonString unmarshals JSON string
+func jsonString(t types.Type, data []byte) (s string, err error) {
+	return s, unmarshalJSONTo(t, data, &s)
+}
+
+// jsonInteger unmarshals integer from JSON number or JSON string with number
+func jsonInteger(t types.Type, data []byte, bitSize int, signed bool) (i int64, u uint64, err error) {
+	var n json.Number
+	if data[0] == '"' {
+		var s string
+		if err = unmarshalJSONTo(t, data, &s); err != nil {
+			return 0, 0, err
+		}
+		n = json.Number(s)
+	} else if err = unmarshalJSONTo(t, data, &n); err != nil {
+		return 0, 0, err
+	}
+	if signed {
+		i, err = strconv.ParseInt(n.String(), 10, bitSize)
+	} else {
+		u, err = strconv.ParseUint(n.String(), 10, bitSize)
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: cannot unmarshal %s into %s: %w", errJSON, data, t.Yql(), err)
+	}
+
+	return i, u, nil
+}
+
+func jsonFloat(t types.Type, data []byte, bitSize int) (float64, error) {
+	if data[0] == '"' {
+		s, err := jsonString(t, data)
+		if err != nil {
+			return 0, err
+		}
+		switch s {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+
+		return 0, fmt.Errorf("%w: cannot unmarshal %s into %s", errJSON, data, t.Yql())
+	}
+	var n json.Number
+	if err := unmarshalJSONTo(t, data, &n); err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(n.String(), bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%w: cannot unmarshal %s into %s: %w", errJSON, data, t.Yql(), err)
+	}
+
+	return f, nil
+}
+
+func jsonBase64(t types.Type, data []byte) ([]byte, error) {
+	s, err := jsonString(t, data)
+	if err != nil {
+		return nil, err
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot unmarshal %s into %s: %w", errJSON, data, t.Yql(), err)
+	}
+
+	return b, nil
+}
+
+func jsonTime(t types.Type, data []byte, layout string) (time.Time, error) {
+	s, err := jsonString(t, data)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if layout == LayoutTimestamp {
+		// accepts timestamps with any number of digits of fraction of second
+		layout = time.RFC3339Nano
+	}
+	tt, err := time.Parse(layout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: cannot unmarshal %s into %s: %w", errJSON, data, t.Yql(), err)
+	}
+
+	return tt, nil
+}
+
+func jsonArray(t types.Type, data []byte) (items []json.RawMessage, err error) {
+	return items, unmarshalJSONTo(t, data, &items)
+}
+
+func fromJSON(t types.Type, data []byte) (Value, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty JSON for %s", errJSON, t.Yql())
+	}
+	switch tt := t.(type) {
+	case types.Primitive:
+		if isJSONNull(data) && tt != types.JSON && tt != types.JSONDocument {
+			return nil, fmt.Errorf("%w: null for non-optional type %s", errJSON, t.Yql())
+		}
+
+		return primitiveFromJSON(tt, data)
+	case *types.Decimal:
+		s, err := jsonString(t, data)
+		if err != nil {
+			return nil, err
+		}
+		v, err := decimal.Parse(s, tt.Precision(), tt.Scale())
+		if err != nil {
+			return nil, fmt.Errorf("%w: cannot unmarshal %s into %s: %w", errJSON, data, t.Yql(), err)
+		}
+
+		return DecimalValueFromBigInt(v, tt.Precision(), tt.Scale()), nil
+	case types.Optional:
+		return optionalFromJSON(tt, data)
+	case *types.List:
+		items, err := itemsFromJSON(t, tt.ItemType(), data)
+		if err != nil {
+			return nil, err
+		}
+
+		return &listValue{t: t, items: items}, nil
+	case *types.Set:
+		items, err := itemsFromJSON(t, tt.ItemType(), data)
+		if err != nil {
+			return nil, err
+		}
+
+		return &setValue{t: t, items: items}, nil
+	case *types.Tuple:
+		return tupleFromJSON(tt, data)
+	case *types.Struct:
+		return structFromJSON(tt, data)
+	case *types.Dict:
+		return dictFromJSON(tt, data)
+	case *types.VariantStruct, *types.VariantTuple:
+		return variantFromJSON(t, data)
+	case types.Void, types.Null:
+		if !isJSONNull(data) {
+			return nil, fmt.Errorf("%w: cannot unmarshal %s into %s", errJSON, data, t.Yql())
+		}
+		if _, isVoid := tt.(types.Void); isVoid {
+			return VoidValue(), nil
+		}
+
+		return NullValue(types.NewVoid()), nil
+	case types.EmptyList:
+		return emptyFromJSON(t, data, ListValue())
+	case types.EmptyDict:
+		return emptyFromJSON(t, data, DictValue())
+	case *types.Tagged:
+		return fromJSON(tt.InnerType(), data)
+	case types.PgType:
+		if isJSONNull(data) {
+			return PgNullValue(tt.OID), nil
+		}
+		s, err := jsonString(t, data)
+		if err != nil {
+			return nil, err
+		}
+
+		return PgValue(tt.OID, s), nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported type %s", errJSON, t.Yql())
+	}
+}
+
+//nolint:funlen,gocyclo
+func primitiveFromJSON(t types.Primitive, data []byte) (Value, error) {
+	switch t {
+	case types.Bool:
+		var b bool
+		if err := unmarshalJSONTo(t, data, &b); err != nil {
+			return nil, err
+		}
+
+		return BoolValue(b), nil
+	case types.Int8, types.Int16, types.Int32, types.Int64:
+		bitSize := map[types.Primitive]int{types.Int8: 8, types.Int16: 16, types.Int32: 32, types.Int64: 64}[t]
+		i, _, err := jsonInteger(t, data, bitSize, true)
+		if err != nil {
+			return nil, err
+		}
+		switch t {
+		case types.Int8:
+			return Int8Value(int8(i)), nil
+		case types.Int16:
+			return Int16Value(int16(i)), nil
+		case types.Int32:
+			return Int32Value(int32(i)), nil
+		default:
+			return Int64Value(i), nil
+		}
+	case types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		bitSize := map[types.Primitive]int{types.Uint8: 8, types.Uint16: 16, types.Uint32: 32, types.Uint64: 64}[t]
+		_, u, err := jsonInteger(t, data, bitSize, false)
+		if err != nil {
+			return nil, err
+		}
+		switch t {
+		case types.Uint8:
+			return Uint8Value(uint8(u)), nil
+		case types.Uint16:
+			return Uint16Value(uint16(u)), nil
+		case types.Uint32:
+			return Uint32Value(uint32(u)), nil
+		default:
+			return Uint64Value(u), nil
+		}
+	case types.Float:
+		f, err := jsonFloat(t, data, 32)
+		if err != nil {
+			return nil, err
+		}
+
+		return FloatValue(float32(f)), nil
+	case types.Double:
+		f, err := jsonFloat(t, data, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		return DoubleValue(f), nil
+	case types.Bytes, types.YSON:
+		b, err := jsonBase64(t, data)
+		if err != nil {
+			return nil, err
+		}
+		if t == types.YSON {
+			return YSONValue(b), nil
+		}
+
+		return BytesValue(b), nil
+	case types.JSON:
+		if !json.Valid(data) {
+			return nil, fmt.Errorf("%w: invalid JSON %s", errJSON, data)
+		}
+
+		return JSONValue(string(data)), nil
+	case types.JSONDocument:
+		if !json.Valid(data) {
+			return nil, fmt.Errorf("%w: invalid JSON %s", errJSON, data)
+		}
+
+		return JSONDocumentValue(string(data)), nil
+	case types.UUID:
+		s, err := jsonString(t, data)
+		if err != nil {
+			return nil, err
+		}
+		u, err := uuid.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("%w: cannot unmarshal %s into %s: %w", errJSON, data, t.Yql(), err)
+		}
+
+		return UUIDValue(u), nil
+	case types.Date, types.Date32:
+		tt, err := jsonTime(t, data, LayoutDate)
+		if err != nil {
+			return nil, err
+		}
+		if t == types.Date32 {
+			return Date32ValueFromTime(tt), nil
+		}
+
+		return DateValueFromTime(tt), nil
+	case types.Datetime, types.Datetime64:
+		tt, err := jsonTime(t, data, time.RFC3339)
+		if err != nil {
+			return nil, err
+		}
+		if t == types.Datetime64 {
+			return Datetime64ValueFromTime(tt), nil
+		}
+
+		return DatetimeValueFromTime(tt), nil
+	case types.Timestamp, types.Timestamp64:
+		tt, err := jsonTime(t, data, LayoutTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		if t == types.Timestamp64 {
+			return Timestamp64ValueFromTime(tt), nil
+		}
+
+		return TimestampValueFromTime(tt), nil
+	case types.Interval, types.Interval64:
+		s, err := jsonString(t, data)
+		if err != nil {
+			return nil, err
+		}
+		us, err := parseISO8601Duration(s)
+		if err != nil {
+			return nil, err
+		}
+		if t == types.Interval64 {
+			return Interval64Value(us), nil
+		}
+
+		return IntervalValue(us), nil
+	default:
+		s, err := jsonString(t, data)
+		if err != nil {
+			return nil, err
+		}
+		switch t {
+		case types.Text:
+			return TextValue(s), nil
+		case types.DyNumber:
+			return DyNumberValue(s), nil
+		case types.TzDate:
+			return TzDateValue(s), nil
+		case types.TzDatetime:
+			return TzDatetimeValue(s), nil
+		case types.TzTimestamp:
+			return TzTimestampValue(s), nil
+		default:
+			return nil, fmt.Errorf("%w: unsupported type %s", errJSON, t.Yql())
+		}
+	}
+}
+
+func optionalFromJSON(t types.Optional, data []byte) (Value, error) {
+	if isJSONNull(data) {
+		return NullValue(t.InnerType()), nil
+	}
+	if _, isOptional := t.InnerType().(types.Optional); isOptional {
+		items, err := jsonArray(t, data)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != 1 {
+			return nil, fmt.Errorf("%w: expected array with single item for %s instead of %s",
+				errJSON, t.Yql(), data,
+			)
+		}
+		data = items[0]
+	}
+	v, err := fromJSON(t.InnerType(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	return OptionalValue(v), nil
+}
+
+func itemsFromJSON(t, itemType types.Type, data []byte) ([]Value, error) {
+	raw, err := jsonArray(t, data)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]Value, len(raw))
+	for i := range raw {
+		items[i], err = fromJSON(itemType, raw[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+func tupleFromJSON(t *types.Tuple, data []byte) (Value, error) {
+	raw, err := jsonArray(t, data)
+	if err != nil {
+		return nil, err
+	}
+	itemTypes := t.InnerTypes()
+	if len(raw) != len(itemTypes) {
+		return nil, fmt.Errorf("%w: expected %d items for %s instead of %d",
+			errJSON, len(itemTypes), t.Yql(), len(raw),
+		)
+	}
+	items := make([]Value, len(raw))
+	for i := range raw {
+		items[i], err = fromJSON(itemTypes[i], raw[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &tupleValue{t: t, items: items}, nil
+}
+
+func structFromJSON(t *types.Struct, data []byte) (Value, error) {
+	var raw map[string]json.RawMessage
+	if err := unmarshalJSONTo(t, data, &raw); err != nil {
+		return nil, err
+	}
+	fields := make([]StructValueField, 0, len(t.Fields()))
+	for _, f := range t.Fields() {
+		fieldData, has := raw[f.Name]
+		if !has {
+			if _, isOptional := f.T.(types.Optional); !isOptional {
+				return nil, fmt.Errorf("%w: member '%s' of %s not found in %s", errJSON, f.Name, t.Yql(), data)
+			}
+			fieldData = []byte("null")
+		}
+		delete(raw, f.Name)
+		v, err := fromJSON(f.T, fieldData)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, StructValueField{
+			Name: f.Name,
+			V:    v,
+		})
+	}
+	for name := range raw {
+		return nil, fmt.Errorf("%w: unknown member '%s' of %s", errJSON, name, t.Yql())
+	}
+
+	return &structValue{t: t, fields: fields}, nil
+}
+
+func dictFromJSON(t *types.Dict, data []byte) (Value, error) {
+	raw, err := jsonArray(t, data)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]DictValueField, len(raw))
+	for i := range raw {
+		var pair []json.RawMessage
+		if err = unmarshalJSONTo(t, raw[i], &pair); err != nil {
+			return nil, err
+		}
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("%w: expected key-value pair for %s instead of %s", errJSON, t.Yql(), raw[i])
+		}
+		values[i].K, err = fromJSON(t.KeyType(), pair[0])
+		if err != nil {
+			return nil, err
+		}
+		values[i].V, err = fromJSON(t.ValueType(), pair[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &dictValue{t: t, values: values}, nil
+}
+
+func variantFromJSON(t types.Type, data []byte) (Value, error) {
+	raw, err := jsonArray(t, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != 2 {
+		return nil, fmt.Errorf("%w: expected alternative and value for %s instead of %s", errJSON, t.Yql(), data)
+	}
+	switch tt := t.(type) {
+	case *types.VariantStruct:
+		name, err := jsonString(t, raw[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range tt.Fields() {
+			if f.Name == name {
+				v, err := fromJSON(f.T, raw[1])
+				if err != nil {
+					return nil, err
+				}
+
+				return VariantValueStruct(v, name, t), nil
+			}
+		}
+
+		return nil, fmt.Errorf("%w: alternative '%s' not found in %s", errJSON, name, t.Yql())
+	case *types.VariantTuple:
+		_, idx, err := jsonInteger(t, raw[0], 32, false)
+		if err != nil {
+			return nil, err
+		}
+		itemTypes := tt.InnerTypes()
+		if idx >= uint64(len(itemTypes)) {
+			return nil, fmt.Errorf("%w: alternative %d not found in %s", errJSON, idx, t.Yql())
+		}
+		v, err := fromJSON(itemTypes[idx], raw[1])
+		if err != nil {
+			return nil, err
+		}
+
+		return VariantValueTuple(v, uint32(idx), t), nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported type %s", errJSON, t.Yql())
+	}
+}
+
+func emptyFromJSON(t types.Type, data []byte, v Value) (Value, error) {
+	raw, err := jsonArray(t, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != 0 {
+		return nil, fmt.Errorf("%w: expected empty array for %s instead of %s", errJSON, t.Yql(), data)
+	}
+
+	return v, nil
+}
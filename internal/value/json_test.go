@@ -0,0 +1,192 @@
+package value
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	for _, tt := range []struct {
+		v   Value
+		exp string
+	}{
+		{v: BoolValue(true), exp: `true`},
+		{v: Int8Value(-8), exp: `-8`},
+		{v: Uint32Value(32), exp: `32`},
+		{v: Int64Value(math.MinInt64), exp: `"-9223372036854775808"`},
+		{v: Uint64Value(math.MaxUint64), exp: `"18446744073709551615"`},
+		{v: FloatValue(1.5), exp: `1.5`},
+		{v: DoubleValue(math.Inf(-1)), exp: `"-Infinity"`},
+		{v: DoubleValue(math.NaN()), exp: `"NaN"`},
+		{v: DecimalValueFromBigInt(big.NewInt(-12345000000), 22, 9), exp: `"-12.345000000"`},
+		{v: DyNumberValue("1E2"), exp: `"1E2"`},
+		{v: BytesValue([]byte("test")), exp: `"dGVzdA=="`},
+		{v: YSONValue([]byte("{a=1}")), exp: `"e2E9MX0="`},
+		{v: TextValue("\"test\"\n"), exp: `"\"test\"\n"`},
+		{v: JSONValue(`{"a": [1, 2]}`), exp: `{"a": [1, 2]}`},
+		{v: JSONDocumentValue(`null`), exp: `null`},
+		{
+			v:   UUIDValue(uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")),
+			exp: `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+		},
+		{v: DateValue(1), exp: `"1970-01-02"`},
+		{v: Date32Value(-1), exp: `"1969-12-31"`},
+		{v: DatetimeValue(3661), exp: `"1970-01-01T01:01:01Z"`},
+		{v: Datetime64Value(-1), exp: `"1969-12-31T23:59:59Z"`},
+		{v: TimestampValue(1), exp: `"1970-01-01T00:00:00.000001Z"`},
+		{v: Timestamp64Value(-1), exp: `"1969-12-31T23:59:59.999999Z"`},
+		{v: IntervalValue(0), exp: `"PT0S"`},
+		{v: IntervalValue(-(24*3600+2*3600+3*60+4)*1000000 - 5), exp: `"-P1DT2H3M4.000005S"`},
+		{v: Interval64Value(2 * 24 * 3600 * 1000000), exp: `"P2D"`},
+		{v: TzDateValue("2020-01-01,Europe/Berlin"), exp: `"2020-01-01,Europe/Berlin"`},
+		{v: VoidValue(), exp: `null`},
+		{v: NullValue(types.Int32), exp: `null`},
+		{v: OptionalValue(Int32Value(1)), exp: `1`},
+		{v: OptionalValue(NullValue(types.Int32)), exp: `[null]`},
+		{v: OptionalValue(OptionalValue(Int32Value(1))), exp: `[1]`},
+		{v: ListValue(Int32Value(1), Int32Value(2)), exp: `[1,2]`},
+		{v: ListValue(), exp: `[]`},
+		{v: SetValue(TextValue("a")), exp: `["a"]`},
+		{v: TupleValue(Int32Value(1), TextValue("a")), exp: `[1,"a"]`},
+		{
+			v: StructValue(
+				StructValueField{Name: "id", V: Uint64Value(1)},
+				StructValueField{Name: "title", V: NullValue(types.Text)},
+			),
+			exp: `{"id":"1","title":null}`,
+		},
+		{
+			v: DictValue(
+				DictValueField{K: TextValue("a"), V: Int32Value(1)},
+				DictValueField{K: TextValue("b"), V: Int32Value(2)},
+			),
+			exp: `[["a",1],["b",2]]`,
+		},
+		{v: DictValue(), exp: `[]`},
+		{
+			v:   VariantValueTuple(TextValue("test"), 1, types.NewTuple(types.Int32, types.Text)),
+			exp: `[1,"test"]`,
+		},
+		{
+			v: VariantValueStruct(TextValue("test"), "b", types.NewStruct(
+				types.StructField{Name: "a", T: types.Int32},
+				types.StructField{Name: "b", T: types.Text},
+			)),
+			exp: `["b","test"]`,
+		},
+	} {
+		t.Run(tt.v.Yql(), func(t *testing.T) {
+			b, err := MarshalJSON(tt.v)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, string(b))
+			if f, ok := tt.v.(*doubleValue); ok && math.IsNaN(f.value) {
+				return
+			}
+			v, err := UnmarshalJSON(tt.v.Type(), b)
+			require.NoError(t, err)
+			require.Equal(t, tt.v.Yql(), v.Yql())
+			require.True(t, types.Equal(tt.v.Type(), v.Type()), v.Type().Yql())
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	for _, tt := range []struct {
+		t    types.Type
+		data string
+		exp  Value
+	}{
+		{t: types.Int64, data: `-1`, exp: Int64Value(-1)},
+		{t: types.Uint64, data: ` "1" `, exp: Uint64Value(1)},
+		{t: types.Uint8, data: `"255"`, exp: Uint8Value(255)},
+		{t: types.Timestamp, data: `"1970-01-01T00:00:01Z"`, exp: TimestampValue(1000000)},
+		{t: types.Datetime, data: `"1970-01-01T03:00:01+03:00"`, exp: DatetimeValue(1)},
+		{t: types.Interval, data: `"PT1.5S"`, exp: IntervalValue(1500000)},
+		{t: types.NewList(types.Int32), data: `[]`, exp: ZeroValue(types.NewList(types.Int32))},
+		{
+			t: types.NewStruct(
+				types.StructField{Name: "id", T: types.Uint64},
+				types.StructField{Name: "title", T: types.NewOptional(types.Text)},
+			),
+			data: `{"id":1}`,
+			exp: StructValue(
+				StructValueField{Name: "id", V: Uint64Value(1)},
+				StructValueField{Name: "title", V: NullValue(types.Text)},
+			),
+		},
+		{
+			t:    types.NewTagged(types.Int32, "tag"),
+			data: `1`,
+			exp:  Int32Value(1),
+		},
+	} {
+		t.Run(tt.t.Yql()+" "+tt.data, func(t *testing.T) {
+			v, err := UnmarshalJSON(tt.t, []byte(tt.data))
+			require.NoError(t, err)
+			require.Equal(t, tt.exp.Yql(), v.Yql())
+		})
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	for _, tt := range []struct {
+		t    types.Type
+		data string
+	}{
+		{t: types.Int32, data: ``},
+		{t: types.Int32, data: `null`},
+		{t: types.Int8, data: `128`},
+		{t: types.Uint32, data: `-1`},
+		{t: types.Int32, data: `1.5`},
+		{t: types.Bool, data: `"true"`},
+		{t: types.Bytes, data: `"not base64"`},
+		{t: types.JSON, data: `{`},
+		{t: types.UUID, data: `"abc"`},
+		{t: types.Date, data: `"2020-13-01"`},
+		{t: types.Interval, data: `"P1H"`},
+		{t: types.Interval, data: `"PT1.1234567S"`},
+		{t: types.Interval64, data: `"P999999999999999999D"`},
+		{t: types.NewDecimal(22, 9), data: `"1.2.3"`},
+		{t: types.NewOptional(types.NewOptional(types.Int32)), data: `1`},
+		{t: types.NewList(types.Int32), data: `{}`},
+		{t: types.NewTuple(types.Int32, types.Text), data: `[1]`},
+		{t: types.NewStruct(types.StructField{Name: "id", T: types.Int32}), data: `{}`},
+		{t: types.NewStruct(types.StructField{Name: "id", T: types.Int32}), data: `{"id":1,"name":"a"}`},
+		{t: types.NewDict(types.Text, types.Int32), data: `[["a"]]`},
+		{t: types.NewVariantTuple(types.Int32), data: `[1,1]`},
+		{t: types.NewVariantStruct(types.StructField{Name: "a", T: types.Int32}), data: `["b",1]`},
+		{t: types.NewEmptyList(), data: `[1]`},
+		{t: types.NewVoid(), data: `1`},
+	} {
+		t.Run(tt.t.Yql()+" "+tt.data, func(t *testing.T) {
+			_, err := UnmarshalJSON(tt.t, []byte(tt.data))
+			require.ErrorIs(t, err, errJSON)
+		})
+	}
+}
+
+func TestISO8601Duration(t *testing.T) {
+	for _, d := range []time.Duration{
+		0,
+		time.Microsecond,
+		-time.Microsecond,
+		time.Second,
+		time.Minute + time.Millisecond,
+		25*time.Hour + 100*time.Microsecond,
+		-48 * time.Hour,
+	} {
+		t.Run(d.String(), func(t *testing.T) {
+			us := d.Microseconds()
+			parsed, err := parseISO8601Duration(formatISO8601Duration(us))
+			require.NoError(t, err)
+			require.Equal(t, us, parsed)
+		})
+	}
+}
@@ -208,6 +208,70 @@ func NullableIntervalValueFromDuration(v *time.Duration) Value {
 	return OptionalValue(IntervalValueFromDuration(*v))
 }
 
+func NullableDate32Value(v *int32) Value {
+	if v == nil {
+		return NullValue(types.Date32)
+	}
+
+	return OptionalValue(Date32Value(*v))
+}
+
+func NullableDate32ValueFromTime(v *time.Time) Value {
+	if v == nil {
+		return NullValue(types.Date32)
+	}
+
+	return OptionalValue(Date32ValueFromTime(*v))
+}
+
+func NullableDatetime64Value(v *int64) Value {
+	if v == nil {
+		return NullValue(types.Datetime64)
+	}
+
+	return OptionalValue(Datetime64Value(*v))
+}
+
+func NullableDatetime64ValueFromTime(v *time.Time) Value {
+	if v == nil {
+		return NullValue(types.Datetime64)
+	}
+
+	return OptionalValue(Datetime64ValueFromTime(*v))
+}
+
+func NullableTimestamp64Value(v *int64) Value {
+	if v == nil {
+		return NullValue(types.Timestamp64)
+	}
+
+	return OptionalValue(Timestamp64Value(*v))
+}
+
+func NullableTimestamp64ValueFromTime(v *time.Time) Value {
+	if v == nil {
+		return NullValue(types.Timestamp64)
+	}
+
+	return OptionalValue(Timestamp64ValueFromTime(*v))
+}
+
+func NullableInterval64ValueFromMicroseconds(v *int64) Value {
+	if v == nil {
+		return NullValue(types.Interval64)
+	}
+
+	return OptionalValue(Interval64Value(*v))
+}
+
+func NullableInterval64ValueFromDuration(v *time.Duration) Value {
+	if v == nil {
+		return NullValue(types.Interval64)
+	}
+
+	return OptionalValue(Interval64ValueFromDuration(*v))
+}
+
 func NullableBytesValue(v *[]byte) Value {
 	if v == nil {
 		return NullValue(types.Bytes)
@@ -360,6 +424,42 @@ func Nullable(t types.Type, v interface{}) Value {
 		default:
 			panic(fmt.Sprintf("unsupported type conversion from %T to TypeInterval", tt))
 		}
+	case types.Date32:
+		switch tt := v.(type) {
+		case *int32:
+			return NullableDate32Value(tt)
+		case *time.Time:
+			return NullableDate32ValueFromTime(tt)
+		default:
+			panic(fmt.Sprintf("unsupported type conversion from %T to TypeDate32", tt))
+		}
+	case types.Datetime64:
+		switch tt := v.(type) {
+		case *int64:
+			return NullableDatetime64Value(tt)
+		case *time.Time:
+			return NullableDatetime64ValueFromTime(tt)
+		default:
+			panic(fmt.Sprintf("unsupported type conversion from %T to TypeDatetime64", tt))
+		}
+	case types.Timestamp64:
+		switch tt := v.(type) {
+		case *int64:
+			return NullableTimestamp64Value(tt)
+		case *time.Time:
+			return NullableTimestamp64ValueFromTime(tt)
+		default:
+			panic(fmt.Sprintf("unsupported type conversion from %T to TypeTimestamp64", tt))
+		}
+	case types.Interval64:
+		switch tt := v.(type) {
+		case *int64:
+			return NullableInterval64ValueFromMicroseconds(tt)
+		case *time.Duration:
+			return NullableInterval64ValueFromDuration(tt)
+		default:
+			panic(fmt.Sprintf("unsupported type conversion from %T to TypeInterval64", tt))
+		}
 	case types.TzDate:
 		switch tt := v.(type) {
 		case *string:
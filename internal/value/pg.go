@@ -0,0 +1,461 @@
+package value
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// pgValue is a value of postgres type in text representation
+type pgValue struct {
+	t    types.PgType
+	val  string
+	null bool
+}
+
+func (v pgValue) castTo(dst interface{}) error {
+	if err := v.castToReflect(reflect.ValueOf(dst)); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (v pgValue) errCannotCast(dst reflect.Value) error {
+	if v.null {
+		return fmt.Errorf("%w NULL (type '%s') to '%s' destination", ErrCannotCast, v.t.Yql(), dst.Type())
+	}
+
+	return fmt.Errorf("%w %q (type '%s') to '%s' destination", ErrCannotCast, v.val, v.t.Yql(), dst.Type())
+}
+
+// castToReflect stores value into destination pointer. Pointers to pointers receive nil for NULL
+//
+//nolint:gocyclo,funlen
+func (v pgValue) castToReflect(ptr reflect.Value) error {
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() {
+		return v.errCannotCast(ptr)
+	}
+	dst := ptr.Elem()
+	if v.null {
+		switch dst.Kind() {
+		case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+			dst.Set(reflect.Zero(dst.Type()))
+
+			return nil
+		default:
+			return v.errCannotCast(ptr)
+		}
+	}
+	switch x := ptr.Interface().(type) {
+	case *string:
+		*x = v.val
+
+		return nil
+	case *[]byte:
+		if v.t.OID == pg.OIDBytea {
+			b, err := pg.ParseBytea(v.val)
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrCannotCast, err)
+			}
+			*x = b
+
+			return nil
+		}
+		*x = []byte(v.val)
+
+		return nil
+	case *json.RawMessage:
+		if v.t.OID != pg.OIDJSON && v.t.OID != pg.OIDJSONB {
+			return v.errCannotCast(ptr)
+		}
+		*x = json.RawMessage(v.val)
+
+		return nil
+	case *interface{}:
+		nv, err := v.driverValue()
+		if err != nil {
+			return err
+		}
+		*x = nv
+
+		return nil
+	case *time.Time:
+		t, err := v.time()
+		if err != nil {
+			return err
+		}
+		*x = t
+
+		return nil
+	case *time.Duration:
+		if v.t.OID != pg.OIDInterval {
+			return v.errCannotCast(ptr)
+		}
+		d, err := pg.ParseInterval(v.val)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCannotCast, err)
+		}
+		*x = d
+
+		return nil
+	case *uuid.UUID:
+		if v.t.OID != pg.OIDUUID {
+			return v.errCannotCast(ptr)
+		}
+		id, err := uuid.Parse(v.val)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCannotCast, err)
+		}
+		*x = id
+
+		return nil
+	}
+	if elem, isArray := pg.ArrayElem(v.t.OID); isArray {
+		return v.castArray(elem, ptr)
+	}
+	switch dst.Kind() {
+	case reflect.Pointer:
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+
+		return v.castToReflect(dst)
+	case reflect.Bool:
+		if v.t.OID != pg.OIDBool {
+			return v.errCannotCast(ptr)
+		}
+		b, err := pg.ParseBool(v.val)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCannotCast, err)
+		}
+		dst.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !v.isInteger() {
+			return v.errCannotCast(ptr)
+		}
+		n, err := strconv.ParseInt(v.val, 10, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCannotCast, err)
+		}
+		dst.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !v.isInteger() {
+			return v.errCannotCast(ptr)
+		}
+		n, err := strconv.ParseUint(v.val, 10, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCannotCast, err)
+		}
+		dst.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		if !v.isInteger() && v.t.OID != pg.OIDFloat4 && v.t.OID != pg.OIDFloat8 && v.t.OID != pg.OIDNumeric {
+			return v.errCannotCast(ptr)
+		}
+		f, err := strconv.ParseFloat(v.val, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrCannotCast, err)
+		}
+		dst.SetFloat(f)
+	case reflect.String:
+		dst.SetString(v.val)
+	default:
+		return v.errCannotCast(ptr)
+	}
+
+	return nil
+}
+
+func (v pgValue) isInteger() bool {
+	switch v.t.OID {
+	case pg.OIDInt2, pg.OIDInt4, pg.OIDInt8, pg.OIDOid:
+		return true
+	default:
+		return false
+	}
+}
+
+func (v pgValue) time() (time.Time, error) {
+	var (
+		t   time.Time
+		err error
+	)
+	switch v.t.OID {
+	case pg.OIDDate:
+		t, err = pg.ParseDate(v.val)
+	case pg.OIDTimestamp:
+		t, err = pg.ParseTimestamp(v.val)
+	case pg.OIDTimestampTz:
+		t, err = pg.ParseTimestampTz(v.val)
+	default:
+		return time.Time{}, fmt.Errorf("%w %q (type '%s') to time.Time", ErrCannotCast, v.val, v.t.Yql())
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrCannotCast, err)
+	}
+
+	return t, nil
+}
+
+// castArray stores one-dimensional array into slice
+func (v pgValue) castArray(elem uint32, ptr reflect.Value) error {
+	dst := ptr.Elem()
+	if dst.Kind() == reflect.Pointer {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+
+		return v.castArray(elem, dst)
+	}
+	if dst.Kind() != reflect.Slice {
+		return v.errCannotCast(ptr)
+	}
+	items, err := pg.ParseArray(v.val)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCannotCast, err)
+	}
+	slice := reflect.MakeSlice(dst.Type(), len(items), len(items))
+	for i, item := range items {
+		itemValue := PgNullValue(elem)
+		if item != nil {
+			itemValue = PgValue(elem, *item)
+		}
+		if err := itemValue.castToReflect(slice.Index(i).Addr()); err != nil {
+			return err
+		}
+	}
+	dst.Set(slice)
+
+	return nil
+}
+
+// driverValue returns value in types of database/sql driver (bool, int64, float64, []byte, string, time.Time or nil)
+func (v pgValue) driverValue() (driver.Value, error) {
+	if v.null {
+		return nil, nil //nolint:nilnil
+	}
+	switch v.t.OID {
+	case pg.OIDBool:
+		b, err := pg.ParseBool(v.val)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCannotCast, err)
+		}
+
+		return b, nil
+	case pg.OIDInt2, pg.OIDInt4, pg.OIDInt8, pg.OIDOid:
+		n, err := strconv.ParseInt(v.val, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCannotCast, err)
+		}
+
+		return n, nil
+	case pg.OIDFloat4, pg.OIDFloat8:
+		f, err := strconv.ParseFloat(v.val, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCannotCast, err)
+		}
+
+		return f, nil
+	case pg.OIDBytea:
+		b, err := pg.ParseBytea(v.val)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCannotCast, err)
+		}
+
+		return b, nil
+	case pg.OIDDate, pg.OIDTimestamp, pg.OIDTimestampTz:
+		return v.time()
+	default:
+		return v.val, nil
+	}
+}
+
+func (v pgValue) Type() types.Type {
+	return v.t
+}
+
+func (v pgValue) toYDB(_ *allocator.Allocator) *Ydb.Value {
+	//nolint:godox
+	// TODO: make allocator
+	if v.null {
+		return &Ydb.Value{
+			Value: &Ydb.Value_NullFlagValue{},
+		}
+	}
+
+	return &Ydb.Value{
+		Value: &Ydb.Value_TextValue{
+			TextValue: v.val,
+		},
+	}
+}
+
+func (v pgValue) Yql() string {
+	if v.t.OID == pg.OIDUnknown {
+		//nolint:godox
+		// TODO: call special function for unknown oids
+		// https://github.com/ydb-platform/ydb/issues/2706
+		return fmt.Sprintf("PgUnknown(%q)", v.val)
+	}
+	if v.null {
+		return fmt.Sprintf("PgCast(NULL, %s)", v.t.Yql())
+	}
+
+	return fmt.Sprintf("PgConst(%q, %s)", v.val, v.t.Yql())
+}
+
+func PgValue(oid uint32, val string) pgValue {
+	return pgValue{
+		t: types.PgType{
+			OID: oid,
+		},
+		val: val,
+	}
+}
+
+func PgNullValue(oid uint32) pgValue {
+	return pgValue{
+		t: types.PgType{
+			OID: oid,
+		},
+		null: true,
+	}
+}
+
+// PgDriverValue converts value of postgres type into types of database/sql driver.
+// Other values are returned as is
+func PgDriverValue(v interface{}) (interface{}, error) {
+	pv, ok := v.(pgValue)
+	if !ok {
+		return v, nil
+	}
+
+	return pv.driverValue()
+}
+
+// PgValueFromGo makes value of postgres type from go value (bool, integers, floats, string, []byte,
+// time.Time, time.Duration, uuid.UUID, json.RawMessage, slices of them as arrays and pointers as nullable).
+// Types of integers are int2, int4 or int8 by size of go type, time.Time is a timestamptz, decimals
+// (ValueConverter into Decimal) are numeric, other driver.Valuer are converted by its values
+//
+//nolint:gocyclo,funlen
+func PgValueFromGo(v interface{}) (Value, error) {
+	switch x := v.(type) {
+	case Value:
+		return x, nil
+	case bool:
+		return PgValue(pg.OIDBool, pg.FormatBool(x)), nil
+	case int8:
+		return PgValue(pg.OIDInt2, strconv.FormatInt(int64(x), 10)), nil
+	case int16:
+		return PgValue(pg.OIDInt2, strconv.FormatInt(int64(x), 10)), nil
+	case uint8:
+		return PgValue(pg.OIDInt2, strconv.FormatUint(uint64(x), 10)), nil
+	case int32:
+		return PgValue(pg.OIDInt4, strconv.FormatInt(int64(x), 10)), nil
+	case uint16:
+		return PgValue(pg.OIDInt4, strconv.FormatUint(uint64(x), 10)), nil
+	case int:
+		return PgValue(pg.OIDInt8, strconv.FormatInt(int64(x), 10)), nil
+	case int64:
+		return PgValue(pg.OIDInt8, strconv.FormatInt(x, 10)), nil
+	case uint32:
+		return PgValue(pg.OIDInt8, strconv.FormatUint(uint64(x), 10)), nil
+	case float32:
+		return PgValue(pg.OIDFloat4, strconv.FormatFloat(float64(x), 'g', -1, 32)), nil
+	case float64:
+		return PgValue(pg.OIDFloat8, strconv.FormatFloat(x, 'g', -1, 64)), nil
+	case string:
+		return PgValue(pg.OIDText, x), nil
+	case []byte:
+		return PgValue(pg.OIDBytea, pg.FormatBytea(x)), nil
+	case json.RawMessage:
+		return PgValue(pg.OIDJSONB, string(x)), nil
+	case time.Time:
+		return PgValue(pg.OIDTimestampTz, x.Format(pg.TimestampTzLayout)), nil
+	case time.Duration:
+		return PgValue(pg.OIDInterval, pg.FormatInterval(x)), nil
+	case uuid.UUID:
+		return PgValue(pg.OIDUUID, x.String()), nil
+	case ValueConverter:
+		yv, err := x.ToYDBValue()
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if d, ok := yv.(DecimalValuer); ok {
+			return PgValue(pg.OIDNumeric, decimal.Format(
+				decimal.FromInt128(d.Value(), d.Precision(), d.Scale()), d.Precision(), d.Scale(),
+			)), nil
+		}
+
+		return yv, nil
+	case driver.Valuer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: nil %T as postgres value", errFromGo, v))
+		}
+		dv, err := x.Value()
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return PgValueFromGo(dv)
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if !rv.IsNil() {
+			return PgValueFromGo(rv.Elem().Interface())
+		}
+		elem, err := PgValueFromGo(reflect.Zero(rv.Type().Elem()).Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		elemType, _ := elem.Type().(types.PgType)
+
+		return PgNullValue(elemType.OID), nil
+	case reflect.Slice:
+		return pgArrayFromGo(rv)
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T as postgres value", errFromGo, v))
+	}
+}
+
+func pgArrayFromGo(rv reflect.Value) (Value, error) {
+	elemValue, err := PgValueFromGo(reflect.Zero(rv.Type().Elem()).Interface())
+	if err != nil {
+		return nil, err
+	}
+	elemType, _ := elemValue.Type().(types.PgType)
+	oid, ok := pg.ArrayOf(elemType.OID)
+	if !ok {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s as postgres array", errFromGo, rv.Type()))
+	}
+	if rv.IsNil() {
+		return PgNullValue(oid), nil
+	}
+	items := make([]*string, rv.Len())
+	for i := range items {
+		item, err := PgValueFromGo(rv.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		if pv, _ := item.(pgValue); !pv.null {
+			items[i] = &pv.val
+		}
+	}
+
+	return PgValue(oid, pg.FormatArray(items)), nil
+}
@@ -0,0 +1,174 @@
+package value
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestPgValueCastTo(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	id := uuid.MustParse("6e73b41c-4ede-4d08-9cfb-b7462d9e498b")
+	for _, tt := range []struct {
+		name string
+		v    Value
+		dst  interface{}
+		exp  interface{}
+	}{
+		{name: "Int4", v: PgValue(pg.OIDInt4, "-123"), dst: new(int32), exp: int32(-123)},
+		{name: "Int4ToInt64", v: PgValue(pg.OIDInt4, "123"), dst: new(int64), exp: int64(123)},
+		{name: "Int8ToUint", v: PgValue(pg.OIDInt8, "123"), dst: new(uint64), exp: uint64(123)},
+		{name: "Int2ToFloat", v: PgValue(pg.OIDInt2, "12"), dst: new(float64), exp: float64(12)},
+		{name: "Float8", v: PgValue(pg.OIDFloat8, "1.5"), dst: new(float64), exp: 1.5},
+		{name: "Numeric", v: PgValue(pg.OIDNumeric, "-12.5"), dst: new(float32), exp: float32(-12.5)},
+		{name: "NumericToString", v: PgValue(pg.OIDNumeric, "-12.50"), dst: new(string), exp: "-12.50"},
+		{name: "Bool", v: PgValue(pg.OIDBool, "t"), dst: new(bool), exp: true},
+		{name: "Text", v: PgValue(pg.OIDText, "abc"), dst: new(string), exp: "abc"},
+		{name: "TextToBytes", v: PgValue(pg.OIDText, "abc"), dst: new([]byte), exp: []byte("abc")},
+		{name: "Bytea", v: PgValue(pg.OIDBytea, `\x0102`), dst: new([]byte), exp: []byte{1, 2}},
+		{name: "JSONB", v: PgValue(pg.OIDJSONB, `{"a": 1}`), dst: new(json.RawMessage), exp: json.RawMessage(`{"a": 1}`)},
+		{name: "Date", v: PgValue(pg.OIDDate, "2024-01-02"), dst: new(time.Time), exp: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "Timestamp", v: PgValue(pg.OIDTimestamp, "2024-01-02 03:04:05.123456"), dst: new(time.Time), exp: ts},
+		{name: "Interval", v: PgValue(pg.OIDInterval, "1 day 01:00:00"), dst: new(time.Duration), exp: 25 * time.Hour},
+		{name: "UUID", v: PgValue(pg.OIDUUID, id.String()), dst: new(uuid.UUID), exp: id},
+		{name: "Pointer", v: PgValue(pg.OIDInt8, "1"), dst: new(*int64), exp: func(v int64) *int64 { return &v }(1)},
+		{name: "Null", v: PgNullValue(pg.OIDInt8), dst: new(*int64), exp: (*int64)(nil)},
+		{name: "NullInterface", v: PgNullValue(pg.OIDText), dst: new(interface{}), exp: nil},
+		{name: "Interface", v: PgValue(pg.OIDInt4, "1"), dst: new(interface{}), exp: int64(1)},
+		{name: "Int4Array", v: PgValue(pg.OIDInt4Array, "{1,2,3}"), dst: new([]int32), exp: []int32{1, 2, 3}},
+		{
+			name: "TextArrayWithNull",
+			v:    PgValue(pg.OIDTextArray, `{a,NULL,"b c"}`),
+			dst:  new([]*string),
+			exp:  []*string{func(s string) *string { return &s }("a"), nil, func(s string) *string { return &s }("b c")},
+		},
+		{name: "NullArray", v: PgNullValue(pg.OIDInt4Array), dst: new([]int32), exp: []int32(nil)},
+		{name: "ArrayToString", v: PgValue(pg.OIDInt4Array, "{1,2}"), dst: new(string), exp: "{1,2}"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, CastTo(tt.v, tt.dst))
+			require.Equal(t, tt.exp, reflect.ValueOf(tt.dst).Elem().Interface())
+		})
+	}
+	t.Run("Timestamptz", func(t *testing.T) {
+		var dst time.Time
+		require.NoError(t, CastTo(PgValue(pg.OIDTimestampTz, "2024-01-02 06:04:05.123456+03"), &dst))
+		require.True(t, ts.Equal(dst))
+	})
+}
+
+func TestPgValueCastToErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		v    Value
+		dst  interface{}
+	}{
+		{name: "Overflow", v: PgValue(pg.OIDInt4, "300"), dst: new(int8)},
+		{name: "Negative", v: PgValue(pg.OIDInt4, "-1"), dst: new(uint32)},
+		{name: "TextToInt", v: PgValue(pg.OIDText, "1"), dst: new(int)},
+		{name: "IntToBool", v: PgValue(pg.OIDInt4, "1"), dst: new(bool)},
+		{name: "TextToTime", v: PgValue(pg.OIDText, "2024-01-02"), dst: new(time.Time)},
+		{name: "InvalidTimestamp", v: PgValue(pg.OIDTimestamp, "now"), dst: new(time.Time)},
+		{name: "NullToInt", v: PgNullValue(pg.OIDInt4), dst: new(int32)},
+		{name: "NullToString", v: PgNullValue(pg.OIDText), dst: new(string)},
+		{name: "ArrayToInt", v: PgValue(pg.OIDInt4Array, "{1}"), dst: new(int)},
+		{name: "InvalidArray", v: PgValue(pg.OIDInt4Array, "{a}"), dst: new([]int)},
+		{name: "NotPointer", v: PgValue(pg.OIDInt4, "1"), dst: 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, CastTo(tt.v, tt.dst), ErrCannotCast)
+		})
+	}
+}
+
+func TestPgValueFromGo(t *testing.T) {
+	id := uuid.MustParse("6e73b41c-4ede-4d08-9cfb-b7462d9e498b")
+	for _, tt := range []struct {
+		name string
+		v    interface{}
+		exp  Value
+	}{
+		{name: "Bool", v: true, exp: PgValue(pg.OIDBool, "t")},
+		{name: "Int16", v: int16(-1), exp: PgValue(pg.OIDInt2, "-1")},
+		{name: "Int32", v: int32(1), exp: PgValue(pg.OIDInt4, "1")},
+		{name: "Int", v: 1, exp: PgValue(pg.OIDInt8, "1")},
+		{name: "Float32", v: float32(0.5), exp: PgValue(pg.OIDFloat4, "0.5")},
+		{name: "Float64", v: 1e100, exp: PgValue(pg.OIDFloat8, "1e+100")},
+		{name: "String", v: "abc", exp: PgValue(pg.OIDText, "abc")},
+		{name: "Bytes", v: []byte{1, 255}, exp: PgValue(pg.OIDBytea, `\x01ff`)},
+		{name: "JSON", v: json.RawMessage(`[1]`), exp: PgValue(pg.OIDJSONB, `[1]`)},
+		{
+			name: "Time",
+			v:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("", 3*60*60)),
+			exp:  PgValue(pg.OIDTimestampTz, "2024-01-02 03:04:05+03:00"),
+		},
+		{name: "Duration", v: -90 * time.Minute, exp: PgValue(pg.OIDInterval, "-01:30:00")},
+		{name: "UUID", v: id, exp: PgValue(pg.OIDUUID, id.String())},
+		{name: "NilPointer", v: (*int32)(nil), exp: PgNullValue(pg.OIDInt4)},
+		{name: "Pointer", v: func(v string) *string { return &v }("a"), exp: PgValue(pg.OIDText, "a")},
+		{name: "Int32Slice", v: []int32{1, 2}, exp: PgValue(pg.OIDInt4Array, `{"1","2"}`)},
+		{name: "NilSlice", v: []string(nil), exp: PgNullValue(pg.OIDTextArray)},
+		{
+			name: "SliceOfPointers",
+			v:    []*string{nil, func(v string) *string { return &v }(`"`)},
+			exp:  PgValue(pg.OIDTextArray, `{NULL,"\""}`),
+		},
+		{
+			name: "Decimal",
+			v:    testDecimalConverter{big.NewInt(-12345), 22, 2},
+			exp:  PgValue(pg.OIDNumeric, "-123.45"),
+		},
+		{name: "Value", v: PgValue(pg.OIDUnknown, "x"), exp: PgValue(pg.OIDUnknown, "x")},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := PgValueFromGo(tt.v)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, v)
+		})
+	}
+	for _, v := range []interface{}{nil, uint64(1), struct{}{}, []struct{}{}, map[string]int{}} {
+		_, err := PgValueFromGo(v)
+		require.ErrorIs(t, err, errFromGo)
+	}
+}
+
+type testDecimalConverter struct {
+	unscaled         *big.Int
+	precision, scale uint32
+}
+
+func (d testDecimalConverter) ToYDBValue() (Value, error) {
+	return DecimalValueFromBigInt(d.unscaled, d.precision, d.scale), nil
+}
+
+func TestPgValueYDB(t *testing.T) {
+	for _, v := range []Value{
+		PgValue(pg.OIDInt4, "1"),
+		PgNullValue(pg.OIDInt4),
+		PgValue(pg.OIDTextArray, "{a}"),
+	} {
+		a := allocator.New()
+		fromYDB := FromYDB(v.Type().ToYDB(a), ToYDB(v, a).GetValue())
+		a.Free()
+		require.Equal(t, v, fromYDB)
+	}
+	require.Equal(t, `PgConst("1", pgint4)`, PgValue(pg.OIDInt4, "1").Yql())
+	require.Equal(t, `PgCast(NULL, pgint4)`, PgNullValue(pg.OIDInt4).Yql())
+	require.Equal(t, `PgConst("{a}", _pgtext)`, PgValue(pg.OIDTextArray, "{a}").Yql())
+	require.Equal(t, types.PgType{OID: pg.OIDInt4}, PgValue(pg.OIDInt4, "1").Type())
+	nv, err := PgDriverValue(PgValue(pg.OIDTimestamp, "2024-01-02 03:04:05"))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), nv)
+	nv, err = PgDriverValue(PgValue(pg.OIDUUID, "x"))
+	require.NoError(t, err)
+	require.Equal(t, "x", nv)
+}
@@ -58,6 +58,48 @@ func TimestampToTime(n uint64) time.Time {
 	return time.Unix(int64(sec), int64(nsec))
 }
 
+// Date32ToTime converts given days since Epoch (negative before 1970) to time.Time
+func Date32ToTime(n int32) time.Time {
+	return time.Unix(int64(n)*int64(secondsPerDay), 0)
+}
+
+// Datetime64ToTime converts given seconds since Epoch (negative before 1970) to time.Time
+func Datetime64ToTime(n int64) time.Time {
+	return time.Unix(n, 0)
+}
+
+// Timestamp64ToTime converts given microseconds since Epoch (negative before 1970) to time.Time
+func Timestamp64ToTime(n int64) time.Time {
+	sec, usec := n/1e6, n%1e6
+	if usec < 0 {
+		sec--
+		usec += 1e6
+	}
+
+	return time.Unix(sec, usec*1000)
+}
+
+// Interval64ToDuration converts given microseconds to time.Duration.
+// Returns error if interval is out of time.Duration range (about 292 years)
+func Interval64ToDuration(n int64) (time.Duration, error) {
+	if n > int64(math.MaxInt64/time.Microsecond) || n < int64(math.MinInt64/time.Microsecond) {
+		return 0, xerrors.WithStackTrace(fmt.Errorf("interval %dus is out of time.Duration range", n))
+	}
+
+	return time.Duration(n) * time.Microsecond, nil
+}
+
+// timeToDate32 returns days since Epoch (rounded down for times before 1970)
+func timeToDate32(t time.Time) int32 {
+	sec := t.Unix()
+	days := sec / int64(secondsPerDay)
+	if sec%int64(secondsPerDay) < 0 {
+		days--
+	}
+
+	return int32(days)
+}
+
 func TzDateToTime(s string) (t time.Time, err error) {
 	ss := strings.Split(s, ",")
 	if len(ss) != 2 {
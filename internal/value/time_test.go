@@ -1,6 +1,7 @@
 package value
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -70,3 +71,56 @@ func TestTzSomeToTime(t *testing.T) {
 		})
 	}
 }
+
+func TestWideTimeToTime(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		src  time.Time
+		conv func(time.Time) time.Time
+	}{
+		{
+			name: "Date32",
+			src:  time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC),
+			conv: func(v time.Time) time.Time { return Date32ToTime(timeToDate32(v)) },
+		},
+		{
+			name: "Date32/1969-12-31",
+			src:  time.Date(1969, time.December, 31, 0, 0, 0, 0, time.UTC),
+			conv: func(v time.Time) time.Time { return Date32ToTime(timeToDate32(v)) },
+		},
+		{
+			name: "Datetime64",
+			src:  time.Date(1812, time.September, 7, 5, 30, 15, 0, time.UTC),
+			conv: func(v time.Time) time.Time { return Datetime64ToTime(v.Unix()) },
+		},
+		{
+			name: "Timestamp64",
+			src:  time.Date(1969, time.December, 31, 23, 59, 59, 999999000, time.UTC),
+			conv: func(v time.Time) time.Time { return Timestamp64ToTime(v.UnixMicro()) },
+		},
+		{
+			name: "Timestamp64/after1970",
+			src:  time.Date(2124, time.March, 1, 12, 0, 0, 1000, time.UTC),
+			conv: func(v time.Time) time.Time { return Timestamp64ToTime(v.UnixMicro()) },
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.True(t, tt.src.Equal(tt.conv(tt.src)), tt.conv(tt.src).UTC().String())
+		})
+	}
+}
+
+func TestTimeToDate32(t *testing.T) {
+	// times within a day before 1970 round down to the start of that day
+	require.Equal(t, int32(-1), timeToDate32(time.Date(1969, time.December, 31, 23, 59, 59, 0, time.UTC)))
+	require.Equal(t, int32(0), timeToDate32(time.Date(1970, time.January, 1, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestInterval64ToDuration(t *testing.T) {
+	d, err := Interval64ToDuration(-42)
+	require.NoError(t, err)
+	require.Equal(t, -42*time.Microsecond, d)
+
+	_, err = Interval64ToDuration(math.MaxInt64 / 2)
+	require.Error(t, err)
+}
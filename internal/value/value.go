@@ -114,6 +114,18 @@ func primitiveValueFromYDB(t types.Primitive, v *Ydb.Value) (Value, error) {
 	case types.Timestamp:
 		return TimestampValue(v.GetUint64Value()), nil
 
+	case types.Date32:
+		return Date32Value(v.GetInt32Value()), nil
+
+	case types.Datetime64:
+		return Datetime64Value(v.GetInt64Value()), nil
+
+	case types.Timestamp64:
+		return Timestamp64Value(v.GetInt64Value()), nil
+
+	case types.Interval64:
+		return Interval64Value(v.GetInt64Value()), nil
+
 	case types.Float:
 		return FloatValue(v.GetFloatValue()), nil
 
@@ -282,13 +294,16 @@ func fromYDB(t *Ydb.Type, v *Ydb.Value) (Value, error) {
 			ttt.Tuple,
 		), nil
 
-	case *types.PgType:
-		return &pgValue{
-			t: types.PgType{
-				OID: ttt.OID,
-			},
-			val: v.GetTextValue(),
-		}, nil
+	case types.PgType:
+		if _, isNull := v.GetValue().(*Ydb.Value_NullFlagValue); isNull {
+			return PgNullValue(ttt.OID), nil
+		}
+
+		return PgValue(ttt.OID, v.GetTextValue()), nil
+
+	case *types.Tagged:
+		// tagged value transfers as value of inner type
+		return fromYDB(t.GetTaggedType().GetType(), v)
 
 	default:
 		return nil, xerrors.WithStackTrace(fmt.Errorf("uncovered type: %T", ttt))
@@ -1026,38 +1041,50 @@ func (v intervalValue) castTo(dst interface{}) error {
 }
 
 func (v intervalValue) Yql() string {
+	return intervalYql(v.Type(), int64(v))
+}
+
+// intervalYql formats interval in microseconds as ISO 8601 duration
+func intervalYql(t types.Type, v int64) string {
 	buffer := xstring.Buffer()
 	defer buffer.Free()
-	buffer.WriteString(v.Type().Yql())
+	buffer.WriteString(t.Yql())
 	buffer.WriteByte('(')
 	buffer.WriteByte('"')
-	d := IntervalToDuration(int64(v))
-	if d < 0 {
+	// interval formats from microseconds without conversion to time.Duration because
+	// Interval64 may be out of range of time.Duration
+	const (
+		microsecondsPerSecond = uint64(time.Second / time.Microsecond)
+		microsecondsPerMinute = 60 * microsecondsPerSecond
+		microsecondsPerHour   = 60 * microsecondsPerMinute
+		microsecondsPerDay    = 24 * microsecondsPerHour
+	)
+	us := uint64(v)
+	if v < 0 {
 		buffer.WriteByte('-')
-		d = -d
+		us = -us
 	}
 	buffer.WriteByte('P')
-	if days := d / time.Hour / 24; days > 0 {
-		d -= days * time.Hour * 24 //nolint:durationcheck
-		buffer.WriteString(strconv.FormatInt(int64(days), 10))
+	if days := us / microsecondsPerDay; days > 0 {
+		us -= days * microsecondsPerDay
+		buffer.WriteString(strconv.FormatUint(days, 10))
 		buffer.WriteByte('D')
 	}
-	if d > 0 {
+	if us > 0 {
 		buffer.WriteByte('T')
 	}
-	if hours := d / time.Hour; hours > 0 {
-		d -= hours * time.Hour //nolint:durationcheck
-		buffer.WriteString(strconv.FormatInt(int64(hours), 10))
+	if hours := us / microsecondsPerHour; hours > 0 {
+		us -= hours * microsecondsPerHour
+		buffer.WriteString(strconv.FormatUint(hours, 10))
 		buffer.WriteByte('H')
 	}
-	if minutes := d / time.Minute; minutes > 0 {
-		d -= minutes * time.Minute //nolint:durationcheck
-		buffer.WriteString(strconv.FormatInt(int64(minutes), 10))
+	if minutes := us / microsecondsPerMinute; minutes > 0 {
+		us -= minutes * microsecondsPerMinute
+		buffer.WriteString(strconv.FormatUint(minutes, 10))
 		buffer.WriteByte('M')
 	}
-	if d > 0 {
-		seconds := float64(d) / float64(time.Second)
-		fmt.Fprintf(buffer, "%0.6f", seconds)
+	if us > 0 {
+		fmt.Fprintf(buffer, "%d.%06d", us/microsecondsPerSecond, us%microsecondsPerSecond)
 		buffer.WriteByte('S')
 	}
 	buffer.WriteByte('"')
@@ -1089,6 +1116,60 @@ func IntervalValueFromDuration(v time.Duration) intervalValue {
 	return intervalValue(durationToMicroseconds(v))
 }
 
+type interval64Value int64
+
+func (v interval64Value) castTo(dst interface{}) error {
+	switch vv := dst.(type) {
+	case *time.Duration:
+		d, err := Interval64ToDuration(int64(v))
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%+v' (type '%s') to '%T' destination: %w",
+				ErrCannotCast, v, v.Type().Yql(), vv, err,
+			))
+		}
+		*vv = d
+
+		return nil
+	case *int64:
+		*vv = int64(v)
+
+		return nil
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%+v' (type '%s') to '%T' destination",
+			ErrCannotCast, v, v.Type().Yql(), vv,
+		))
+	}
+}
+
+func (v interval64Value) Yql() string {
+	return intervalYql(v.Type(), int64(v))
+}
+
+func (interval64Value) Type() types.Type {
+	return types.Interval64
+}
+
+func (v interval64Value) toYDB(a *allocator.Allocator) *Ydb.Value {
+	vv := a.Int64()
+	vv.Int64Value = int64(v)
+
+	vvv := a.Value()
+	vvv.Value = vv
+
+	return vvv
+}
+
+// Interval64Value makes Value from given microseconds value
+func Interval64Value(v int64) interval64Value {
+	return interval64Value(v)
+}
+
+func Interval64ValueFromDuration(v time.Duration) interval64Value {
+	return interval64Value(durationToMicroseconds(v))
+}
+
 type jsonValue string
 
 func (v jsonValue) castTo(dst interface{}) error {
@@ -1237,39 +1318,6 @@ func ListValue(items ...Value) *listValue {
 	}
 }
 
-type pgValue struct {
-	t   types.PgType
-	val string
-}
-
-func (v pgValue) castTo(dst interface{}) error {
-	return xerrors.WithStackTrace(fmt.Errorf(
-		"%w  PgType to '%T' destination",
-		ErrCannotCast, dst,
-	))
-}
-
-func (v pgValue) Type() types.Type {
-	return v.t
-}
-
-func (v pgValue) toYDB(_ *allocator.Allocator) *Ydb.Value {
-	//nolint:godox
-	// TODO: make allocator
-	return &Ydb.Value{
-		Value: &Ydb.Value_TextValue{
-			TextValue: v.val,
-		},
-	}
-}
-
-func (v pgValue) Yql() string {
-	//nolint:godox
-	// TODO: call special function for unknown oids
-	// https://github.com/ydb-platform/ydb/issues/2706
-	return fmt.Sprintf("PgUnknown(%q)", v.val)
-}
-
 type setValue struct {
 	t     types.Type
 	items []Value
@@ -1316,15 +1364,6 @@ func (v *setValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	return vvv
 }
 
-func PgValue(oid uint32, val string) pgValue {
-	return pgValue{
-		t: types.PgType{
-			OID: oid,
-		},
-		val: val,
-	}
-}
-
 func SetValue(items ...Value) *setValue {
 	sort.Slice(items, func(i, j int) bool {
 		return items[i].Yql() < items[j].Yql()
@@ -1373,7 +1412,7 @@ func (v *optionalValue) castTo(dst interface{}) error {
 			return nil
 		}
 
-		if err := v.value.castTo(ptr.Interface()); err != nil {
+		if err := CastTo(v.value, ptr.Interface()); err != nil {
 			return xerrors.WithStackTrace(err)
 		}
 
@@ -1388,7 +1427,7 @@ func (v *optionalValue) castTo(dst interface{}) error {
 
 	inner.Set(reflect.New(inner.Type().Elem()))
 
-	if err := v.value.castTo(inner.Interface()); err != nil {
+	if err := CastTo(v.value, inner.Interface()); err != nil {
 		return xerrors.WithStackTrace(err)
 	}
 
@@ -1553,6 +1592,151 @@ func TimestampValueFromTime(t time.Time) timestampValue {
 	return timestampValue(t.Sub(epoch) / time.Microsecond)
 }
 
+type date32Value int32
+
+func (v date32Value) castTo(dst interface{}) error {
+	switch vv := dst.(type) {
+	case *time.Time:
+		*vv = Date32ToTime(int32(v))
+
+		return nil
+	case *int64:
+		*vv = int64(v)
+
+		return nil
+	case *int32:
+		*vv = int32(v)
+
+		return nil
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%+v' (type '%s') to '%T' destination",
+			ErrCannotCast, v, v.Type().Yql(), vv,
+		))
+	}
+}
+
+func (v date32Value) Yql() string {
+	return fmt.Sprintf("%s(%q)", v.Type().Yql(), Date32ToTime(int32(v)).UTC().Format(LayoutDate))
+}
+
+func (date32Value) Type() types.Type {
+	return types.Date32
+}
+
+func (v date32Value) toYDB(a *allocator.Allocator) *Ydb.Value {
+	vv := a.Int32()
+	vv.Int32Value = int32(v)
+
+	vvv := a.Value()
+	vvv.Value = vv
+
+	return vvv
+}
+
+// Date32Value returns ydb date32 value by given days since Epoch (negative before 1970)
+func Date32Value(v int32) date32Value {
+	return date32Value(v)
+}
+
+func Date32ValueFromTime(t time.Time) date32Value {
+	return date32Value(timeToDate32(t))
+}
+
+type datetime64Value int64
+
+func (v datetime64Value) castTo(dst interface{}) error {
+	switch vv := dst.(type) {
+	case *time.Time:
+		*vv = Datetime64ToTime(int64(v))
+
+		return nil
+	case *int64:
+		*vv = int64(v)
+
+		return nil
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%+v' (type '%s') to '%T' destination",
+			ErrCannotCast, v, v.Type().Yql(), vv,
+		))
+	}
+}
+
+func (v datetime64Value) Yql() string {
+	return fmt.Sprintf("%s(%q)", v.Type().Yql(), Datetime64ToTime(int64(v)).UTC().Format(LayoutDatetime))
+}
+
+func (datetime64Value) Type() types.Type {
+	return types.Datetime64
+}
+
+func (v datetime64Value) toYDB(a *allocator.Allocator) *Ydb.Value {
+	vv := a.Int64()
+	vv.Int64Value = int64(v)
+
+	vvv := a.Value()
+	vvv.Value = vv
+
+	return vvv
+}
+
+// Datetime64Value makes ydb datetime64 value from seconds since Epoch (negative before 1970)
+func Datetime64Value(v int64) datetime64Value {
+	return datetime64Value(v)
+}
+
+func Datetime64ValueFromTime(t time.Time) datetime64Value {
+	return datetime64Value(t.Unix())
+}
+
+type timestamp64Value int64
+
+func (v timestamp64Value) castTo(dst interface{}) error {
+	switch vv := dst.(type) {
+	case *time.Time:
+		*vv = Timestamp64ToTime(int64(v))
+
+		return nil
+	case *int64:
+		*vv = int64(v)
+
+		return nil
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%+v' (type '%s') to '%T' destination",
+			ErrCannotCast, v, v.Type().Yql(), vv,
+		))
+	}
+}
+
+func (v timestamp64Value) Yql() string {
+	return fmt.Sprintf("%s(%q)", v.Type().Yql(), Timestamp64ToTime(int64(v)).UTC().Format(LayoutTimestamp))
+}
+
+func (timestamp64Value) Type() types.Type {
+	return types.Timestamp64
+}
+
+func (v timestamp64Value) toYDB(a *allocator.Allocator) *Ydb.Value {
+	vv := a.Int64()
+	vv.Int64Value = int64(v)
+
+	vvv := a.Value()
+	vvv.Value = vv
+
+	return vvv
+}
+
+// Timestamp64Value makes ydb timestamp64 value by given microseconds since Epoch (negative before 1970)
+func Timestamp64Value(v int64) timestamp64Value {
+	return timestamp64Value(v)
+}
+
+func Timestamp64ValueFromTime(t time.Time) timestamp64Value {
+	return timestamp64Value(t.UnixMicro())
+}
+
 type tupleValue struct {
 	t     types.Type
 	items []Value
@@ -2334,6 +2518,18 @@ func zeroPrimitiveValue(t types.Primitive) Value {
 	case types.Interval:
 		return IntervalValue(0)
 
+	case types.Date32:
+		return Date32Value(0)
+
+	case types.Datetime64:
+		return Datetime64Value(0)
+
+	case types.Timestamp64:
+		return Timestamp64Value(0)
+
+	case types.Interval64:
+		return Interval64Value(0)
+
 	case types.Text:
 		return TextValue("")
 
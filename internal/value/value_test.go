@@ -35,6 +35,10 @@ func BenchmarkMemory(b *testing.B) {
 		DatetimeValue(1),
 		TimestampValue(1),
 		IntervalValue(1),
+		Date32Value(-1),
+		Datetime64Value(-1),
+		Timestamp64Value(-1),
+		Interval64Value(-1),
 		VoidValue(),
 		FloatValue(1),
 		DoubleValue(1),
@@ -114,6 +118,10 @@ func TestToYDBFromYDB(t *testing.T) {
 		DatetimeValue(1),
 		TimestampValue(1),
 		IntervalValue(1),
+		Date32Value(-1),
+		Datetime64Value(-1),
+		Timestamp64Value(-1),
+		Interval64Value(-1),
 		VoidValue(),
 		FloatValue(1),
 		DoubleValue(1),
@@ -334,6 +342,31 @@ func TestValueYql(t *testing.T) {
 			value:   TzTimestampValue("1997-12-14T03:09:42.123456,Europe/Berlin"),
 			literal: `TzTimestamp("1997-12-14T03:09:42.123456,Europe/Berlin")`,
 		},
+		{
+			value:   Date32ValueFromTime(time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			literal: `Date32("1900-01-01")`,
+		},
+		{
+			value:   Datetime64ValueFromTime(time.Date(1900, time.January, 1, 5, 19, 20, 0, time.UTC)),
+			literal: `Datetime64("1900-01-01T05:19:20Z")`,
+		},
+		{
+			value:   Timestamp64ValueFromTime(time.Date(1900, time.January, 1, 3, 9, 42, 123456000, time.UTC)),
+			literal: `Timestamp64("1900-01-01T03:09:42.123456Z")`,
+		},
+		{
+			value:   Interval64ValueFromDuration(-time.Duration(42) * time.Millisecond),
+			literal: `Interval64("-PT0.042000S")`,
+		},
+		{
+			// out of range of time.Duration
+			value:   Interval64Value(400 * 365 * 24 * 3600 * 1000000),
+			literal: `Interval64("P146000D")`,
+		},
+		{
+			value:   Interval64Value(math.MinInt64),
+			literal: `Interval64("-P106751991DT4H54.775808S")`,
+		},
 		{
 			value:   NullValue(types.Int32),
 			literal: `Nothing(Optional<Int32>)`,
@@ -789,6 +822,36 @@ func TestNullable(t *testing.T) {
 			v:    func() *int64 { return nil }(),
 			exp:  NullValue(types.Interval),
 		},
+		{
+			name: "date32 from int32",
+			t:    types.Date32,
+			v:    func(v int32) *int32 { return &v }(-123),
+			exp:  OptionalValue(Date32Value(-123)),
+		},
+		{
+			name: "date32 from time.Time",
+			t:    types.Date32,
+			v:    func(v time.Time) *time.Time { return &v }(time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			exp:  OptionalValue(Date32ValueFromTime(time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC))),
+		},
+		{
+			name: "timestamp64 from time.Time",
+			t:    types.Timestamp64,
+			v:    func(v time.Time) *time.Time { return &v }(time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			exp:  OptionalValue(Timestamp64ValueFromTime(time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC))),
+		},
+		{
+			name: "nil datetime64",
+			t:    types.Datetime64,
+			v:    func() *int64 { return nil }(),
+			exp:  NullValue(types.Datetime64),
+		},
+		{
+			name: "interval64 from time.Duration",
+			t:    types.Interval64,
+			v:    func(v time.Duration) *time.Duration { return &v }(-time.Second),
+			exp:  OptionalValue(Interval64ValueFromDuration(-time.Second)),
+		},
 		{
 			name: "tzDatetime from int32",
 			t:    types.TzDatetime,
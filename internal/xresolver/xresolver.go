@@ -1,6 +1,9 @@
 package xresolver
 
 import (
+	"context"
+	"net"
+	"sort"
 	"strings"
 
 	"google.golang.org/grpc/resolver"
@@ -77,3 +80,34 @@ func New(scheme string, trace *trace.Driver) resolver.Builder {
 		trace:   trace,
 	}
 }
+
+// Resolve resolves host of address into list of addresses with the same port.
+// Address with IP host returns as is
+func Resolve(ctx context.Context, t *trace.Driver, address string) (addrs []string, err error) {
+	call := stack.FunctionID("")
+	defer func() {
+		trace.DriverOnResolve(t, call, address, addrs)(err)
+	}()
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if net.ParseIP(host) != nil {
+		return []string{address}, nil
+	}
+
+	hosts, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	sort.Strings(hosts)
+	addrs = make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		addrs = append(addrs, net.JoinHostPort(h, port))
+	}
+
+	return addrs, nil
+}
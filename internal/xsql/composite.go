@@ -0,0 +1,167 @@
+package xsql
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	_ value.ValueConverter = (*Composite)(nil)
+	_ value.ValueScanner   = (*Composite)(nil)
+	_ sql.Scanner          = (*Composite)(nil)
+	_ driver.Valuer        = (*Composite)(nil)
+)
+
+var errComposite = errors.New("composite value error")
+
+type compositeKind uint8
+
+const (
+	compositeList = compositeKind(iota)
+	compositeStruct
+	compositeDict
+)
+
+func (k compositeKind) String() string {
+	switch k {
+	case compositeList:
+		return "List"
+	case compositeStruct:
+		return "Struct"
+	default:
+		return "Dict"
+	}
+}
+
+// Composite binds go slice, struct or map as YDB value of type List, Struct or Dict
+// and scans YDB values of these types into go value
+type Composite struct {
+	kind compositeKind
+	dst  interface{}
+}
+
+// ListOf makes Composite for pointer to slice or array
+func ListOf(dst interface{}) *Composite {
+	return &Composite{kind: compositeList, dst: dst}
+}
+
+// StructOf makes Composite for pointer to struct. Names of members of struct value are taken
+// from `sql` tag or names of fields
+func StructOf(dst interface{}) *Composite {
+	return &Composite{kind: compositeStruct, dst: dst}
+}
+
+// DictOf makes Composite for pointer to map. Map with values of type struct{} is bound as Set
+func DictOf(dst interface{}) *Composite {
+	return &Composite{kind: compositeDict, dst: dst}
+}
+
+// elem returns go value pointed by dst and checks that kind of go value is suitable for composite kind
+func (c *Composite) elem() (reflect.Value, error) {
+	rv := reflect.ValueOf(c.dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return reflect.Value{}, xerrors.WithStackTrace(
+			fmt.Errorf("%w: %T is not a non-nil pointer", errComposite, c.dst),
+		)
+	}
+	rv = rv.Elem()
+	switch kind := rv.Kind(); {
+	case c.kind == compositeList && (kind == reflect.Slice || kind == reflect.Array),
+		c.kind == compositeStruct && kind == reflect.Struct,
+		c.kind == compositeDict && kind == reflect.Map:
+		return rv, nil
+	default:
+		return reflect.Value{}, xerrors.WithStackTrace(
+			fmt.Errorf("%w: %s cannot be used as %s", errComposite, rv.Type(), c.kind),
+		)
+	}
+}
+
+func (c *Composite) isSuitable(t types.Type) bool {
+	if optional, ok := t.(types.Optional); ok {
+		t = optional.InnerType()
+	}
+	switch t.(type) {
+	case *types.List, *types.EmptyList:
+		return c.kind == compositeList
+	case *types.Struct:
+		return c.kind == compositeStruct
+	case *types.Dict, *types.EmptyDict, *types.Set:
+		return c.kind == compositeDict
+	default:
+		return false
+	}
+}
+
+// ToYDBValue converts go value pointed by dst into YDB value
+func (c *Composite) ToYDBValue() (value.Value, error) {
+	rv, err := c.elem()
+	if err != nil {
+		return nil, err
+	}
+	if c.kind == compositeList && rv.Type().Elem().Kind() == reflect.Uint8 {
+		// value.FromGo converts byte slices to String
+		items := make([]value.Value, rv.Len())
+		for i := range items {
+			items[i] = value.Uint8Value(uint8(rv.Index(i).Uint()))
+		}
+		if len(items) == 0 {
+			return value.ZeroValue(types.NewList(types.Uint8)), nil
+		}
+
+		return value.ListValue(items...), nil
+	}
+	v, err := value.FromGo(rv.Interface())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return v, nil
+}
+
+// ScanYDBValue stores YDB value into go value pointed by dst
+func (c *Composite) ScanYDBValue(v value.Value) error {
+	if _, err := c.elem(); err != nil {
+		return err
+	}
+	if !c.isSuitable(v.Type()) {
+		return xerrors.WithStackTrace(
+			fmt.Errorf("%w: cannot scan %s as %s", errComposite, v.Type().Yql(), c.kind),
+		)
+	}
+	if err := value.ToGo(v, c.dst); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// Scan implements sql.Scanner. NULL is stored as zero value (nil slice or map)
+func (c *Composite) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		rv, err := c.elem()
+		if err != nil {
+			return err
+		}
+		rv.SetZero()
+
+		return nil
+	case value.Value:
+		return c.ScanYDBValue(v)
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("%w: cannot scan %T as %s", errComposite, src, c.kind))
+	}
+}
+
+// Value implements driver.Valuer
+func (c *Composite) Value() (driver.Value, error) {
+	return c.ToYDBValue()
+}
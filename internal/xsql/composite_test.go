@@ -0,0 +1,96 @@
+package xsql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestCompositeValue(t *testing.T) {
+	type item struct {
+		ID    uint64 `sql:"id"`
+		Title string `sql:"title"`
+	}
+	t.Run("List", func(t *testing.T) {
+		src := []uint64{1, 2}
+		v, err := ListOf(&src).Value()
+		require.NoError(t, err)
+		require.Equal(t, value.ListValue(value.Uint64Value(1), value.Uint64Value(2)), v)
+
+		var dst []uint64
+		require.NoError(t, ListOf(&dst).Scan(v))
+		require.Equal(t, src, dst)
+
+		require.NoError(t, ListOf(&dst).Scan(nil))
+		require.Nil(t, dst)
+	})
+	t.Run("EmptyList", func(t *testing.T) {
+		var src []string
+		v, err := ListOf(&src).Value()
+		require.NoError(t, err)
+		require.Equal(t, "List<Utf8>", v.(value.Value).Type().Yql())
+	})
+	t.Run("ListOfBytes", func(t *testing.T) {
+		src := []byte{1, 2}
+		v, err := ListOf(&src).Value()
+		require.NoError(t, err)
+		require.Equal(t, value.ListValue(value.Uint8Value(1), value.Uint8Value(2)), v)
+
+		v, err = ListOf(&[]byte{}).Value()
+		require.NoError(t, err)
+		require.Equal(t, "List<Uint8>", v.(value.Value).Type().Yql())
+	})
+	t.Run("Struct", func(t *testing.T) {
+		src := item{ID: 1, Title: "test"}
+		v, err := StructOf(&src).Value()
+		require.NoError(t, err)
+		require.Equal(t, value.StructValue(
+			value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+			value.StructValueField{Name: "title", V: value.TextValue("test")},
+		), v)
+
+		var dst item
+		require.NoError(t, StructOf(&dst).Scan(value.OptionalValue(v.(value.Value))))
+		require.Equal(t, src, dst)
+	})
+	t.Run("Dict", func(t *testing.T) {
+		src := map[string]int64{"a": 1, "b": 2}
+		v, err := DictOf(&src).Value()
+		require.NoError(t, err)
+		require.Equal(t, "Dict<Utf8,Int64>", v.(value.Value).Type().Yql())
+
+		var dst map[string]int64
+		require.NoError(t, DictOf(&dst).Scan(v))
+		require.Equal(t, src, dst)
+	})
+	t.Run("Set", func(t *testing.T) {
+		var dst map[string]struct{}
+		require.NoError(t, DictOf(&dst).Scan(value.SetValue(value.TextValue("a"))))
+		require.Equal(t, map[string]struct{}{"a": {}}, dst)
+	})
+	t.Run("Errors", func(t *testing.T) {
+		var (
+			ids []uint64
+			s   item
+			m   map[string]int64
+		)
+		for _, err := range []error{
+			ListOf(ids).Scan(nil),
+			ListOf(&s).Scan(nil),
+			StructOf(&m).Scan(nil),
+			DictOf(&ids).Scan(nil),
+			ListOf(&ids).Scan(value.StructValue()),
+			DictOf(&m).Scan(value.ListValue(value.Int64Value(1))),
+			StructOf(&s).Scan("text"),
+			func() error {
+				_, err := DictOf(&ids).Value()
+
+				return err
+			}(),
+		} {
+			require.ErrorIs(t, err, errComposite)
+		}
+	})
+}
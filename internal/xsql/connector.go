@@ -15,6 +15,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
@@ -175,6 +176,48 @@ func WithTraceRetry(t *trace.Retry) ConnectorOption {
 	return traceRetryConnectorOption{t: t}
 }
 
+type retryBudgetConnectorOption struct {
+	budget *retry.Budget
+}
+
+func (o retryBudgetConnectorOption) Apply(c *Connector) error {
+	c.retryBudget = o.budget
+
+	return nil
+}
+
+func WithRetryBudget(budget *retry.Budget) ConnectorOption {
+	return retryBudgetConnectorOption{budget: budget}
+}
+
+type circuitBreakerConnectorOption struct {
+	circuitBreaker *retry.CircuitBreaker
+}
+
+func (o circuitBreakerConnectorOption) Apply(c *Connector) error {
+	c.circuitBreaker = o.circuitBreaker
+
+	return nil
+}
+
+func WithCircuitBreaker(circuitBreaker *retry.CircuitBreaker) ConnectorOption {
+	return circuitBreakerConnectorOption{circuitBreaker: circuitBreaker}
+}
+
+type errorClassifierConnectorOption struct {
+	classifier retry.ErrorClassifier
+}
+
+func (o errorClassifierConnectorOption) Apply(c *Connector) error {
+	c.errorClassifier = o.classifier
+
+	return nil
+}
+
+func WithErrorClassifier(classifier retry.ErrorClassifier) ConnectorOption {
+	return errorClassifierConnectorOption{classifier: classifier}
+}
+
 type fakeTxConnectorOption QueryMode
 
 func (m fakeTxConnectorOption) Apply(c *Connector) error {
@@ -248,8 +291,11 @@ type Connector struct {
 	disableServerBalancer bool
 	idleThreshold         time.Duration
 
-	trace      *trace.DatabaseSQL
-	traceRetry *trace.Retry
+	trace           *trace.DatabaseSQL
+	traceRetry      *trace.Retry
+	retryBudget     *retry.Budget
+	circuitBreaker  *retry.CircuitBreaker
+	errorClassifier retry.ErrorClassifier
 }
 
 var (
@@ -349,6 +395,18 @@ func (d *driverWrapper) TraceRetry() *trace.Retry {
 	return d.c.traceRetry
 }
 
+func (d *driverWrapper) RetryBudget() *retry.Budget {
+	return d.c.retryBudget
+}
+
+func (d *driverWrapper) CircuitBreaker() *retry.CircuitBreaker {
+	return d.c.circuitBreaker
+}
+
+func (d *driverWrapper) ErrorClassifier() retry.ErrorClassifier {
+	return d.c.errorClassifier
+}
+
 func (d *driverWrapper) Open(_ string) (driver.Conn, error) {
 	return nil, ErrUnsupported
 }
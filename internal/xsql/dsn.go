@@ -63,6 +63,10 @@ func Parse(dataSourceName string) (opts []config.Option, connectorOpts []Connect
 				binders = append(binders, WithQueryBind(bind.PositionalArgs{}))
 			case "numeric":
 				binders = append(binders, WithQueryBind(bind.NumericArgs{}))
+			case "pg":
+				binders = append(binders, WithQueryBind(bind.PgArgs{}))
+			case "named":
+				binders = append(binders, WithQueryBind(bind.NamedArgs{}))
 			default:
 				if strings.HasPrefix(transformer, tablePathPrefixTransformer) {
 					prefix, err := extractTablePathPrefixFromBinderName(transformer)
@@ -105,6 +105,30 @@ func TestParse(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			dsn: "grpc://localhost:2135/local?go_query_bind=pg",
+			opts: []config.Option{
+				config.WithSecure(false),
+				config.WithEndpoint("localhost:2135"),
+				config.WithDatabase("/local"),
+			},
+			connectorOpts: []ConnectorOption{
+				WithQueryBind(bind.PgArgs{}),
+			},
+			err: nil,
+		},
+		{
+			dsn: "grpc://localhost:2135/local?go_query_bind=named",
+			opts: []config.Option{
+				config.WithSecure(false),
+				config.WithEndpoint("localhost:2135"),
+				config.WithDatabase("/local"),
+			},
+			connectorOpts: []ConnectorOption{
+				WithQueryBind(bind.NamedArgs{}),
+			},
+			err: nil,
+		},
 		{
 			dsn: "grpc://localhost:2135/local?query_mode=scripting&go_query_bind=table_path_prefix(path/to/tables),positional", //nolint:lll
 			opts: []config.Option{
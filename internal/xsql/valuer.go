@@ -8,6 +8,8 @@ type valuer struct {
 	v interface{}
 }
 
+// UnmarshalYDB stores primitive values as go values and composite values (List, Struct, Dict and others)
+// as types.Value which scans into go slices, structs and maps with ListOf, StructOf and DictOf
 func (v *valuer) UnmarshalYDB(raw scanner.RawValue) error {
 	v.v = raw.Any()
 
@@ -501,6 +501,85 @@ func internalDriver(l Logger, d trace.Detailer) trace.Driver { //nolint:gocyclo
 				)
 			}
 		},
+		OnBalancerEndpointEject: func(
+			info trace.DriverBalancerEndpointEjectStartInfo,
+		) func(
+			trace.DriverBalancerEndpointEjectDoneInfo,
+		) {
+			if d.Details()&trace.DriverBalancerEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, WARN, "ydb", "driver", "balancer", "endpoint", "eject")
+			l.Log(ctx, "ejected",
+				Stringer("endpoint", info.Endpoint),
+				String("reason", info.Reason),
+				Int("ejections", info.Ejections),
+				Duration("duration", info.Duration),
+			)
+
+			return nil
+		},
+		OnBalancerEndpointReadmit: func(
+			info trace.DriverBalancerEndpointReadmitStartInfo,
+		) func(
+			trace.DriverBalancerEndpointReadmitDoneInfo,
+		) {
+			if d.Details()&trace.DriverBalancerEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, INFO, "ydb", "driver", "balancer", "endpoint", "readmit")
+			l.Log(ctx, "readmitted",
+				Stringer("endpoint", info.Endpoint),
+				Duration("slowStart", info.SlowStart),
+			)
+
+			return nil
+		},
+		OnBalancerHedge: func(info trace.DriverBalancerHedgeStartInfo) func(trace.DriverBalancerHedgeDoneInfo) {
+			if d.Details()&trace.DriverBalancerEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, TRACE, "ydb", "driver", "balancer", "hedge")
+			l.Log(ctx, "start",
+				String("method", string(info.Method)),
+				Stringer("endpoint", info.Endpoint),
+				Stringer("hedged", info.Hedged),
+				Duration("delay", info.Delay),
+			)
+			start := time.Now()
+
+			return func(info trace.DriverBalancerHedgeDoneInfo) {
+				if info.Error == nil {
+					l.Log(ctx, "done",
+						latencyField(start),
+						Stringer("winner", info.Winner),
+					)
+				} else {
+					l.Log(WithLevel(ctx, WARN), "failed",
+						latencyField(start),
+						Error(info.Error),
+						versionField(),
+					)
+				}
+			}
+		},
+		OnBalancerCircuitBreakerStateChange: func(
+			info trace.DriverBalancerCircuitBreakerStateChangeStartInfo,
+		) func(
+			trace.DriverBalancerCircuitBreakerStateChangeDoneInfo,
+		) {
+			if d.Details()&trace.DriverBalancerEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, WARN, "ydb", "driver", "balancer", "circuit", "breaker")
+			l.Log(ctx, "state changed",
+				Stringer("endpoint", info.Endpoint),
+				String("from", info.From),
+				String("to", info.To),
+			)
+
+			return nil
+		},
 		OnGetCredentials: func(info trace.DriverGetCredentialsStartInfo) func(trace.DriverGetCredentialsDoneInfo) {
 			if d.Details()&trace.DriverCredentialsEvents == 0 {
 				return nil
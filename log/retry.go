@@ -83,6 +83,47 @@ func internalRetry(l Logger, d trace.Detailer) (t trace.Retry) {
 			}
 		}
 	}
+	t.OnBudget = func(info trace.RetryBudgetStartInfo) func(trace.RetryBudgetDoneInfo) {
+		if d.Details()&trace.RetryEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "retry", "budget")
+		label := info.Label
+
+		return func(info trace.RetryBudgetDoneInfo) {
+			if info.Allowed {
+				l.Log(ctx, "token taken",
+					String("label", label),
+					Any("tokens", info.Tokens),
+					Any("maxTokens", info.MaxTokens),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "exhausted",
+					String("label", label),
+					Any("tokens", info.Tokens),
+					Any("maxTokens", info.MaxTokens),
+				)
+			}
+		}
+	}
+
+	t.OnCircuitBreakerStateChange = func(
+		info trace.RetryCircuitBreakerStateChangeStartInfo,
+	) func(
+		trace.RetryCircuitBreakerStateChangeDoneInfo,
+	) {
+		if d.Details()&trace.RetryEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, WARN, "ydb", "retry", "circuit", "breaker")
+		l.Log(ctx, "state changed",
+			String("label", info.Label),
+			String("from", info.From),
+			String("to", info.To),
+		)
+
+		return nil
+	}
 
 	return t
 }
@@ -18,6 +18,10 @@ func driver(config Config) (t trace.Driver) {
 	banned := config.WithSystem("conn").GaugeVec("banned", "endpoint", "node_id", "cause")
 	requests := config.WithSystem("conn").CounterVec("requests", "status", "method", "endpoint", "node_id")
 	tli := config.CounterVec("transaction_locks_invalidated")
+	ejected := config.WithSystem("balancer").GaugeVec("ejected", "endpoint", "node_id")
+	ejections := config.WithSystem("balancer").CounterVec("ejections", "endpoint", "node_id", "reason")
+	hedges := config.WithSystem("balancer").CounterVec("hedges", "status", "method", "winner")
+	circuitBreaker := config.WithSystem("balancer").GaugeVec("circuit_breaker", "endpoint", "node_id", "state")
 
 	type endpointKey struct {
 		localDC bool
@@ -77,6 +81,79 @@ func driver(config Config) (t trace.Driver) {
 
 		return nil
 	}
+	t.OnBalancerEndpointEject = func(info trace.DriverBalancerEndpointEjectStartInfo) func(
+		trace.DriverBalancerEndpointEjectDoneInfo,
+	) {
+		if config.Details()&trace.DriverBalancerEvents != 0 {
+			ejected.With(map[string]string{
+				"endpoint": info.Endpoint.Address(),
+				"node_id":  idToString(info.Endpoint.NodeID()),
+			}).Set(1)
+			ejections.With(map[string]string{
+				"endpoint": info.Endpoint.Address(),
+				"node_id":  idToString(info.Endpoint.NodeID()),
+				"reason":   info.Reason,
+			}).Inc()
+		}
+
+		return nil
+	}
+	t.OnBalancerEndpointReadmit = func(info trace.DriverBalancerEndpointReadmitStartInfo) func(
+		trace.DriverBalancerEndpointReadmitDoneInfo,
+	) {
+		if config.Details()&trace.DriverBalancerEvents != 0 {
+			ejected.With(map[string]string{
+				"endpoint": info.Endpoint.Address(),
+				"node_id":  idToString(info.Endpoint.NodeID()),
+			}).Set(0)
+		}
+
+		return nil
+	}
+	t.OnBalancerHedge = func(info trace.DriverBalancerHedgeStartInfo) func(trace.DriverBalancerHedgeDoneInfo) {
+		var (
+			method = info.Method
+			hedged = info.Hedged
+		)
+
+		return func(info trace.DriverBalancerHedgeDoneInfo) {
+			if config.Details()&trace.DriverBalancerEvents != 0 {
+				winner := "none"
+				switch {
+				case info.Winner == nil:
+				case info.Winner.Address() == hedged.Address():
+					winner = "hedged"
+				default:
+					winner = "primary"
+				}
+				hedges.With(map[string]string{
+					"status": errorBrief(info.Error),
+					"method": string(method),
+					"winner": winner,
+				}).Inc()
+			}
+		}
+	}
+	t.OnBalancerCircuitBreakerStateChange = func(
+		info trace.DriverBalancerCircuitBreakerStateChangeStartInfo,
+	) func(
+		trace.DriverBalancerCircuitBreakerStateChangeDoneInfo,
+	) {
+		if config.Details()&trace.DriverBalancerEvents != 0 {
+			circuitBreaker.With(map[string]string{
+				"endpoint": info.Endpoint.Address(),
+				"node_id":  idToString(info.Endpoint.NodeID()),
+				"state":    info.From,
+			}).Set(0)
+			circuitBreaker.With(map[string]string{
+				"endpoint": info.Endpoint.Address(),
+				"node_id":  idToString(info.Endpoint.NodeID()),
+				"state":    info.To,
+			}).Set(1)
+		}
+
+		return nil
+	}
 	t.OnBalancerClusterDiscoveryAttempt = func(info trace.DriverBalancerClusterDiscoveryAttemptStartInfo) func(
 		trace.DriverBalancerClusterDiscoveryAttemptDoneInfo,
 	) {
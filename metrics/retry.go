@@ -11,6 +11,9 @@ func retry(config Config) (t trace.Retry) {
 	errs := config.CounterVec("errors", "status", "retry_label", "final")
 	attempts := config.HistogramVec("attempts", []float64{0, 1, 2, 3, 4, 5, 7, 10}, "retry_label")
 	latency := config.TimerVec("latency", "retry_label")
+	budgetTokens := config.GaugeVec("budget_tokens")
+	budgetExhausted := config.CounterVec("budget_exhausted", "retry_label")
+	circuitBreaker := config.GaugeVec("circuit_breaker", "retry_label", "state")
 	t.OnRetry = func(info trace.RetryLoopStartInfo) func(trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
 		label := info.Label
 		if label == "" {
@@ -44,6 +47,39 @@ func retry(config Config) (t trace.Retry) {
 			}
 		}
 	}
+	t.OnBudget = func(info trace.RetryBudgetStartInfo) func(trace.RetryBudgetDoneInfo) {
+		label := info.Label
+
+		return func(info trace.RetryBudgetDoneInfo) {
+			if config.Details()&trace.RetryEvents != 0 {
+				budgetTokens.With(nil).Set(info.Tokens)
+				if !info.Allowed {
+					budgetExhausted.With(map[string]string{
+						"retry_label": label,
+					}).Inc()
+				}
+			}
+		}
+	}
+
+	t.OnCircuitBreakerStateChange = func(
+		info trace.RetryCircuitBreakerStateChangeStartInfo,
+	) func(
+		trace.RetryCircuitBreakerStateChangeDoneInfo,
+	) {
+		if config.Details()&trace.RetryEvents != 0 {
+			circuitBreaker.With(map[string]string{
+				"retry_label": info.Label,
+				"state":       info.From,
+			}).Set(0)
+			circuitBreaker.With(map[string]string{
+				"retry_label": info.Label,
+				"state":       info.To,
+			}).Set(1)
+		}
+
+		return nil
+	}
 
 	return t
 }
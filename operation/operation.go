@@ -0,0 +1,251 @@
+package operation
+
+import (
+	"context"
+	"time"
+)
+
+// Kind is a kind of long-running operation
+type Kind string
+
+const (
+	KindBuildIndex   = Kind("buildindex")
+	KindExportToS3   = Kind("export/s3")
+	KindExportToYT   = Kind("export/yt")
+	KindImportFromS3 = Kind("import/s3")
+	KindScriptExec   = Kind("scriptexec")
+)
+
+type Client interface {
+	// Get returns current state of operation with given id
+	Get(ctx context.Context, id string) (*Operation, error)
+
+	// List returns operations of given kind and token of next page.
+	// Empty token means that there are no more pages.
+	List(ctx context.Context, kind Kind, opts ...ListOption) (_ []*Operation, nextPageToken string, _ error)
+
+	// Cancel starts cancellation of operation with given id
+	Cancel(ctx context.Context, id string) error
+
+	// Forget forgets operation with given id on server side
+	Forget(ctx context.Context, id string) error
+
+	// Wait polls operation with given id with backoff until it becomes ready.
+	// Wait returns ready operation and its status error (the same as Operation.Err)
+	Wait(ctx context.Context, id string, opts ...WaitOption) (*Operation, error)
+}
+
+// Operation is a snapshot of long-running operation state
+type Operation struct {
+	ID    string
+	Ready bool
+
+	// Err is an error with status and issues of ready operation.
+	// Err is nil if operation is not ready yet or completed successfully
+	Err error
+
+	// Metadata is a typed metadata of operation: *BuildIndexMetadata, *ExportMetadata, *ImportMetadata.
+	// Metadata is nil if operation has no metadata or metadata type is unknown
+	Metadata Metadata
+}
+
+// Metadata is a typed metadata of operation
+type Metadata interface {
+	Kind() Kind
+}
+
+// BuildIndexState is a state of index building
+type BuildIndexState int
+
+const (
+	BuildIndexStateUnspecified = BuildIndexState(iota)
+	BuildIndexStatePreparing
+	BuildIndexStateTransferingData
+	BuildIndexStateApplying
+	BuildIndexStateDone
+	BuildIndexStateCancellation
+	BuildIndexStateCancelled
+	BuildIndexStateRejection
+	BuildIndexStateRejected
+)
+
+func (s BuildIndexState) String() string {
+	switch s {
+	case BuildIndexStatePreparing:
+		return "preparing"
+	case BuildIndexStateTransferingData:
+		return "transfering_data"
+	case BuildIndexStateApplying:
+		return "applying"
+	case BuildIndexStateDone:
+		return "done"
+	case BuildIndexStateCancellation:
+		return "cancellation"
+	case BuildIndexStateCancelled:
+		return "cancelled"
+	case BuildIndexStateRejection:
+		return "rejection"
+	case BuildIndexStateRejected:
+		return "rejected"
+	default:
+		return "unspecified"
+	}
+}
+
+// BuildIndexMetadata is a metadata of build index operation
+type BuildIndexMetadata struct {
+	// Path is a path of table
+	Path string
+	// Index is a name of building index
+	Index string
+	State BuildIndexState
+	// Progress is a percent of completion in range [0, 100]
+	Progress float32
+}
+
+func (m *BuildIndexMetadata) Kind() Kind {
+	return KindBuildIndex
+}
+
+// TransferProgress is a progress of export or import operation
+type TransferProgress int
+
+const (
+	TransferProgressUnspecified = TransferProgress(iota)
+	TransferProgressPreparing
+	TransferProgressTransferData
+	TransferProgressBuildIndexes
+	TransferProgressDone
+	TransferProgressCancellation
+	TransferProgressCancelled
+)
+
+func (p TransferProgress) String() string {
+	switch p {
+	case TransferProgressPreparing:
+		return "preparing"
+	case TransferProgressTransferData:
+		return "transfer_data"
+	case TransferProgressBuildIndexes:
+		return "build_indexes"
+	case TransferProgressDone:
+		return "done"
+	case TransferProgressCancellation:
+		return "cancellation"
+	case TransferProgressCancelled:
+		return "cancelled"
+	default:
+		return "unspecified"
+	}
+}
+
+// TransferItemProgress is a progress of export or import of single item
+type TransferItemProgress struct {
+	PartsTotal     uint32
+	PartsCompleted uint32
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+type transferMetadata struct {
+	Progress TransferProgress
+	Items    []TransferItemProgress
+}
+
+// Percent returns summary percent of completed parts of all items in range [0, 100]
+func (m *transferMetadata) Percent() float32 {
+	var total, completed uint64
+	for _, item := range m.Items {
+		total += uint64(item.PartsTotal)
+		completed += uint64(item.PartsCompleted)
+	}
+	if total == 0 {
+		if m.Progress == TransferProgressDone {
+			return 100
+		}
+
+		return 0
+	}
+
+	return float32(completed) * 100 / float32(total)
+}
+
+// ExportMetadata is a metadata of export operation
+type ExportMetadata struct {
+	transferMetadata
+
+	kind Kind
+}
+
+// NewExportMetadata makes export metadata of given kind
+func NewExportMetadata(kind Kind, progress TransferProgress, items ...TransferItemProgress) *ExportMetadata {
+	return &ExportMetadata{
+		transferMetadata: transferMetadata{
+			Progress: progress,
+			Items:    items,
+		},
+		kind: kind,
+	}
+}
+
+func (m *ExportMetadata) Kind() Kind {
+	return m.kind
+}
+
+// ImportMetadata is a metadata of import operation
+type ImportMetadata struct {
+	transferMetadata
+}
+
+// NewImportMetadata makes import metadata
+func NewImportMetadata(progress TransferProgress, items ...TransferItemProgress) *ImportMetadata {
+	return &ImportMetadata{
+		transferMetadata: transferMetadata{
+			Progress: progress,
+			Items:    items,
+		},
+	}
+}
+
+func (m *ImportMetadata) Kind() Kind {
+	return KindImportFromS3
+}
+
+type (
+	// ListOptions is a settings of List request
+	ListOptions struct {
+		PageSize  uint64
+		PageToken string
+	}
+	ListOption func(o *ListOptions)
+)
+
+// WithPageSize limits count of operations in List response
+func WithPageSize(pageSize uint64) ListOption {
+	return func(o *ListOptions) {
+		o.PageSize = pageSize
+	}
+}
+
+// WithPageToken requests page of operations by token from previous List response
+func WithPageToken(pageToken string) ListOption {
+	return func(o *ListOptions) {
+		o.PageToken = pageToken
+	}
+}
+
+type (
+	// WaitOptions is a settings of Wait polling
+	WaitOptions struct {
+		OnProgress func(op *Operation)
+	}
+	WaitOption func(o *WaitOptions)
+)
+
+// WithWaitProgress sets callback which called on each not ready operation state
+// while waiting (for example for reporting of build index progress)
+func WithWaitProgress(onProgress func(op *Operation)) WaitOption {
+	return func(o *WaitOptions) {
+		o.OnProgress = onProgress
+	}
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/certificates"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	coordinationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
+	internalCredentials "github.com/ydb-platform/ydb-go-sdk/v3/internal/credentials"
 	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
+	operationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
 	queryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	ratelimiterConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/config"
 	schemeConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/config"
@@ -25,6 +27,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -129,6 +132,32 @@ func WithEndpoint(endpoint string) Option {
 	}
 }
 
+// WithStaticEndpoints makes balancer over fixed list of endpoints (host:port) without cluster discovery.
+// Balancer with static endpoints uses the same balancing and pessimization logic as with discovery.
+//
+// If driver string have no endpoint - first static endpoint used as starting endpoint.
+// Static endpoints are rediscovered with discovery interval, so banned endpoints are allowed again.
+// With balancers.SingleConn() only first static endpoint is used
+func WithStaticEndpoints(addrs ...string) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithStaticEndpoints(addrs...))
+
+		return nil
+	}
+}
+
+// WithStaticEndpointsResolveInterval enables DNS resolving of static endpoints hosts into IP addresses
+// and refresh of resolved addresses with interval. Every resolved address used as separated endpoint.
+//
+// Warning: resolved endpoints dialed by IP address, so TLS certificates must be valid for IP addresses
+func WithStaticEndpointsResolveInterval(interval time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithStaticEndpointsResolveInterval(interval))
+
+		return nil
+	}
+}
+
 // WithDatabase defines database option
 //
 // Warning: use ydb.Open with required Driver string parameter instead
@@ -204,6 +233,32 @@ func WithAnonymousCredentials() Option {
 	)
 }
 
+// WithServiceAccountKeyFileCredentials applies credentials from authorized key file of service account.
+// IAM token refreshes in background before expiration
+func WithServiceAccountKeyFileCredentials(
+	path string, opts ...internalCredentials.ServiceAccountCredentialsOption,
+) Option {
+	return WithCreateCredentialsFunc(func(context.Context) (credentials.Credentials, error) {
+		return credentials.NewServiceAccountKeyFileCredentials(path,
+			append([]internalCredentials.ServiceAccountCredentialsOption{
+				credentials.WithSourceInfo(fmt.Sprintf("ydb.WithServiceAccountKeyFileCredentials(%q)", path)),
+			}, opts...)...,
+		)
+	})
+}
+
+// WithOAuth2TokenExchangeCredentials applies credentials with OAuth 2.0 token exchange (RFC 8693).
+// Access token refreshes in background before expiration
+func WithOAuth2TokenExchangeCredentials(opts ...internalCredentials.OAuth2TokenExchangeCredentialsOption) Option {
+	return WithCreateCredentialsFunc(func(context.Context) (credentials.Credentials, error) {
+		return credentials.NewOAuth2TokenExchangeCredentials(
+			append([]internalCredentials.OAuth2TokenExchangeCredentialsOption{
+				credentials.WithSourceInfo("ydb.WithOAuth2TokenExchangeCredentials()"),
+			}, opts...)...,
+		)
+	})
+}
+
 // WithCreateCredentialsFunc add callback funcion to provide requests credentials
 func WithCreateCredentialsFunc(createCredentials func(ctx context.Context) (credentials.Credentials, error)) Option {
 	return func(ctx context.Context, c *Driver) error {
@@ -304,6 +359,38 @@ func WithTraceRetry(t trace.Retry, opts ...trace.RetryComposeOption) Option {
 	}
 }
 
+// WithRetryBudget applies shared retry budget to all retry calls of driver (table, query, scheme,
+// scripting, coordination, ratelimiter, topic and database/sql retryers).
+// Retry attempts beyond budget fails fast with retry.ErrBudgetExhausted
+func WithRetryBudget(budget *retry.Budget) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithRetryBudget(budget))
+
+		return nil
+	}
+}
+
+// WithCircuitBreaker applies circuit breaker to all labeled retry calls of driver (see retry.WithLabel,
+// query.WithLabel and table.WithLabel). Calls with label of open circuit breaker fails fast with
+// retry.CircuitBreakerOpenError. Circuit breaker of endpoints configures with balancers.WithCircuitBreaker
+func WithCircuitBreaker(circuitBreaker *retry.CircuitBreaker) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithCircuitBreaker(circuitBreaker))
+
+		return nil
+	}
+}
+
+// WithErrorClassifier applies user-defined classifier of errors to all retry calls of driver.
+// Classifier can override decisions of driver about retrying of errors (see retry.WithErrorClassifier)
+func WithErrorClassifier(classifier func(err error) retry.Decision) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithErrorClassifier(classifier))
+
+		return nil
+	}
+}
+
 // WithCertificate appends certificate to TLS config root certificates
 func WithCertificate(cert *x509.Certificate) Option {
 	return func(ctx context.Context, c *Driver) error {
@@ -620,6 +707,15 @@ func WithRatelimiterOptions(opts ...ratelimiterConfig.Option) Option {
 	}
 }
 
+// WithOperationOptions returns operation client option
+func WithOperationOptions(opts ...operationConfig.Option) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.operationOptions = append(c.operationOptions, opts...)
+
+		return nil
+	}
+}
+
 // WithTraceDiscovery adds configured discovery tracer to Driver
 func WithTraceDiscovery(t trace.Discovery, opts ...trace.DiscoveryComposeOption) Option {
 	return func(ctx context.Context, c *Driver) error {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -66,3 +67,60 @@ func WithTrace(t *trace.Query) bothDoAndDoTxOption {
 func WithLabel(lbl string) bothDoAndDoTxOption {
 	return options.WithLabel(lbl)
 }
+
+// WithRetryOptions applies retry options (such as retry.WithBudget) to Do and DoTx calls
+func WithRetryOptions(opts ...retry.Option) bothDoAndDoTxOption {
+	return options.WithRetryOptions(opts...)
+}
+
+// DoWithResult is a generic variant of Client.Do which returns result of successful operation.
+//
+// Result of failed attempt never returns: on error DoWithResult returns zero value of T
+func DoWithResult[T any](ctx context.Context, c Client, op func(ctx context.Context, s Session) (T, error),
+	opts ...options.DoOption,
+) (T, error) {
+	var result T
+	err := c.Do(ctx, func(ctx context.Context, s Session) error {
+		res, err := op(ctx, s)
+		if err != nil {
+			return err
+		}
+		result = res
+
+		return nil
+	}, opts...)
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return result, nil
+}
+
+// DoTxWithResult is a generic variant of Client.DoTx which returns result of operation
+// within committed transaction.
+//
+// Result of failed attempt (including failed commit) never returns: on error DoTxWithResult
+// returns zero value of T
+func DoTxWithResult[T any](ctx context.Context, c Client, op func(ctx context.Context, tx TxActor) (T, error),
+	opts ...options.DoTxOption,
+) (T, error) {
+	var result T
+	err := c.DoTx(ctx, func(ctx context.Context, tx TxActor) error {
+		res, err := op(ctx, tx)
+		if err != nil {
+			return err
+		}
+		result = res
+
+		return nil
+	}, opts...)
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return result, nil
+}
@@ -68,6 +68,45 @@ func Example_selectWithoutParameters() {
 	fmt.Printf("id=%v, myStr='%s'\n", id, myStr)
 }
 
+func Example_doWithResult() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	// DoWithResult returns result of successful attempt only
+	id, err := query.DoWithResult(ctx, db.Query(),
+		func(ctx context.Context, s query.Session) (id int32, err error) {
+			_, res, err := s.Execute(ctx, `SELECT 42 as id`)
+			if err != nil {
+				return 0, err // for auto-retry with driver
+			}
+			defer func() { _ = res.Close(ctx) }() // cleanup resources
+			rs, err := res.NextResultSet(ctx)
+			if err != nil {
+				return 0, err
+			}
+			row, err := rs.NextRow(ctx)
+			if err != nil {
+				return 0, err
+			}
+			if err = row.Scan(&id); err != nil {
+				return 0, err
+			}
+
+			return id, res.Err()
+		},
+		query.WithIdempotent(),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+	}
+	fmt.Printf("id=%v\n", id)
+}
+
 func Example_selectWithParameters() {
 	ctx := context.TODO()
 	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
@@ -0,0 +1,37 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// WriteJSONLines writes rows of result set into w in JSON lines format (one JSON object per row).
+// Columns of rows are encoded in order of columns in result set, values of columns are encoded
+// as types.MarshalJSON does
+func WriteJSONLines(ctx context.Context, w io.Writer, rs ResultSet) error {
+	for {
+		row, err := rs.NextRow(ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return xerrors.WithStackTrace(err)
+		}
+		marshaler, ok := row.(json.Marshaler)
+		if !ok {
+			return xerrors.WithStackTrace(fmt.Errorf("row %T is not a json.Marshaler", row))
+		}
+		line, err := marshaler.MarshalJSON()
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if _, err = w.Write(append(line, '\n')); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+}
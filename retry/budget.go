@@ -0,0 +1,94 @@
+package retry
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrBudgetExhausted returns from Retry if retry attempt is not allowed by retry budget
+var ErrBudgetExhausted = xerrors.Wrap(errors.New("retry budget exhausted"))
+
+// Budget is a token bucket which limits retry attempts and shares between retry calls
+// (such as retryThrottling policy in gRPC).
+//
+// Every failed attempt with retryable error takes one token from budget and every successful attempt
+// returns tokenRatio tokens into budget. Retry attempts allowed only while count of tokens is greater than
+// half of maxTokens, so under partial outage retries stop before they multiply the load on cluster
+type Budget struct {
+	maxTokens  float64
+	tokenRatio float64
+
+	mu     sync.Mutex
+	tokens float64
+}
+
+// NewBudget creates retry budget with maxTokens capacity.
+// tokenRatio is a count of tokens which returns into budget on every successful attempt
+func NewBudget(maxTokens, tokenRatio float64) *Budget {
+	return &Budget{
+		maxTokens:  maxTokens,
+		tokenRatio: tokenRatio,
+		tokens:     maxTokens,
+	}
+}
+
+// Tokens returns current count of tokens in budget
+func (b *Budget) Tokens() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.tokens
+}
+
+// MaxTokens returns capacity of budget
+func (b *Budget) MaxTokens() float64 {
+	return b.maxTokens
+}
+
+func (b *Budget) onSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens += b.tokenRatio
+	if b.tokens > b.maxTokens {
+		b.tokens = b.maxTokens
+	}
+}
+
+// onFailure takes token from budget and reports whether retry attempt is allowed
+func (b *Budget) onFailure() (tokens float64, allowed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens--
+	if b.tokens < 0 {
+		b.tokens = 0
+	}
+
+	return b.tokens, b.tokens > b.maxTokens/2
+}
+
+var _ Option = budgetOption{}
+
+type budgetOption struct {
+	budget *Budget
+}
+
+func (o budgetOption) ApplyRetryOption(opts *retryOptions) {
+	opts.budget = o.budget
+}
+
+func (o budgetOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithBudget(o.budget))
+}
+
+func (o budgetOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithBudget(o.budget))
+}
+
+// WithBudget applies retry budget to retry operation. Nil budget disables limitation of retry attempts
+func WithBudget(b *Budget) budgetOption {
+	return budgetOption{budget: b}
+}
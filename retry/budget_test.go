@@ -0,0 +1,89 @@
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestBudget(t *testing.T) {
+	b := NewBudget(4, 0.5)
+	require.EqualValues(t, 4, b.Tokens())
+	b.onSuccess()
+	require.EqualValues(t, 4, b.Tokens())
+	tokens, allowed := b.onFailure()
+	require.EqualValues(t, 3, tokens)
+	require.True(t, allowed)
+	tokens, allowed = b.onFailure()
+	require.EqualValues(t, 2, tokens)
+	require.False(t, allowed)
+	b.onSuccess()
+	require.EqualValues(t, 2.5, b.Tokens())
+	for i := 0; i < 10; i++ {
+		_, _ = b.onFailure()
+	}
+	require.EqualValues(t, 0, b.Tokens())
+}
+
+func TestRetryWithBudget(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		budget  = NewBudget(4, 1)
+		backoff = Backoff(time.Microsecond, 0, 0)
+		events  []bool
+		attempt = func(success bool, counter *int) retryOperation {
+			return func(ctx context.Context) error {
+				*counter++
+				if success && *counter > 1 {
+					return nil
+				}
+
+				return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+			}
+		}
+		opts = []Option{
+			WithIdempotent(true),
+			WithBudget(budget),
+			WithFastBackoff(backoff),
+			WithSlowBackoff(backoff),
+			WithTrace(&trace.Retry{
+				OnBudget: func(info trace.RetryBudgetStartInfo) func(trace.RetryBudgetDoneInfo) {
+					return func(info trace.RetryBudgetDoneInfo) {
+						events = append(events, info.Allowed)
+					}
+				},
+			}),
+		}
+	)
+
+	var counter int
+	err := Retry(ctx, attempt(true, &counter), opts...)
+	require.NoError(t, err)
+	require.Equal(t, 2, counter)
+	require.EqualValues(t, 4, budget.Tokens())
+
+	counter = 0
+	err = Retry(ctx, attempt(false, &counter), opts...)
+	require.ErrorIs(t, err, ErrBudgetExhausted)
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED))
+	require.Equal(t, 2, counter)
+	require.Equal(t, []bool{true, true, false}, events)
+
+	// budget is shared between retry calls
+	counter = 0
+	err = Retry(ctx, attempt(false, &counter), opts...)
+	require.ErrorIs(t, err, ErrBudgetExhausted)
+	require.Equal(t, 1, counter)
+
+	// retry calls without budget are not limited
+	counter = 0
+	err = Retry(ctx, attempt(true, &counter), WithIdempotent(true), WithFastBackoff(backoff), WithSlowBackoff(backoff))
+	require.NoError(t, err)
+	require.Equal(t, 2, counter)
+}
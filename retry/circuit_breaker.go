@@ -0,0 +1,212 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/circuitbreaker"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Default settings of circuit breaker
+const (
+	DefaultCircuitBreakerFailureRateThreshold = 0.5
+	DefaultCircuitBreakerMinRequests          = 20
+	DefaultCircuitBreakerInterval             = 10 * time.Second
+	DefaultCircuitBreakerCoolDown             = 5 * time.Second
+	DefaultCircuitBreakerHalfOpenRequests     = 1
+)
+
+// CircuitBreakerOpenError returns if call is rejected by open circuit breaker
+type CircuitBreakerOpenError struct {
+	// Name is a label of retry call or address of endpoint
+	Name string
+	// Until is a time of moving of circuit breaker to half-open state
+	Until time.Time
+}
+
+func (e *CircuitBreakerOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker '%s' is open until %s", e.Name, e.Until.Format(time.RFC3339Nano))
+}
+
+// IsCircuitBreakerOpen checks that err is a rejection of call by open circuit breaker
+func IsCircuitBreakerOpen(err error) bool {
+	var e *CircuitBreakerOpenError
+
+	return xerrors.As(err, &e)
+}
+
+type CircuitBreakerOption func(c *circuitbreaker.Config)
+
+// WithCircuitBreakerFailureRate opens circuit if share of failed calls within interval reaches
+// threshold (in range (0, 1]) and count of calls within interval is not less than minRequests
+func WithCircuitBreakerFailureRate(threshold float64, minRequests int, interval time.Duration) CircuitBreakerOption {
+	return func(c *circuitbreaker.Config) {
+		c.FailureRateThreshold = threshold
+		c.MinRequests = minRequests
+		c.Interval = interval
+	}
+}
+
+// WithCircuitBreakerCoolDown sets duration of open state before moving to half-open state
+func WithCircuitBreakerCoolDown(coolDown time.Duration) CircuitBreakerOption {
+	return func(c *circuitbreaker.Config) {
+		c.CoolDown = coolDown
+	}
+}
+
+// WithCircuitBreakerHalfOpenRequests sets count of probe calls in half-open state.
+// Circuit closes if all probe calls succeeded and opens again on first failed probe call
+func WithCircuitBreakerHalfOpenRequests(n int) CircuitBreakerOption {
+	return func(c *circuitbreaker.Config) {
+		c.HalfOpenRequests = n
+	}
+}
+
+func circuitBreakerConfig(opts ...CircuitBreakerOption) circuitbreaker.Config {
+	config := circuitbreaker.Config{
+		FailureRateThreshold: DefaultCircuitBreakerFailureRateThreshold,
+		MinRequests:          DefaultCircuitBreakerMinRequests,
+		Interval:             DefaultCircuitBreakerInterval,
+		CoolDown:             DefaultCircuitBreakerCoolDown,
+		HalfOpenRequests:     DefaultCircuitBreakerHalfOpenRequests,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&config)
+		}
+	}
+
+	return config
+}
+
+// CircuitBreaker is a set of circuit breakers for labels of retry calls (see WithLabel).
+// Circuit breaker of label opens if failure rate of calls with label crosses threshold,
+// rejects calls with CircuitBreakerOpenError while open and moves to half-open state after cool-down.
+// Retry calls without label are not guarded with circuit breaker
+type CircuitBreaker struct {
+	config circuitbreaker.Config
+
+	mu       sync.Mutex
+	breakers map[string]*circuitbreaker.Breaker
+}
+
+func NewCircuitBreaker(opts ...CircuitBreakerOption) *CircuitBreaker {
+	return &CircuitBreaker{
+		config:   circuitBreakerConfig(opts...),
+		breakers: make(map[string]*circuitbreaker.Breaker),
+	}
+}
+
+// State returns state of circuit breaker of label: closed, open or half-open
+func (cb *CircuitBreaker) State(label string) string {
+	cb.mu.Lock()
+	b, has := cb.breakers[label]
+	cb.mu.Unlock()
+
+	if !has {
+		return circuitbreaker.Closed.String()
+	}
+
+	return b.State().String()
+}
+
+func (cb *CircuitBreaker) get(label string) *circuitbreaker.Breaker {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	b, has := cb.breakers[label]
+	if !has {
+		b = circuitbreaker.New(cb.config)
+		cb.breakers[label] = b
+	}
+
+	return b
+}
+
+var _ Option = circuitBreakerOption{}
+
+type circuitBreakerOption struct {
+	circuitBreaker *CircuitBreaker
+}
+
+func (o circuitBreakerOption) ApplyRetryOption(opts *retryOptions) {
+	opts.circuitBreaker = o.circuitBreaker
+}
+
+func (o circuitBreakerOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithCircuitBreaker(o.circuitBreaker))
+}
+
+func (o circuitBreakerOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithCircuitBreaker(o.circuitBreaker))
+}
+
+// WithCircuitBreaker applies circuit breaker to labeled retry operation. Nil circuit breaker disables guarding
+func WithCircuitBreaker(cb *CircuitBreaker) circuitBreakerOption {
+	return circuitBreakerOption{circuitBreaker: cb}
+}
+
+// labelBreaker is a circuit breaker of retry call label
+type labelBreaker struct {
+	*circuitbreaker.Breaker
+
+	label string
+	opts  *retryOptions
+}
+
+func (opts *retryOptions) labelBreaker() *labelBreaker {
+	if opts.circuitBreaker == nil || opts.label == "" {
+		return nil
+	}
+
+	return &labelBreaker{
+		Breaker: opts.circuitBreaker.get(opts.label),
+		label:   opts.label,
+		opts:    opts,
+	}
+}
+
+func (b *labelBreaker) allow(ctx context.Context) error {
+	allowed, until, t := b.Allow()
+	b.onTransition(ctx, t)
+	if !allowed {
+		return &CircuitBreakerOpenError{
+			Name:  b.label,
+			Until: until,
+		}
+	}
+
+	return nil
+}
+
+func (b *labelBreaker) done(ctx context.Context, err error) {
+	b.onTransition(ctx, b.Done(isCircuitBreakerFailure(err)))
+}
+
+func (b *labelBreaker) onTransition(ctx context.Context, t circuitbreaker.Transition) {
+	if t.Changed() {
+		trace.RetryOnCircuitBreakerStateChange(b.opts.trace, &ctx,
+			b.opts.call, b.label, t.From.String(), t.To.String(),
+		)()
+	}
+}
+
+// isCircuitBreakerFailure checks that error is a failure of cluster (retryable errors
+// such as overload, unavailability and timeouts) and not a logical error of operation
+func isCircuitBreakerFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if xerrors.Is(err, context.Canceled) {
+		return false
+	}
+	if xerrors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	return Check(err).MustRetry(true)
+}
@@ -0,0 +1,80 @@
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestRetryWithCircuitBreaker(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		backoff = Backoff(time.Microsecond, 0, 0)
+		cb      = NewCircuitBreaker(
+			WithCircuitBreakerFailureRate(0.5, 4, time.Minute),
+			WithCircuitBreakerCoolDown(time.Hour),
+		)
+		transitions []string
+		opts        = []Option{
+			WithIdempotent(true),
+			WithCircuitBreaker(cb),
+			WithFastBackoff(backoff),
+			WithSlowBackoff(backoff),
+			WithTrace(&trace.Retry{
+				OnCircuitBreakerStateChange: func(info trace.RetryCircuitBreakerStateChangeStartInfo) func(
+					trace.RetryCircuitBreakerStateChangeDoneInfo,
+				) {
+					transitions = append(transitions, info.Label+":"+info.From+"->"+info.To)
+
+					return nil
+				},
+			}),
+		}
+		counter int
+	)
+	err := Retry(ctx, func(ctx context.Context) error {
+		counter++
+
+		return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+	}, append(opts, WithLabel("a"))...)
+	require.True(t, IsCircuitBreakerOpen(err))
+	require.Equal(t, 4, counter)
+	require.Equal(t, "open", cb.State("a"))
+	require.Equal(t, []string{"a:closed->open"}, transitions)
+
+	// open circuit breaker rejects calls with the same label without attempts
+	counter = 0
+	err = Retry(ctx, func(ctx context.Context) error {
+		counter++
+
+		return nil
+	}, append(opts, WithLabel("a"))...)
+	var openErr *CircuitBreakerOpenError
+	require.ErrorAs(t, err, &openErr)
+	require.Equal(t, "a", openErr.Name)
+	require.Equal(t, 0, counter)
+
+	// circuit breakers of other labels and calls without label are not affected
+	for _, label := range []string{"b", ""} {
+		err = Retry(ctx, func(ctx context.Context) error {
+			return nil
+		}, append(opts, WithLabel(label))...)
+		require.NoError(t, err)
+	}
+	require.Equal(t, "closed", cb.State("b"))
+
+	// non-retryable errors are not failures
+	for i := 0; i < 10; i++ {
+		err = Retry(ctx, func(ctx context.Context) error {
+			return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR))
+		}, append(opts, WithLabel("c"))...)
+		require.False(t, IsCircuitBreakerOpen(err))
+	}
+	require.Equal(t, "closed", cb.State("c"))
+}
@@ -0,0 +1,94 @@
+package retry
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Action is an action of retry loop on error
+type Action int
+
+const (
+	// ActionDefault keeps decision of driver (see Check)
+	ActionDefault = Action(iota)
+	// ActionRetry retries error regardless of idempotency of operation
+	ActionRetry
+	// ActionRetryIdempotent retries error only for idempotent operation
+	ActionRetryIdempotent
+	// ActionNoRetry breaks retry loop on error even for idempotent operation
+	ActionNoRetry
+)
+
+// Decision is a result of user-defined classification of error
+type Decision struct {
+	// Action overrides decision of driver about retrying of error
+	Action Action
+
+	// Backoff replaces backoff before next attempt. If nil - backoff type of driver decision is used
+	// (fast, slow or without backoff)
+	Backoff backoff.Backoff
+
+	// Class is a name of error class. Failed attempts counts per error class for MaxAttempts
+	Class string
+
+	// MaxAttempts caps count of failed attempts with errors of Class within retry call.
+	// Zero means no limit
+	MaxAttempts int
+}
+
+// ErrorClassifier classifies errors of retry operation
+type ErrorClassifier func(err error) Decision
+
+var _ Option = errorClassifierOption{}
+
+type errorClassifierOption struct {
+	classifier ErrorClassifier
+}
+
+func (o errorClassifierOption) ApplyRetryOption(opts *retryOptions) {
+	opts.errorClassifier = o.classifier
+}
+
+func (o errorClassifierOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithErrorClassifier(o.classifier))
+}
+
+func (o errorClassifierOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithErrorClassifier(o.classifier))
+}
+
+// WithErrorClassifier applies user-defined classification of errors to retry operation.
+// Classifier can override decision of driver about retrying of error, make application errors retryable
+// with custom backoff, cap count of attempts per error class or make errors non-retryable even for
+// idempotent operation. Nil classifier keeps decisions of driver
+func WithErrorClassifier(classifier func(err error) Decision) errorClassifierOption {
+	return errorClassifierOption{classifier: classifier}
+}
+
+// apply applies decision of error classifier to retry mode of driver
+func (d Decision) apply(m retryMode) retryMode {
+	switch d.Action {
+	case ActionRetry:
+		m.errType = xerrors.TypeRetryable
+	case ActionRetryIdempotent:
+		m.errType = xerrors.TypeConditionallyRetryable
+	case ActionNoRetry:
+		m.errType = xerrors.TypeNonRetryable
+	case ActionDefault:
+	}
+
+	return m
+}
+
+// classAttempts counts failed attempts per error class within retry call
+type classAttempts map[string]int
+
+// exceeded accounts failed attempt with error of decision class and checks that attempts limit of class exceeded
+func (a classAttempts) exceeded(d Decision) bool {
+	if d.MaxAttempts <= 0 {
+		return false
+	}
+	a[d.Class]++
+
+	return a[d.Class] >= d.MaxAttempts
+}
@@ -0,0 +1,95 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errApplication = errors.New("application error")
+
+func TestRetryWithErrorClassifier(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		overloaded = xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+		backoff    = Backoff(time.Microsecond, 0, 0)
+		classifier = func(err error) Decision {
+			switch {
+			case errors.Is(err, errApplication):
+				return Decision{
+					Action:  ActionRetry,
+					Backoff: backoff,
+				}
+			case xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED):
+				return Decision{
+					Class:       "overloaded",
+					MaxAttempts: 3,
+				}
+			case xerrors.IsOperationError(err, Ydb.StatusIds_UNAVAILABLE):
+				return Decision{
+					Action: ActionNoRetry,
+				}
+			default:
+				return Decision{}
+			}
+		}
+		opts = []Option{
+			WithErrorClassifier(classifier),
+			WithFastBackoff(backoff),
+			WithSlowBackoff(backoff),
+		}
+	)
+	t.Run("RetryableApplicationError", func(t *testing.T) {
+		counter := 0
+		err := Retry(ctx, func(ctx context.Context) error {
+			counter++
+			if counter < 3 {
+				return errApplication
+			}
+
+			return nil
+		}, opts...)
+		require.NoError(t, err)
+		require.Equal(t, 3, counter)
+	})
+	t.Run("AttemptsLimitOfClass", func(t *testing.T) {
+		counter := 0
+		err := Retry(ctx, func(ctx context.Context) error {
+			counter++
+
+			return overloaded
+		}, append(opts, WithIdempotent(true))...)
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED))
+		require.Equal(t, 3, counter)
+	})
+	t.Run("NonRetryableIdempotent", func(t *testing.T) {
+		counter := 0
+		err := Retry(ctx, func(ctx context.Context) error {
+			counter++
+
+			return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+		}, append(opts, WithIdempotent(true))...)
+		require.Error(t, err)
+		require.Equal(t, 1, counter)
+	})
+	t.Run("DefaultDecision", func(t *testing.T) {
+		counter := 0
+		err := Retry(ctx, func(ctx context.Context) error {
+			counter++
+			if counter < 3 {
+				return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_ABORTED))
+			}
+
+			return nil
+		}, opts...)
+		require.NoError(t, err)
+		require.Equal(t, 3, counter)
+	})
+}
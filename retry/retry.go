@@ -25,6 +25,11 @@ type retryOptions struct {
 	stackTrace  bool
 	fastBackoff backoff.Backoff
 	slowBackoff backoff.Backoff
+	budget      *Budget
+
+	errorClassifier ErrorClassifier
+
+	circuitBreaker *CircuitBreaker
 
 	panicCallback func(e interface{})
 }
@@ -260,6 +265,9 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 		onIntermediate = trace.RetryOnRetry(options.trace, &ctx,
 			options.call, options.label, options.idempotent, xcontext.IsNestedCall(ctx),
 		)
+		breaker = options.labelBreaker()
+
+		classAttempts = classAttempts{}
 	)
 	defer func() {
 		onIntermediate(finalErr)(attempts, finalErr)
@@ -276,6 +284,14 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 			)
 
 		default:
+			if breaker != nil {
+				if err := breaker.allow(ctx); err != nil {
+					return xerrors.WithStackTrace(
+						fmt.Errorf("attempt No.%d rejected: %w", attempts, err),
+					)
+				}
+			}
+
 			err := func() (err error) {
 				if options.panicCallback != nil {
 					defer func() {
@@ -291,7 +307,15 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 				return op(ctx)
 			}()
 
+			if breaker != nil {
+				breaker.done(ctx, err)
+			}
+
 			if err == nil {
+				if options.budget != nil {
+					options.budget.onSuccess()
+				}
+
 				return nil
 			}
 
@@ -306,6 +330,12 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 
 			m := Check(err)
 
+			var decision Decision
+			if options.errorClassifier != nil {
+				decision = options.errorClassifier(err)
+				m = decision.apply(m)
+			}
+
 			if m.StatusCode() != code {
 				i = 0
 			}
@@ -318,7 +348,24 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 				)
 			}
 
-			if e := wait.Wait(ctx, options.fastBackoff, options.slowBackoff, m.BackoffType(), i); e != nil {
+			if classAttempts.exceeded(decision) {
+				return xerrors.WithStackTrace(
+					fmt.Errorf("attempts limit (%d) of error class '%s' exceeded on attempt No.%d: %w",
+						decision.MaxAttempts, decision.Class, attempts, err,
+					),
+				)
+			}
+
+			if options.budget != nil && !options.takeBudget(ctx) {
+				return xerrors.WithStackTrace(
+					xerrors.Join(
+						fmt.Errorf("retry attempt No.%d is not allowed: %w", attempts+1, ErrBudgetExhausted),
+						err,
+					),
+				)
+			}
+
+			if e := options.wait(ctx, m, decision, i); e != nil {
 				return xerrors.WithStackTrace(
 					xerrors.Join(
 						fmt.Errorf("wait exit on attempt No.%d",
@@ -335,6 +382,48 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 	}
 }
 
+// wait waits backoff before next attempt. Backoff of error classifier decision overrides backoffs of retry call
+func (opts *retryOptions) wait(ctx context.Context, m retryMode, decision Decision, i int) error {
+	if decision.Backoff != nil {
+		return wait.Wait(ctx, decision.Backoff, decision.Backoff, backoff.TypeFast, i)
+	}
+
+	return wait.Wait(ctx, opts.fastBackoff, opts.slowBackoff, m.BackoffType(), i)
+}
+
+// RetryWithResult is a generic variant of Retry which returns result of successful attempt.
+//
+// RetryWithResult has the same idempotency, backoff and trace semantics as Retry.
+// Result of failed attempt never returns: on error RetryWithResult returns zero value of T
+func RetryWithResult[T any](ctx context.Context, op func(context.Context) (T, error), opts ...Option) (T, error) {
+	var result T
+	err := Retry(ctx, func(ctx context.Context) error {
+		res, err := op(ctx)
+		if err != nil {
+			return err
+		}
+		result = res
+
+		return nil
+	}, append([]Option{withCaller(stack.FunctionID(""))}, opts...)...)
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return result, nil
+}
+
+// takeBudget takes token from retry budget and reports whether next retry attempt is allowed
+func (opts *retryOptions) takeBudget(ctx context.Context) bool {
+	onDone := trace.RetryOnBudget(opts.trace, &ctx, opts.call, opts.label)
+	tokens, allowed := opts.budget.onFailure()
+	onDone(tokens, opts.budget.MaxTokens(), allowed)
+
+	return allowed
+}
+
 // Check returns retry mode for queryErr.
 func Check(err error) (m retryMode) {
 	code, errType, backoffType, deleteSession := xerrors.Check(err)
@@ -187,3 +187,32 @@ func TestRetryTransportCancelled(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryWithResult(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Success", func(t *testing.T) {
+		counter := 0
+		v, err := RetryWithResult(ctx, func(ctx context.Context) (int, error) {
+			counter++
+			if counter < 3 {
+				return counter, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+			}
+
+			return counter * 10, nil
+		}, WithIdempotent(true))
+		require.NoError(t, err)
+		require.Equal(t, 30, v)
+		require.Equal(t, 3, counter)
+	})
+	t.Run("NonRetryable", func(t *testing.T) {
+		counter := 0
+		v, err := RetryWithResult(ctx, func(ctx context.Context) (string, error) {
+			counter++
+
+			return "partial", fmt.Errorf("non-retryable error")
+		}, WithIdempotent(false))
+		require.Error(t, err)
+		require.Empty(t, v)
+		require.Equal(t, 1, counter)
+	})
+}
@@ -37,23 +37,41 @@ func WithDoRetryOptions(opts ...Option) doRetryOptionsOption {
 	return opts
 }
 
+// driverRetryOptions returns retry options defined by driver of db (trace, budget, circuit breaker and
+// error classifier). Options are placed before caller options, so caller options override them
+func driverRetryOptions(db *sql.DB) (opts []Option) {
+	if classifier, has := db.Driver().(interface {
+		ErrorClassifier() ErrorClassifier
+	}); has {
+		opts = append(opts, WithErrorClassifier(classifier.ErrorClassifier()))
+	}
+	if breaker, has := db.Driver().(interface {
+		CircuitBreaker() *CircuitBreaker
+	}); has {
+		opts = append(opts, WithCircuitBreaker(breaker.CircuitBreaker()))
+	}
+	if budgeter, has := db.Driver().(interface {
+		RetryBudget() *Budget
+	}); has {
+		opts = append(opts, WithBudget(budgeter.RetryBudget()))
+	}
+	if tracer, has := db.Driver().(interface {
+		TraceRetry() *trace.Retry
+	}); has {
+		opts = append(opts, WithTrace(tracer.TraceRetry()))
+	}
+
+	return opts
+}
+
 // Do is a retryer of database/sql Conn with fallbacks on errors
 func Do(ctx context.Context, db *sql.DB, op func(ctx context.Context, cc *sql.Conn) error, opts ...doOption) error {
 	var (
 		options = doOptions{
-			retryOptions: []Option{
-				withCaller(stack.FunctionID("")),
-			},
+			retryOptions: append(driverRetryOptions(db), withCaller(stack.FunctionID(""))),
 		}
 		attempts = 0
 	)
-	if tracer, has := db.Driver().(interface {
-		TraceRetry() *trace.Retry
-	}); has {
-		options.retryOptions = append(options.retryOptions, nil)
-		copy(options.retryOptions[1:], options.retryOptions)
-		options.retryOptions[0] = WithTrace(tracer.TraceRetry())
-	}
 	for _, opt := range opts {
 		if opt != nil {
 			opt.ApplyDoOption(&options)
@@ -128,9 +146,7 @@ func WithTxOptions(txOptions *sql.TxOptions) txOptionsOption {
 func DoTx(ctx context.Context, db *sql.DB, op func(context.Context, *sql.Tx) error, opts ...doTxOption) error {
 	var (
 		options = doTxOptions{
-			retryOptions: []Option{
-				withCaller(stack.FunctionID("")),
-			},
+			retryOptions: append(driverRetryOptions(db), withCaller(stack.FunctionID(""))),
 			txOptions: &sql.TxOptions{
 				Isolation: sql.LevelDefault,
 				ReadOnly:  false,
@@ -138,13 +154,6 @@ func DoTx(ctx context.Context, db *sql.DB, op func(context.Context, *sql.Tx) err
 		}
 		attempts = 0
 	)
-	if tracer, has := db.Driver().(interface {
-		TraceRetry() *trace.Retry
-	}); has {
-		options.retryOptions = append(options.retryOptions, nil)
-		copy(options.retryOptions[1:], options.retryOptions)
-		options.retryOptions[0] = WithTrace(tracer.TraceRetry())
-	}
 	for _, opt := range opts {
 		if opt != nil {
 			opt.ApplyDoTxOption(&options)
@@ -0,0 +1,263 @@
+package scheme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const sysDirectory = ".sys"
+
+var (
+	// SkipDir is used as a return value from WalkFunc to indicate that
+	// the directory named in the call is to be skipped
+	SkipDir = errors.New("skip this directory") //nolint:revive,stylecheck
+
+	// SkipAll is used as a return value from WalkFunc to indicate that
+	// all remaining entries are to be skipped
+	SkipAll = errors.New("skip everything and stop the walk") //nolint:revive,stylecheck
+)
+
+// WalkFunc is the type of the function called by Walk to visit each entry.
+//
+// The path argument contains the absolute path of entry.
+// If the function returns SkipDir for a directory - Walk skips the directory's contents.
+// SkipDir returned for a non-directory entry is ignored.
+// If the function returns SkipAll - Walk stops and returns nil.
+// Any other non-nil error stops Walk and returns as Walk result.
+type WalkFunc func(ctx context.Context, path string, e Entry) error
+
+// WalkClient is a part of Client which Walk uses for traversal of scheme tree
+type WalkClient interface {
+	DescribePath(ctx context.Context, path string) (e Entry, err error)
+	ListDirectory(ctx context.Context, path string) (d Directory, err error)
+}
+
+var _ WalkClient = Client(nil)
+
+type walkOptions struct {
+	entryTypes  map[EntryType]struct{}
+	skipSystem  bool
+	concurrency int
+}
+
+type WalkOption func(o *walkOptions)
+
+// WithWalkEntryTypes restricts calls of WalkFunc to entries with given types.
+// Directories are traversed regardless of filter
+func WithWalkEntryTypes(types ...EntryType) WalkOption {
+	return func(o *walkOptions) {
+		if o.entryTypes == nil {
+			o.entryTypes = make(map[EntryType]struct{}, len(types))
+		}
+		for _, t := range types {
+			o.entryTypes[t] = struct{}{}
+		}
+	}
+}
+
+// WithWalkSkipSystemDirectories excludes `.sys` directories from walk
+func WithWalkSkipSystemDirectories() WalkOption {
+	return func(o *walkOptions) {
+		o.skipSystem = true
+	}
+}
+
+// WithWalkConcurrency defines max number of goroutines (and concurrent ListDirectory calls) of walk.
+// WalkFunc calls are serialized regardless of concurrency.
+// With concurrency greater than 1 the order of entries is not defined
+func WithWalkConcurrency(concurrency int) WalkOption {
+	return func(o *walkOptions) {
+		if concurrency > 0 {
+			o.concurrency = concurrency
+		}
+	}
+}
+
+func (o *walkOptions) match(t EntryType) bool {
+	if len(o.entryTypes) == 0 {
+		return true
+	}
+	_, has := o.entryTypes[t]
+
+	return has
+}
+
+func isWalkable(t EntryType) bool {
+	switch t {
+	case EntryDirectory, EntryDatabase, EntryColumnStore:
+		return true
+	default:
+		return false
+	}
+}
+
+type walker struct {
+	client  WalkClient
+	fn      WalkFunc
+	opts    walkOptions
+	fnMtx   sync.Mutex
+	sem     chan struct{}
+	wg      sync.WaitGroup
+	errOnce sync.Once
+	err     error
+	cancel  context.CancelFunc
+}
+
+// Walk walks the scheme tree rooted at root (absolute path), calling fn for each entry
+// in the tree, including root
+//
+// Walk does not follow into `.sys` directories if WithWalkSkipSystemDirectories option provided
+func Walk(ctx context.Context, client WalkClient, root string, fn WalkFunc, opts ...WalkOption) error {
+	w := &walker{
+		client: client,
+		fn:     fn,
+		opts: walkOptions{
+			concurrency: 1,
+		},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&w.opts)
+		}
+	}
+	entry, err := client.DescribePath(ctx, root)
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("cannot describe path %q: %w", root, err))
+	}
+
+	ctx, w.cancel = context.WithCancel(ctx)
+	defer w.cancel()
+
+	if w.opts.concurrency == 1 {
+		err = w.visit(ctx, root, entry)
+	} else {
+		// current goroutine is a first worker of walk
+		w.sem = make(chan struct{}, w.opts.concurrency-1)
+		w.walkAsync(ctx, root, entry)
+		w.wg.Wait()
+		err = w.err
+	}
+
+	if errors.Is(err, SkipAll) || errors.Is(err, SkipDir) {
+		return nil
+	}
+
+	return err
+}
+
+func (w *walker) call(ctx context.Context, p string, e Entry) error {
+	if !w.opts.match(e.Type) {
+		return nil
+	}
+
+	w.fnMtx.Lock()
+	defer w.fnMtx.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return w.fn(ctx, p, e)
+}
+
+func (w *walker) list(ctx context.Context, p string) (Directory, error) {
+	if err := ctx.Err(); err != nil {
+		return Directory{}, xerrors.WithStackTrace(err)
+	}
+
+	d, err := w.client.ListDirectory(ctx, p)
+	if err != nil {
+		return d, xerrors.WithStackTrace(fmt.Errorf("listing directory %q failed: %w", p, err))
+	}
+
+	return d, nil
+}
+
+func (w *walker) skip(e *Entry) bool {
+	return w.opts.skipSystem && e.Name == sysDirectory && isWalkable(e.Type)
+}
+
+// visit walks sequentially in depth-first order
+func (w *walker) visit(ctx context.Context, p string, e Entry) error {
+	if err := w.call(ctx, p, e); err != nil {
+		return err
+	}
+	if !isWalkable(e.Type) {
+		return nil
+	}
+
+	d, err := w.list(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	for i := range d.Children {
+		child := d.Children[i]
+		if w.skip(&child) {
+			continue
+		}
+		if err = w.visit(ctx, path.Join(p, child.Name), child); err != nil {
+			if errors.Is(err, SkipDir) {
+				continue
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *walker) fail(err error) {
+	w.errOnce.Do(func() {
+		w.err = err
+		w.cancel()
+	})
+}
+
+// walkAsync walks concurrently. Children are walked in new goroutines while number of goroutines
+// is less than concurrency, otherwise in current goroutine
+func (w *walker) walkAsync(ctx context.Context, p string, e Entry) {
+	if err := w.call(ctx, p, e); err != nil {
+		if !errors.Is(err, SkipDir) {
+			w.fail(err)
+		}
+
+		return
+	}
+	if !isWalkable(e.Type) {
+		return
+	}
+
+	d, err := w.list(ctx, p)
+	if err != nil {
+		w.fail(err)
+
+		return
+	}
+
+	for i := range d.Children {
+		child := d.Children[i]
+		if w.skip(&child) {
+			continue
+		}
+		select {
+		case w.sem <- struct{}{}:
+			w.wg.Add(1)
+			go func(p string, e Entry) {
+				defer func() {
+					<-w.sem
+					w.wg.Done()
+				}()
+				w.walkAsync(ctx, p, e)
+			}(path.Join(p, child.Name), child)
+		default:
+			w.walkAsync(ctx, path.Join(p, child.Name), child)
+		}
+	}
+}
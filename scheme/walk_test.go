@@ -0,0 +1,255 @@
+package scheme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type walkClientMock map[string][]Entry
+
+func (m walkClientMock) DescribePath(ctx context.Context, p string) (e Entry, err error) {
+	if p == "/local" {
+		return Entry{Name: "local", Type: EntryDatabase}, nil
+	}
+	dir, name := path.Split(p)
+	for _, child := range m[path.Clean(dir)] {
+		if child.Name == name {
+			return child, nil
+		}
+	}
+
+	return e, fmt.Errorf("path %q not found", p)
+}
+
+func (m walkClientMock) ListDirectory(ctx context.Context, p string) (d Directory, err error) {
+	children, has := m[p]
+	if !has {
+		return d, fmt.Errorf("directory %q not found", p)
+	}
+
+	return Directory{Children: children}, nil
+}
+
+var testTree = walkClientMock{
+	"/local": {
+		{Name: ".sys", Type: EntryDirectory},
+		{Name: "a", Type: EntryDirectory},
+		{Name: "t1", Type: EntryTable},
+		{Name: "topic", Type: EntryTopic},
+	},
+	"/local/.sys": {
+		{Name: "partition_stats", Type: EntryTable},
+	},
+	"/local/a": {
+		{Name: "b", Type: EntryDirectory},
+		{Name: "t2", Type: EntryColumnTable},
+	},
+	"/local/a/b": {
+		{Name: "t3", Type: EntryTable},
+	},
+}
+
+func TestWalk(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		root  string
+		fn    func(p string, e Entry) error
+		opts  []WalkOption
+		paths []string
+		err   bool
+	}{
+		{
+			name: "All",
+			root: "/local",
+			paths: []string{
+				"/local",
+				"/local/.sys",
+				"/local/.sys/partition_stats",
+				"/local/a",
+				"/local/a/b",
+				"/local/a/b/t3",
+				"/local/a/t2",
+				"/local/t1",
+				"/local/topic",
+			},
+		},
+		{
+			name: "SkipSystem",
+			root: "/local",
+			opts: []WalkOption{WithWalkSkipSystemDirectories()},
+			paths: []string{
+				"/local",
+				"/local/a",
+				"/local/a/b",
+				"/local/a/b/t3",
+				"/local/a/t2",
+				"/local/t1",
+				"/local/topic",
+			},
+		},
+		{
+			name: "EntryTypes",
+			root: "/local",
+			opts: []WalkOption{
+				WithWalkSkipSystemDirectories(),
+				WithWalkEntryTypes(EntryTable, EntryColumnTable),
+			},
+			paths: []string{
+				"/local/a/b/t3",
+				"/local/a/t2",
+				"/local/t1",
+			},
+		},
+		{
+			name: "SubDirectory",
+			root: "/local/a",
+			paths: []string{
+				"/local/a",
+				"/local/a/b",
+				"/local/a/b/t3",
+				"/local/a/t2",
+			},
+		},
+		{
+			name: "SkipDir",
+			root: "/local",
+			opts: []WalkOption{WithWalkSkipSystemDirectories()},
+			fn: func(p string, e Entry) error {
+				if p == "/local/a" {
+					return SkipDir
+				}
+
+				return nil
+			},
+			paths: []string{
+				"/local",
+				"/local/a",
+				"/local/t1",
+				"/local/topic",
+			},
+		},
+		{
+			name: "SkipAll",
+			root: "/local",
+			opts: []WalkOption{WithWalkSkipSystemDirectories()},
+			fn: func(p string, e Entry) error {
+				if p == "/local/a/b" {
+					return SkipAll
+				}
+
+				return nil
+			},
+			paths: []string{
+				"/local",
+				"/local/a",
+				"/local/a/b",
+			},
+		},
+		{
+			name: "Error",
+			root: "/local",
+			fn: func(p string, e Entry) error {
+				if p == "/local/a/t2" {
+					return errors.New("test")
+				}
+
+				return nil
+			},
+			err: true,
+		},
+		{
+			name: "NotFound",
+			root: "/local/c",
+			err:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, concurrency := range []int{1, 4} {
+				t.Run(fmt.Sprintf("concurrency=%d", concurrency), func(t *testing.T) {
+					var (
+						mtx   sync.Mutex
+						paths []string
+					)
+					err := Walk(context.Background(), testTree, tt.root, func(ctx context.Context, p string, e Entry) error {
+						mtx.Lock()
+						paths = append(paths, p)
+						mtx.Unlock()
+						if tt.fn != nil {
+							return tt.fn(p, e)
+						}
+
+						return nil
+					}, append(tt.opts, WithWalkConcurrency(concurrency))...)
+					if tt.err {
+						require.Error(t, err)
+
+						return
+					}
+					require.NoError(t, err)
+					if concurrency > 1 {
+						sort.Strings(paths)
+						if tt.name == "SkipAll" {
+							// order of visits is not defined with concurrency
+							require.Contains(t, paths, "/local/a/b")
+
+							return
+						}
+					}
+					require.Equal(t, tt.paths, paths)
+				})
+			}
+		})
+	}
+}
+
+type walkClientConcurrencyMock struct {
+	walkClientMock
+	mtx            sync.Mutex
+	inFlight, peak int
+}
+
+func (m *walkClientConcurrencyMock) ListDirectory(ctx context.Context, p string) (d Directory, err error) {
+	m.mtx.Lock()
+	m.inFlight++
+	if m.inFlight > m.peak {
+		m.peak = m.inFlight
+	}
+	m.mtx.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	m.mtx.Lock()
+	m.inFlight--
+	m.mtx.Unlock()
+
+	return m.walkClientMock.ListDirectory(ctx, p)
+}
+
+func TestWalkConcurrencyLimit(t *testing.T) {
+	client := &walkClientConcurrencyMock{
+		walkClientMock: walkClientMock{},
+	}
+	for i := 0; i < 50; i++ {
+		dir := fmt.Sprintf("d%d", i)
+		client.walkClientMock["/local"] = append(client.walkClientMock["/local"], Entry{Name: dir, Type: EntryDirectory})
+		client.walkClientMock["/local/"+dir] = []Entry{{Name: "t", Type: EntryTable}}
+	}
+	var visited int
+	err := Walk(context.Background(), client, "/local", func(ctx context.Context, p string, e Entry) error {
+		visited++
+
+		return nil
+	}, WithWalkConcurrency(3))
+	require.NoError(t, err)
+	require.Equal(t, 101, visited)
+	require.LessOrEqual(t, client.peak, 3)
+	require.Greater(t, client.peak, 1)
+}
@@ -129,6 +129,43 @@ func WithNumericArgs() QueryBindConnectorOption {
 	return xsql.WithQueryBind(bind.NumericArgs{})
 }
 
+// WithPgArgs enables queries in PostgreSQL syntax: placeholders $1, $2, ... are bound
+// with args as values of postgres types (such as pgint8 for int64 or pgtext for string)
+func WithPgArgs() QueryBindConnectorOption {
+	return xsql.WithQueryBind(bind.PgArgs{})
+}
+
+// WithNamedArgs enables named placeholders @name and :name which are bound with named args (sql.Named)
+// as YQL parameters $name. Types of parameters are declared automatically
+func WithNamedArgs() QueryBindConnectorOption {
+	return xsql.WithQueryBind(bind.NamedArgs{})
+}
+
+// CompositeValue binds go value as query arg and scans YDB value into go value with database/sql
+type CompositeValue interface {
+	sql.Scanner
+	driver.Valuer
+}
+
+// ListOf makes CompositeValue for binding and scanning values of type List with pointer to slice, such as:
+//
+//	var ids []uint64
+//	err := db.QueryRowContext(ctx, "SELECT AsList(1ul, 2ul)").Scan(ydb.ListOf(&ids))
+func ListOf(dst interface{}) CompositeValue {
+	return xsql.ListOf(dst)
+}
+
+// StructOf makes CompositeValue for binding and scanning values of type Struct with pointer to struct.
+// Names of members of struct value are taken from `sql` tag or names of fields
+func StructOf(dst interface{}) CompositeValue {
+	return xsql.StructOf(dst)
+}
+
+// DictOf makes CompositeValue for binding and scanning values of type Dict (or Set) with pointer to map
+func DictOf(dst interface{}) CompositeValue {
+	return xsql.DictOf(dst)
+}
+
 func WithDefaultTxControl(txControl *table.TransactionControl) ConnectorOption {
 	return xsql.WithDefaultTxControl(txControl)
 }
@@ -167,6 +204,9 @@ func Connector(parent *Driver, opts ...ConnectorOption) (SQLConnector, error) {
 			),
 			xsql.WithOnClose(d.detach),
 			xsql.WithTraceRetry(parent.config.TraceRetry()),
+			xsql.WithRetryBudget(parent.config.RetryBudget()),
+			xsql.WithCircuitBreaker(parent.config.CircuitBreaker()),
+			xsql.WithErrorClassifier(parent.config.ErrorClassifier()),
 		)...,
 	)
 	if err != nil {
@@ -0,0 +1,994 @@
+package sugar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+// SchemaVersion is a version of Schema file format
+const SchemaVersion = 1
+
+const (
+	storeRow    = "row"
+	storeColumn = "column"
+)
+
+type dbCoordination interface {
+	Coordination() coordination.Client
+}
+
+type dbRatelimiter interface {
+	Ratelimiter() ratelimiter.Client
+}
+
+type dbForSchema interface {
+	dbName
+	dbScheme
+	dbTable
+	dbTopic
+	dbCoordination
+	dbRatelimiter
+}
+
+// Schema is a portable snapshot of database scheme objects
+//
+// Schema is serializable with encoding/json and gopkg.in/yaml.v3 (YAML representation has the same fields
+// as JSON). All paths inside Schema are relative to the dumped root, all lists are sorted by path,
+// so the result of json.Marshal and yaml.Marshal is stable for the same database scheme.
+type Schema struct {
+	Version           int                      `json:"version"`
+	Directories       []string                 `json:"directories,omitempty"`
+	Tables            []SchemaTable            `json:"tables,omitempty"`
+	Topics            []SchemaTopic            `json:"topics,omitempty"`
+	CoordinationNodes []SchemaCoordinationNode `json:"coordination_nodes,omitempty"`
+}
+
+// MarshalYAML implements yaml.Marshaler. YAML document is made from JSON representation of Schema
+// for keeping the same names and order of fields
+func (s Schema) MarshalYAML() (interface{}, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	var node yaml.Node
+	// JSON is a subset of YAML
+	if err = yaml.Unmarshal(data, &node); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	resetYAMLStyle(&node)
+
+	// content of document node
+	return node.Content[0], nil
+}
+
+// resetYAMLStyle makes block style instead of JSON-like flow style of parsed JSON document
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		resetYAMLStyle(n)
+	}
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (s *Schema) UnmarshalYAML(node *yaml.Node) error {
+	var v interface{}
+	if err := node.Decode(&v); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if err = json.Unmarshal(data, s); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type SchemaTable struct {
+	Path                 string                      `json:"path"`
+	Store                string                      `json:"store"`
+	Columns              []SchemaColumn              `json:"columns"`
+	PrimaryKey           []string                    `json:"primary_key"`
+	Indexes              []SchemaIndex               `json:"indexes,omitempty"`
+	ColumnFamilies       []SchemaColumnFamily        `json:"column_families,omitempty"`
+	Attributes           map[string]string           `json:"attributes,omitempty"`
+	PartitioningSettings *SchemaPartitioningSettings `json:"partitioning_settings,omitempty"`
+	ReadReplicas         *SchemaReadReplicas         `json:"read_replicas,omitempty"`
+	KeyBloomFilter       string                      `json:"key_bloom_filter,omitempty"`
+	TimeToLive           *SchemaTimeToLive           `json:"time_to_live,omitempty"`
+	Changefeeds          []SchemaChangefeed          `json:"changefeeds,omitempty"`
+}
+
+// SchemaColumn describes table column
+//
+// Type is a JSON representation of Ydb.Type protobuf message
+type SchemaColumn struct {
+	Name   string          `json:"name"`
+	Type   json.RawMessage `json:"type"`
+	Family string          `json:"family,omitempty"`
+}
+
+type SchemaIndex struct {
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	IndexColumns []string `json:"index_columns"`
+	DataColumns  []string `json:"data_columns,omitempty"`
+}
+
+type SchemaColumnFamily struct {
+	Name         string `json:"name"`
+	Media        string `json:"media,omitempty"`
+	Compression  string `json:"compression,omitempty"`
+	KeepInMemory string `json:"keep_in_memory,omitempty"`
+}
+
+type SchemaPartitioningSettings struct {
+	PartitioningBySize string `json:"partitioning_by_size,omitempty"`
+	PartitionSizeMb    uint64 `json:"partition_size_mb,omitempty"`
+	PartitioningByLoad string `json:"partitioning_by_load,omitempty"`
+	MinPartitionsCount uint64 `json:"min_partitions_count,omitempty"`
+	MaxPartitionsCount uint64 `json:"max_partitions_count,omitempty"`
+}
+
+type SchemaReadReplicas struct {
+	Type  string `json:"type"`
+	Count uint64 `json:"count"`
+}
+
+type SchemaTimeToLive struct {
+	ColumnName         string `json:"column_name"`
+	Mode               string `json:"mode"`
+	Unit               string `json:"unit,omitempty"`
+	ExpireAfterSeconds uint32 `json:"expire_after_seconds"`
+}
+
+type SchemaChangefeed struct {
+	Name              string            `json:"name"`
+	Mode              string            `json:"mode"`
+	Format            string            `json:"format"`
+	VirtualTimestamps bool              `json:"virtual_timestamps,omitempty"`
+	RetentionPeriod   string            `json:"retention_period,omitempty"`
+	Attributes        map[string]string `json:"attributes,omitempty"`
+	Consumers         []SchemaConsumer  `json:"consumers,omitempty"`
+}
+
+type SchemaTopic struct {
+	Path                              string            `json:"path"`
+	MinActivePartitions               int64             `json:"min_active_partitions,omitempty"`
+	PartitionCountLimit               int64             `json:"partition_count_limit,omitempty"`
+	RetentionPeriod                   string            `json:"retention_period,omitempty"`
+	RetentionStorageMB                int64             `json:"retention_storage_mb,omitempty"`
+	SupportedCodecs                   []int32           `json:"supported_codecs,omitempty"`
+	PartitionWriteSpeedBytesPerSecond int64             `json:"partition_write_speed_bytes_per_second,omitempty"`
+	PartitionWriteBurstBytes          int64             `json:"partition_write_burst_bytes,omitempty"`
+	Attributes                        map[string]string `json:"attributes,omitempty"`
+	MeteringMode                      string            `json:"metering_mode,omitempty"`
+	Consumers                         []SchemaConsumer  `json:"consumers,omitempty"`
+}
+
+type SchemaConsumer struct {
+	Name            string            `json:"name"`
+	Important       bool              `json:"important,omitempty"`
+	SupportedCodecs []int32           `json:"supported_codecs,omitempty"`
+	ReadFrom        *time.Time        `json:"read_from,omitempty"`
+	Attributes      map[string]string `json:"attributes,omitempty"`
+}
+
+type SchemaCoordinationNode struct {
+	Path                     string                      `json:"path"`
+	SelfCheckPeriodMillis    uint32                      `json:"self_check_period_millis,omitempty"`
+	SessionGracePeriodMillis uint32                      `json:"session_grace_period_millis,omitempty"`
+	ReadConsistencyMode      string                      `json:"read_consistency_mode,omitempty"`
+	AttachConsistencyMode    string                      `json:"attach_consistency_mode,omitempty"`
+	RatelimiterCountersMode  string                      `json:"ratelimiter_counters_mode,omitempty"`
+	RateLimiterResources     []SchemaRateLimiterResource `json:"rate_limiter_resources,omitempty"`
+}
+
+type SchemaRateLimiterResource struct {
+	Path                    string  `json:"path"`
+	MaxUnitsPerSecond       float64 `json:"max_units_per_second,omitempty"`
+	MaxBurstSizeCoefficient float64 `json:"max_burst_size_coefficient,omitempty"`
+	PrefetchCoefficient     float64 `json:"prefetch_coefficient,omitempty"`
+	PrefetchWatermark       float64 `json:"prefetch_watermark,omitempty"`
+}
+
+func absolutePath(db dbName, p string) string {
+	if p != db.Name() && !strings.HasPrefix(p, db.Name()+"/") {
+		return path.Join(db.Name(), p)
+	}
+
+	return p
+}
+
+// DumpSchema makes a snapshot of scheme objects inside root path
+// (directories, row and column tables, topics, coordination nodes with rate limiter resources).
+// root is a database root relative path. Empty root means the root of database.
+// If root points to a table, topic or coordination node then snapshot contains this object only
+// (with path equals to name of object). System `.sys` directories are skipped.
+//
+// Table partition boundaries, table data and entries of other types are not dumped.
+func DumpSchema(ctx context.Context, db dbForSchema, root string) (*Schema, error) {
+	root = absolutePath(db, root)
+	s := &Schema{
+		Version: SchemaVersion,
+	}
+	err := scheme.Walk(ctx, db.Scheme(), root, func(ctx context.Context, p string, e scheme.Entry) error {
+		relPath := strings.TrimPrefix(strings.TrimPrefix(p, root), "/")
+		if relPath == "" {
+			if e.IsDirectory() || e.IsDatabase() || e.Type == scheme.EntryColumnStore {
+				return nil
+			}
+			relPath = path.Base(p)
+		}
+		switch e.Type {
+		case scheme.EntryDirectory:
+			s.Directories = append(s.Directories, relPath)
+		case scheme.EntryTable, scheme.EntryColumnTable:
+			var desc options.Description
+			err := db.Table().Do(ctx, func(ctx context.Context, session table.Session) (err error) {
+				desc, err = session.DescribeTable(ctx, p)
+
+				return err
+			}, table.WithIdempotent())
+			if err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("describe table %q failed: %w", p, err))
+			}
+			t, err := schemaTableFromDescription(relPath, e.Type == scheme.EntryColumnTable, &desc)
+			if err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("dump table %q failed: %w", p, err))
+			}
+			for i := range t.Changefeeds {
+				cf := &t.Changefeeds[i]
+				topicDesc, err := db.Topic().Describe(ctx, path.Join(p, cf.Name))
+				if err != nil {
+					return xerrors.WithStackTrace(fmt.Errorf("describe changefeed %q of table %q failed: %w", cf.Name, p, err))
+				}
+				if topicDesc.RetentionPeriod > 0 {
+					cf.RetentionPeriod = topicDesc.RetentionPeriod.String()
+				}
+				cf.Consumers = consumersToSchema(topicDesc.Consumers)
+			}
+			s.Tables = append(s.Tables, t)
+		case scheme.EntryTopic:
+			desc, err := db.Topic().Describe(ctx, p)
+			if err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("describe topic %q failed: %w", p, err))
+			}
+			s.Topics = append(s.Topics, schemaTopicFromDescription(relPath, &desc))
+		case scheme.EntryCoordinationNode:
+			node, err := dumpCoordinationNode(ctx, db, p)
+			if err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("dump coordination node %q failed: %w", p, err))
+			}
+			node.Path = relPath
+			s.CoordinationNodes = append(s.CoordinationNodes, node)
+		}
+
+		return nil
+	}, scheme.WithWalkSkipSystemDirectories())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	sort.Strings(s.Directories)
+	sort.Slice(s.Tables, func(i, j int) bool {
+		return s.Tables[i].Path < s.Tables[j].Path
+	})
+	sort.Slice(s.Topics, func(i, j int) bool {
+		return s.Topics[i].Path < s.Topics[j].Path
+	})
+	sort.Slice(s.CoordinationNodes, func(i, j int) bool {
+		return s.CoordinationNodes[i].Path < s.CoordinationNodes[j].Path
+	})
+
+	return s, nil
+}
+
+// RestoreSchema creates scheme objects from snapshot s inside root path.
+// root is a database root relative path. Empty root means the root of database.
+//
+// Objects are created in order: directories, coordination nodes with rate limiter resources,
+// tables with changefeeds, topics. RestoreSchema fails on already existing objects.
+func RestoreSchema(ctx context.Context, db dbForSchema, root string, s *Schema) error {
+	if s.Version != SchemaVersion {
+		return xerrors.WithStackTrace(fmt.Errorf("unsupported schema version %d", s.Version))
+	}
+	root = absolutePath(db, root)
+	for _, dir := range s.Directories {
+		p := path.Join(root, dir)
+		if err := db.Scheme().MakeDirectory(ctx, p); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cannot make directory %q: %w", p, err))
+		}
+	}
+	for i := range s.CoordinationNodes {
+		if err := restoreCoordinationNode(ctx, db, root, &s.CoordinationNodes[i]); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	for i := range s.Tables {
+		if err := restoreTable(ctx, db, root, &s.Tables[i]); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	for i := range s.Topics {
+		p := path.Join(root, s.Topics[i].Path)
+		opts, err := s.Topics[i].createOptions()
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("restore topic %q failed: %w", p, err))
+		}
+		if err = db.Topic().Create(ctx, p, opts...); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("create topic %q failed: %w", p, err))
+		}
+	}
+
+	return nil
+}
+
+func dumpCoordinationNode(ctx context.Context, db dbForSchema, p string) (node SchemaCoordinationNode, _ error) {
+	_, config, err := db.Coordination().DescribeNode(ctx, p)
+	if err != nil {
+		return node, xerrors.WithStackTrace(err)
+	}
+	node = SchemaCoordinationNode{
+		SelfCheckPeriodMillis:    config.SelfCheckPeriodMillis,
+		SessionGracePeriodMillis: config.SessionGracePeriodMillis,
+		ReadConsistencyMode:      enumName(consistencyModes, config.ReadConsistencyMode),
+		AttachConsistencyMode:    enumName(consistencyModes, config.AttachConsistencyMode),
+		RatelimiterCountersMode:  enumName(ratelimiterCountersModes, config.RatelimiterCountersMode),
+	}
+	resources, err := db.Ratelimiter().ListResource(ctx, p, "", true)
+	if err != nil {
+		return node, xerrors.WithStackTrace(err)
+	}
+	sort.Strings(resources)
+	for _, resourcePath := range resources {
+		resource, err := db.Ratelimiter().DescribeResource(ctx, p, resourcePath)
+		if err != nil {
+			return node, xerrors.WithStackTrace(err)
+		}
+		node.RateLimiterResources = append(node.RateLimiterResources, SchemaRateLimiterResource{
+			Path:                    resourcePath,
+			MaxUnitsPerSecond:       resource.HierarchicalDrr.MaxUnitsPerSecond,
+			MaxBurstSizeCoefficient: resource.HierarchicalDrr.MaxBurstSizeCoefficient,
+			PrefetchCoefficient:     resource.HierarchicalDrr.PrefetchCoefficient,
+			PrefetchWatermark:       resource.HierarchicalDrr.PrefetchWatermark,
+		})
+	}
+
+	return node, nil
+}
+
+func restoreCoordinationNode(ctx context.Context, db dbForSchema, root string, node *SchemaCoordinationNode) error {
+	p := path.Join(root, node.Path)
+	config := coordination.NodeConfig{
+		Path:                     p,
+		SelfCheckPeriodMillis:    node.SelfCheckPeriodMillis,
+		SessionGracePeriodMillis: node.SessionGracePeriodMillis,
+	}
+	var err error
+	if config.ReadConsistencyMode, err = enumValue(consistencyModes, node.ReadConsistencyMode); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("restore coordination node %q failed: %w", p, err))
+	}
+	if config.AttachConsistencyMode, err = enumValue(consistencyModes, node.AttachConsistencyMode); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("restore coordination node %q failed: %w", p, err))
+	}
+	config.RatelimiterCountersMode, err = enumValue(ratelimiterCountersModes, node.RatelimiterCountersMode)
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("restore coordination node %q failed: %w", p, err))
+	}
+	if err = db.Coordination().CreateNode(ctx, p, config); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("create coordination node %q failed: %w", p, err))
+	}
+	// parent resources must be created before children
+	resources := append([]SchemaRateLimiterResource(nil), node.RateLimiterResources...)
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Path < resources[j].Path
+	})
+	for _, r := range resources {
+		err = db.Ratelimiter().CreateResource(ctx, p, ratelimiter.Resource{
+			ResourcePath: r.Path,
+			HierarchicalDrr: ratelimiter.HierarchicalDrrSettings{
+				MaxUnitsPerSecond:       r.MaxUnitsPerSecond,
+				MaxBurstSizeCoefficient: r.MaxBurstSizeCoefficient,
+				PrefetchCoefficient:     r.PrefetchCoefficient,
+				PrefetchWatermark:       r.PrefetchWatermark,
+			},
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(
+				fmt.Errorf("create rate limiter resource %q in %q failed: %w", r.Path, p, err),
+			)
+		}
+	}
+
+	return nil
+}
+
+func restoreTable(ctx context.Context, db dbForSchema, root string, t *SchemaTable) error {
+	p := path.Join(root, t.Path)
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		if t.Store == storeColumn {
+			query, err := t.createColumnTableQuery(p)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			return s.ExecuteSchemeQuery(ctx, query)
+		}
+		opts, err := t.createTableOptions()
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return s.CreateTable(ctx, p, opts...)
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("create table %q failed: %w", p, err))
+	}
+	if len(t.Changefeeds) == 0 {
+		return nil
+	}
+	for i := range t.Changefeeds {
+		cf := &t.Changefeeds[i]
+		opt, err := cf.alterTableOption()
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("restore changefeed %q of table %q failed: %w", cf.Name, p, err))
+		}
+		err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.AlterTable(ctx, p, opt)
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("add changefeed %q to table %q failed: %w", cf.Name, p, err))
+		}
+		if err = addChangefeedConsumers(ctx, db, p, options.ChangefeedConsumers(opt)); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+func (cf *SchemaChangefeed) alterTableOption() (options.AlterTableOption, error) {
+	mode, err := enumValue(changefeedModes, cf.Mode)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("mode: %w", err))
+	}
+	format, err := enumValue(changefeedFormats, cf.Format)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("format: %w", err))
+	}
+	opts := []options.ChangefeedOption{
+		options.WithChangefeedMode(mode),
+		options.WithChangefeedFormat(format),
+		options.WithChangefeedVirtualTimestamps(cf.VirtualTimestamps),
+	}
+	if cf.RetentionPeriod != "" {
+		retentionPeriod, err := time.ParseDuration(cf.RetentionPeriod)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("retention period: %w", err))
+		}
+		opts = append(opts, options.WithChangefeedRetentionPeriod(retentionPeriod))
+	}
+	for _, k := range sortedKeys(cf.Attributes) {
+		opts = append(opts, options.WithChangefeedAttribute(k, cf.Attributes[k]))
+	}
+	if len(cf.Consumers) > 0 {
+		opts = append(opts, options.WithChangefeedConsumers(consumersFromSchema(cf.Consumers)...))
+	}
+
+	return options.WithAddChangefeed(cf.Name, opts...), nil
+}
+
+func marshalType(t types.Type) (json.RawMessage, error) {
+	a := allocator.New()
+	defer a.Free()
+
+	data, err := protojson.Marshal(types.TypeToYDB(t, a))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	// protojson output is not stable by design, so compact it
+	var buf bytes.Buffer
+	if err = json.Compact(&buf, data); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func unmarshalType(data json.RawMessage) (types.Type, error) {
+	var t Ydb.Type
+	if err := protojson.Unmarshal(data, &t); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return types.TypeFromYDB(&t), nil
+}
+
+func schemaTableFromDescription(relPath string, columnStore bool, desc *options.Description) (
+	t SchemaTable, err error,
+) {
+	t = SchemaTable{
+		Path:           relPath,
+		Store:          storeRow,
+		PrimaryKey:     desc.PrimaryKey,
+		KeyBloomFilter: enumName(featureFlags, desc.KeyBloomFilter),
+	}
+	if columnStore {
+		t.Store = storeColumn
+	}
+	for _, c := range desc.Columns {
+		typ, err := marshalType(c.Type)
+		if err != nil {
+			return t, xerrors.WithStackTrace(fmt.Errorf("column %q: %w", c.Name, err))
+		}
+		t.Columns = append(t.Columns, SchemaColumn{
+			Name:   c.Name,
+			Type:   typ,
+			Family: c.Family,
+		})
+	}
+	for _, idx := range desc.Indexes {
+		t.Indexes = append(t.Indexes, SchemaIndex{
+			Name:         idx.Name,
+			Type:         enumName(indexTypes, idx.Type),
+			IndexColumns: idx.IndexColumns,
+			DataColumns:  idx.DataColumns,
+		})
+	}
+	for _, cf := range desc.ColumnFamilies {
+		t.ColumnFamilies = append(t.ColumnFamilies, SchemaColumnFamily{
+			Name:         cf.Name,
+			Media:        cf.Data.Media,
+			Compression:  enumName(compressions, cf.Compression),
+			KeepInMemory: enumName(featureFlags, cf.KeepInMemory),
+		})
+	}
+	if len(desc.Attributes) > 0 {
+		t.Attributes = desc.Attributes
+	}
+	if ps := desc.PartitioningSettings; ps != (options.PartitioningSettings{}) {
+		t.PartitioningSettings = &SchemaPartitioningSettings{
+			PartitioningBySize: enumName(featureFlags, ps.PartitioningBySize),
+			PartitionSizeMb:    ps.PartitionSizeMb,
+			PartitioningByLoad: enumName(featureFlags, ps.PartitioningByLoad),
+			MinPartitionsCount: ps.MinPartitionsCount,
+			MaxPartitionsCount: ps.MaxPartitionsCount,
+		}
+	}
+	if rr := desc.ReadReplicaSettings; rr.Count > 0 {
+		t.ReadReplicas = &SchemaReadReplicas{
+			Type:  enumName(readReplicasTypes, rr.Type),
+			Count: rr.Count,
+		}
+	}
+	if ttl := desc.TimeToLiveSettings; ttl != nil {
+		t.TimeToLive = &SchemaTimeToLive{
+			ColumnName:         ttl.ColumnName,
+			Mode:               enumName(timeToLiveModes, ttl.Mode),
+			ExpireAfterSeconds: ttl.ExpireAfterSeconds,
+		}
+		if ttl.ColumnUnit != nil {
+			t.TimeToLive.Unit = enumName(timeToLiveUnits, *ttl.ColumnUnit)
+		}
+	}
+	for _, cf := range desc.Changefeeds {
+		changefeed := SchemaChangefeed{
+			Name:              cf.Name,
+			Mode:              enumName(changefeedModes, cf.Mode),
+			Format:            enumName(changefeedFormats, cf.Format),
+			VirtualTimestamps: cf.VirtualTimestamps,
+		}
+		if len(cf.Attributes) > 0 {
+			changefeed.Attributes = cf.Attributes
+		}
+		t.Changefeeds = append(t.Changefeeds, changefeed)
+	}
+
+	return t, nil
+}
+
+func (t *SchemaTable) createTableOptions() (opts []options.CreateTableOption, err error) {
+	for _, c := range t.Columns {
+		typ, err := unmarshalType(c.Type)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("column %q: %w", c.Name, err))
+		}
+		opts = append(opts, options.WithColumnMeta(options.Column{
+			Name:   c.Name,
+			Type:   typ,
+			Family: c.Family,
+		}))
+	}
+	opts = append(opts, options.WithPrimaryKeyColumn(t.PrimaryKey...))
+	for _, idx := range t.Indexes {
+		typ, err := enumValue(indexTypes, idx.Type)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("index %q: %w", idx.Name, err))
+		}
+		opts = append(opts, options.WithIndex(idx.Name,
+			options.WithIndexType(typ),
+			options.WithIndexColumns(idx.IndexColumns...),
+			options.WithDataColumns(idx.DataColumns...),
+		))
+	}
+	if len(t.ColumnFamilies) > 0 {
+		families := make([]options.ColumnFamily, 0, len(t.ColumnFamilies))
+		for _, cf := range t.ColumnFamilies {
+			family := options.ColumnFamily{
+				Name: cf.Name,
+				Data: options.StoragePool{Media: cf.Media},
+			}
+			if family.Compression, err = enumValue(compressions, cf.Compression); err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("column family %q: %w", cf.Name, err))
+			}
+			if family.KeepInMemory, err = enumValue(featureFlags, cf.KeepInMemory); err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("column family %q: %w", cf.Name, err))
+			}
+			families = append(families, family)
+		}
+		opts = append(opts, options.WithColumnFamilies(families...))
+	}
+	for _, k := range sortedKeys(t.Attributes) {
+		opts = append(opts, options.WithAttribute(k, t.Attributes[k]))
+	}
+	if ps := t.PartitioningSettings; ps != nil {
+		settings := options.PartitioningSettings{
+			PartitionSizeMb:    ps.PartitionSizeMb,
+			MinPartitionsCount: ps.MinPartitionsCount,
+			MaxPartitionsCount: ps.MaxPartitionsCount,
+		}
+		if settings.PartitioningBySize, err = enumValue(featureFlags, ps.PartitioningBySize); err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("partitioning settings: %w", err))
+		}
+		if settings.PartitioningByLoad, err = enumValue(featureFlags, ps.PartitioningByLoad); err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("partitioning settings: %w", err))
+		}
+		opts = append(opts, options.WithPartitioningSettingsObject(settings))
+	}
+	if rr := t.ReadReplicas; rr != nil {
+		typ, err := enumValue(readReplicasTypes, rr.Type)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("read replicas: %w", err))
+		}
+		opts = append(opts, options.WithReadReplicasSettings(options.ReadReplicasSettings{
+			Type:  typ,
+			Count: rr.Count,
+		}))
+	}
+	if t.KeyBloomFilter != "" {
+		flag, err := enumValue(featureFlags, t.KeyBloomFilter)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("key bloom filter: %w", err))
+		}
+		opts = append(opts, options.WithKeyBloomFilter(flag))
+	}
+	if ttl := t.TimeToLive; ttl != nil {
+		settings := options.TimeToLiveSettings{
+			ColumnName:         ttl.ColumnName,
+			ExpireAfterSeconds: ttl.ExpireAfterSeconds,
+		}
+		if settings.Mode, err = enumValue(timeToLiveModes, ttl.Mode); err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("time to live: %w", err))
+		}
+		if ttl.Unit != "" {
+			unit, err := enumValue(timeToLiveUnits, ttl.Unit)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("time to live: %w", err))
+			}
+			settings.ColumnUnit = &unit
+		}
+		opts = append(opts, options.WithTimeToLiveSettings(settings))
+	}
+
+	return opts, nil
+}
+
+func (t *SchemaTable) createColumnTableQuery(tablePath string) (string, error) {
+	var buf strings.Builder
+	buf.WriteString("CREATE TABLE `" + tablePath + "` (\n")
+	for _, c := range t.Columns {
+		typ, err := unmarshalType(c.Type)
+		if err != nil {
+			return "", xerrors.WithStackTrace(fmt.Errorf("column %q: %w", c.Name, err))
+		}
+		buf.WriteString("\t`" + c.Name + "` ")
+		optional, isOptional := typ.(types.Optional)
+		if isOptional {
+			buf.WriteString(optional.InnerType().Yql())
+		} else {
+			buf.WriteString(typ.Yql())
+		}
+		if c.Family != "" {
+			buf.WriteString(" FAMILY `" + c.Family + "`")
+		}
+		if !isOptional {
+			buf.WriteString(" NOT NULL")
+		}
+		buf.WriteString(",\n")
+	}
+	buf.WriteString("\tPRIMARY KEY (")
+	for i, k := range t.PrimaryKey {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("`" + k + "`")
+	}
+	buf.WriteString(")")
+	for _, cf := range t.ColumnFamilies {
+		buf.WriteString(",\n\tFAMILY `" + cf.Name + "` (" + cf.settingsQuery() + ")")
+	}
+	buf.WriteString("\n) WITH (\n\tSTORE = COLUMN")
+	if ps := t.PartitioningSettings; ps != nil {
+		if ps.PartitioningBySize != "" {
+			buf.WriteString(",\n\tAUTO_PARTITIONING_BY_SIZE = " + strings.ToUpper(ps.PartitioningBySize))
+		}
+		if ps.PartitionSizeMb > 0 {
+			fmt.Fprintf(&buf, ",\n\tAUTO_PARTITIONING_PARTITION_SIZE_MB = %d", ps.PartitionSizeMb)
+		}
+		if ps.PartitioningByLoad != "" {
+			buf.WriteString(",\n\tAUTO_PARTITIONING_BY_LOAD = " + strings.ToUpper(ps.PartitioningByLoad))
+		}
+		if ps.MinPartitionsCount > 0 {
+			fmt.Fprintf(&buf, ",\n\tAUTO_PARTITIONING_MIN_PARTITIONS_COUNT = %d", ps.MinPartitionsCount)
+		}
+		if ps.MaxPartitionsCount > 0 {
+			fmt.Fprintf(&buf, ",\n\tAUTO_PARTITIONING_MAX_PARTITIONS_COUNT = %d", ps.MaxPartitionsCount)
+		}
+	}
+	if ttl := t.TimeToLive; ttl != nil {
+		fmt.Fprintf(&buf, ",\n\tTTL = Interval(\"PT%dS\") ON `%s`", ttl.ExpireAfterSeconds, ttl.ColumnName)
+		if ttl.Unit != "" {
+			buf.WriteString(" AS " + strings.ToUpper(ttl.Unit))
+		}
+	}
+	buf.WriteString("\n)")
+
+	return buf.String(), nil
+}
+
+// settingsQuery returns settings of column family in YQL syntax
+func (cf *SchemaColumnFamily) settingsQuery() string {
+	var settings []string
+	if cf.Media != "" {
+		settings = append(settings, fmt.Sprintf("DATA = %q", cf.Media))
+	}
+	switch cf.Compression {
+	case "":
+	case "none":
+		settings = append(settings, `COMPRESSION = "off"`)
+	default:
+		settings = append(settings, fmt.Sprintf("COMPRESSION = %q", cf.Compression))
+	}
+	if cf.KeepInMemory != "" {
+		settings = append(settings, "KEEP_IN_MEMORY = "+strings.ToUpper(cf.KeepInMemory))
+	}
+
+	return strings.Join(settings, ", ")
+}
+
+func schemaTopicFromDescription(relPath string, desc *topictypes.TopicDescription) SchemaTopic {
+	t := SchemaTopic{
+		Path:                              relPath,
+		MinActivePartitions:               desc.PartitionSettings.MinActivePartitions,
+		PartitionCountLimit:               desc.PartitionSettings.PartitionCountLimit,
+		RetentionStorageMB:                desc.RetentionStorageMB,
+		SupportedCodecs:                   codecsToSchema(desc.SupportedCodecs),
+		PartitionWriteSpeedBytesPerSecond: desc.PartitionWriteSpeedBytesPerSecond,
+		PartitionWriteBurstBytes:          desc.PartitionWriteBurstBytes,
+		MeteringMode:                      enumName(meteringModes, desc.MeteringMode),
+	}
+	if desc.RetentionPeriod > 0 {
+		t.RetentionPeriod = desc.RetentionPeriod.String()
+	}
+	if len(desc.Attributes) > 0 {
+		t.Attributes = desc.Attributes
+	}
+	t.Consumers = consumersToSchema(desc.Consumers)
+
+	return t
+}
+
+func consumersToSchema(consumers []topictypes.Consumer) (res []SchemaConsumer) {
+	for i := range consumers {
+		c := &consumers[i]
+		consumer := SchemaConsumer{
+			Name:            c.Name,
+			Important:       c.Important,
+			SupportedCodecs: codecsToSchema(c.SupportedCodecs),
+		}
+		if !c.ReadFrom.IsZero() {
+			readFrom := c.ReadFrom.UTC()
+			consumer.ReadFrom = &readFrom
+		}
+		if len(c.Attributes) > 0 {
+			consumer.Attributes = c.Attributes
+		}
+		res = append(res, consumer)
+	}
+
+	return res
+}
+
+func consumersFromSchema(consumers []SchemaConsumer) []topictypes.Consumer {
+	res := make([]topictypes.Consumer, 0, len(consumers))
+	for _, c := range consumers {
+		consumer := topictypes.Consumer{
+			Name:            c.Name,
+			Important:       c.Important,
+			SupportedCodecs: codecsFromSchema(c.SupportedCodecs),
+			Attributes:      c.Attributes,
+		}
+		if c.ReadFrom != nil {
+			consumer.ReadFrom = *c.ReadFrom
+		}
+		res = append(res, consumer)
+	}
+
+	return res
+}
+
+func (t *SchemaTopic) createOptions() (opts []topicoptions.CreateOption, err error) {
+	opts = append(opts,
+		topicoptions.CreateWithMinActivePartitions(t.MinActivePartitions),
+		topicoptions.CreateWithPartitionCountLimit(t.PartitionCountLimit),
+		topicoptions.CreateWithRetentionStorageMB(t.RetentionStorageMB),
+		topicoptions.CreateWithPartitionWriteSpeedBytesPerSecond(t.PartitionWriteSpeedBytesPerSecond),
+		topicoptions.CreateWithPartitionWriteBurstBytes(t.PartitionWriteBurstBytes),
+	)
+	if t.RetentionPeriod != "" {
+		retentionPeriod, err := time.ParseDuration(t.RetentionPeriod)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("retention period: %w", err))
+		}
+		opts = append(opts, topicoptions.CreateWithRetentionPeriod(retentionPeriod))
+	}
+	if len(t.SupportedCodecs) > 0 {
+		opts = append(opts, topicoptions.CreateWithSupportedCodecs(codecsFromSchema(t.SupportedCodecs)...))
+	}
+	if len(t.Attributes) > 0 {
+		opts = append(opts, topicoptions.CreateWithAttributes(t.Attributes))
+	}
+	if t.MeteringMode != "" {
+		mode, err := enumValue(meteringModes, t.MeteringMode)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("metering mode: %w", err))
+		}
+		opts = append(opts, topicoptions.CreateWithMeteringMode(mode))
+	}
+	if len(t.Consumers) > 0 {
+		opts = append(opts, topicoptions.CreateWithConsumer(consumersFromSchema(t.Consumers)...))
+	}
+
+	return opts, nil
+}
+
+func codecsToSchema(codecs []topictypes.Codec) []int32 {
+	if len(codecs) == 0 {
+		return nil
+	}
+	res := make([]int32, len(codecs))
+	for i, c := range codecs {
+		res[i] = int32(c)
+	}
+
+	return res
+}
+
+func codecsFromSchema(codecs []int32) []topictypes.Codec {
+	if len(codecs) == 0 {
+		return nil
+	}
+	res := make([]topictypes.Codec, len(codecs))
+	for i, c := range codecs {
+		res[i] = topictypes.Codec(c)
+	}
+
+	return res
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+type enumNames[T comparable] map[T]string
+
+var (
+	featureFlags = enumNames[options.FeatureFlag]{
+		options.FeatureEnabled:  "enabled",
+		options.FeatureDisabled: "disabled",
+	}
+	compressions = enumNames[options.ColumnFamilyCompression]{
+		options.ColumnFamilyCompressionNone: "none",
+		options.ColumnFamilyCompressionLZ4:  "lz4",
+	}
+	indexTypes = enumNames[options.IndexType]{
+		options.IndexTypeGlobal:      "global",
+		options.IndexTypeGlobalAsync: "global_async",
+	}
+	readReplicasTypes = enumNames[options.ReadReplicasType]{
+		options.ReadReplicasPerAzReadReplicas: "per_az",
+		options.ReadReplicasAnyAzReadReplicas: "any_az",
+	}
+	timeToLiveModes = enumNames[options.TimeToLiveMode]{
+		options.TimeToLiveModeDateType:            "date_type",
+		options.TimeToLiveModeValueSinceUnixEpoch: "value_since_unix_epoch",
+	}
+	timeToLiveUnits = enumNames[options.TimeToLiveUnit]{
+		options.TimeToLiveUnitSeconds:      "seconds",
+		options.TimeToLiveUnitMilliseconds: "milliseconds",
+		options.TimeToLiveUnitMicroseconds: "microseconds",
+		options.TimeToLiveUnitNanoseconds:  "nanoseconds",
+	}
+	changefeedModes = enumNames[options.ChangefeedMode]{
+		options.ChangefeedModeKeysOnly:        "keys_only",
+		options.ChangefeedModeUpdates:         "updates",
+		options.ChangefeedModeNewImage:        "new_image",
+		options.ChangefeedModeOldImage:        "old_image",
+		options.ChangefeedModeNewAndOldImages: "new_and_old_images",
+	}
+	changefeedFormats = enumNames[options.ChangefeedFormat]{
+		options.ChangefeedFormatJSON:                "json",
+		options.ChangefeedFormatDynamoDBStreamsJSON: "dynamodb_streams_json",
+	}
+	consistencyModes = enumNames[coordination.ConsistencyMode]{
+		coordination.ConsistencyModeStrict:  "strict",
+		coordination.ConsistencyModeRelaxed: "relaxed",
+	}
+	ratelimiterCountersModes = enumNames[coordination.RatelimiterCountersMode]{
+		coordination.RatelimiterCountersModeAggregated: "aggregated",
+		coordination.RatelimiterCountersModeDetailed:   "detailed",
+	}
+	meteringModes = enumNames[topictypes.MeteringMode]{
+		topictypes.MeteringModeReservedCapacity: "reserved_capacity",
+		topictypes.MeteringModeRequestUnits:     "request_units",
+	}
+)
+
+// enumName returns empty string for values which are not listed in names (unspecified values)
+func enumName[T comparable](names enumNames[T], v T) string {
+	return names[v]
+}
+
+func enumValue[T comparable](names enumNames[T], name string) (v T, _ error) {
+	if name == "" {
+		return v, nil
+	}
+	for value, n := range names {
+		if n == name {
+			return value, nil
+		}
+	}
+
+	return v, xerrors.WithStackTrace(fmt.Errorf("unknown value %q", name))
+}
@@ -0,0 +1,276 @@
+package sugar
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+func TestSchemaTableRoundTrip(t *testing.T) {
+	desc := options.Description{
+		Name: "series",
+		Columns: []options.Column{
+			{Name: "id", Type: types.TypeUint64},
+			{Name: "title", Type: types.Optional(types.TypeUTF8), Family: "default"},
+			{Name: "created_at", Type: types.Optional(types.TypeTimestamp)},
+			{Name: "tags", Type: types.Optional(types.List(types.TypeText))},
+		},
+		PrimaryKey: []string{"id"},
+		Indexes: []options.IndexDescription{
+			{
+				Name:         "title_index",
+				IndexColumns: []string{"title"},
+				DataColumns:  []string{"created_at"},
+				Type:         options.IndexTypeGlobalAsync,
+			},
+		},
+		ColumnFamilies: []options.ColumnFamily{
+			{
+				Name:         "default",
+				Data:         options.StoragePool{Media: "ssd"},
+				Compression:  options.ColumnFamilyCompressionLZ4,
+				KeepInMemory: options.FeatureDisabled,
+			},
+		},
+		Attributes: map[string]string{
+			"b": "2",
+			"a": "1",
+		},
+		PartitioningSettings: options.PartitioningSettings{
+			PartitioningBySize: options.FeatureEnabled,
+			PartitionSizeMb:    512,
+			MinPartitionsCount: 4,
+		},
+		KeyBloomFilter: options.FeatureEnabled,
+		TimeToLiveSettings: func() *options.TimeToLiveSettings {
+			ttl := options.NewTTLSettings().ColumnDateType("created_at").ExpireAfter(time.Hour)
+
+			return &ttl
+		}(),
+		Changefeeds: []options.ChangefeedDescription{
+			{
+				Name:   "feed",
+				Mode:   options.ChangefeedModeNewAndOldImages,
+				Format: options.ChangefeedFormatJSON,
+				State:  options.ChangefeedStateEnabled,
+			},
+		},
+	}
+
+	table, err := schemaTableFromDescription("dir/series", false, &desc)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(table)
+	require.NoError(t, err)
+
+	var restored SchemaTable
+	require.NoError(t, json.Unmarshal(data, &restored))
+	require.Equal(t, table, restored)
+	require.Equal(t, []SchemaChangefeed{{Name: "feed", Mode: "new_and_old_images", Format: "json"}}, restored.Changefeeds)
+
+	cf, err := restored.Changefeeds[0].alterTableOption()
+	require.NoError(t, err)
+	var alter Ydb_Table.AlterTableRequest
+	cf.ApplyAlterTableOption((*options.AlterTableDesc)(&alter), allocator.New())
+	require.Len(t, alter.GetAddChangefeeds(), 1)
+	require.Equal(t, Ydb_Table.ChangefeedMode_MODE_NEW_AND_OLD_IMAGES, alter.GetAddChangefeeds()[0].GetMode())
+	require.Equal(t, Ydb_Table.ChangefeedFormat_FORMAT_JSON, alter.GetAddChangefeeds()[0].GetFormat())
+
+	opts, err := restored.createTableOptions()
+	require.NoError(t, err)
+
+	a := allocator.New()
+	defer a.Free()
+
+	var (
+		expected options.CreateTableDesc
+		actual   options.CreateTableDesc
+	)
+	for _, opt := range []options.CreateTableOption{
+		options.WithColumnMeta(desc.Columns[0]),
+		options.WithColumnMeta(desc.Columns[1]),
+		options.WithColumnMeta(desc.Columns[2]),
+		options.WithColumnMeta(desc.Columns[3]),
+		options.WithPrimaryKeyColumn("id"),
+		options.WithIndex("title_index",
+			options.WithIndexType(options.GlobalAsyncIndex()),
+			options.WithIndexColumns("title"),
+			options.WithDataColumns("created_at"),
+		),
+		options.WithColumnFamilies(desc.ColumnFamilies...),
+		options.WithAttribute("a", "1"),
+		options.WithAttribute("b", "2"),
+		options.WithPartitioningSettingsObject(desc.PartitioningSettings),
+		options.WithKeyBloomFilter(options.FeatureEnabled),
+		options.WithTimeToLiveSettings(*desc.TimeToLiveSettings),
+	} {
+		opt.ApplyCreateTableOption(&expected, a)
+	}
+	for _, opt := range opts {
+		opt.ApplyCreateTableOption(&actual, a)
+	}
+	require.True(t, proto.Equal(
+		(*Ydb_Table.CreateTableRequest)(&expected),
+		(*Ydb_Table.CreateTableRequest)(&actual),
+	))
+}
+
+func TestSchemaColumnTableQuery(t *testing.T) {
+	unit := options.TimeToLiveUnitSeconds
+	desc := options.Description{
+		Columns: []options.Column{
+			{Name: "id", Type: types.TypeUint64},
+			{Name: "value", Type: types.Optional(types.TypeDouble), Family: "cold"},
+			{Name: "ts", Type: types.TypeUint32, Family: "cold"},
+		},
+		PrimaryKey: []string{"id"},
+		ColumnFamilies: []options.ColumnFamily{
+			{
+				Name:        "cold",
+				Data:        options.StoragePool{Media: "hdd"},
+				Compression: options.ColumnFamilyCompressionLZ4,
+			},
+		},
+		PartitioningSettings: options.PartitioningSettings{
+			PartitioningBySize: options.FeatureEnabled,
+			PartitionSizeMb:    512,
+			MinPartitionsCount: 8,
+			MaxPartitionsCount: 16,
+		},
+		TimeToLiveSettings: &options.TimeToLiveSettings{
+			ColumnName:         "ts",
+			Mode:               options.TimeToLiveModeValueSinceUnixEpoch,
+			ExpireAfterSeconds: 3600,
+			ColumnUnit:         &unit,
+		},
+	}
+	table, err := schemaTableFromDescription("metrics", true, &desc)
+	require.NoError(t, err)
+	require.Equal(t, "column", table.Store)
+
+	query, err := table.createColumnTableQuery("/local/metrics")
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `/local/metrics` (\n"+
+		"\t`id` Uint64 NOT NULL,\n"+
+		"\t`value` Double FAMILY `cold`,\n"+
+		"\t`ts` Uint32 FAMILY `cold` NOT NULL,\n"+
+		"\tPRIMARY KEY (`id`),\n"+
+		"\tFAMILY `cold` (DATA = \"hdd\", COMPRESSION = \"lz4\")\n"+
+		") WITH (\n"+
+		"\tSTORE = COLUMN,\n"+
+		"\tAUTO_PARTITIONING_BY_SIZE = ENABLED,\n"+
+		"\tAUTO_PARTITIONING_PARTITION_SIZE_MB = 512,\n"+
+		"\tAUTO_PARTITIONING_MIN_PARTITIONS_COUNT = 8,\n"+
+		"\tAUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 16,\n"+
+		"\tTTL = Interval(\"PT3600S\") ON `ts` AS SECONDS\n"+
+		")", query)
+}
+
+type testDBName string
+
+func (name testDBName) Name() string {
+	return string(name)
+}
+
+func TestSchemaAbsolutePath(t *testing.T) {
+	db := testDBName("/local")
+	require.Equal(t, "/local", absolutePath(db, ""))
+	require.Equal(t, "/local", absolutePath(db, "/local"))
+	require.Equal(t, "/local/a/b", absolutePath(db, "/local/a/b"))
+	require.Equal(t, "/local/a/b", absolutePath(db, "a/b"))
+	require.Equal(t, "/local/local2/x", absolutePath(db, "/local2/x"))
+}
+
+func TestSchemaYAML(t *testing.T) {
+	desc := options.Description{
+		Columns: []options.Column{
+			{Name: "id", Type: types.TypeUint64},
+			{Name: "title", Type: types.Optional(types.TypeText)},
+		},
+		PrimaryKey: []string{"id"},
+		Attributes: map[string]string{"version": "1"},
+	}
+	table, err := schemaTableFromDescription("dir/series", false, &desc)
+	require.NoError(t, err)
+	readFrom := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := Schema{
+		Version:     SchemaVersion,
+		Directories: []string{"dir"},
+		Tables:      []SchemaTable{table},
+		Topics: []SchemaTopic{{
+			Path:            "events",
+			RetentionPeriod: "24h0m0s",
+			Consumers:       []SchemaConsumer{{Name: "reader", ReadFrom: &readFrom}},
+		}},
+	}
+
+	data, err := yaml.Marshal(s)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "version: 1\ndirectories:\n    - dir\ntables:\n    - path: dir/series\n")
+
+	var decoded Schema
+	require.NoError(t, yaml.Unmarshal(data, &decoded))
+	expected, err := json.Marshal(s)
+	require.NoError(t, err)
+	actual, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(actual))
+}
+
+func TestSchemaTopic(t *testing.T) {
+	readFrom := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	topic := schemaTopicFromDescription("events", &topictypes.TopicDescription{
+		PartitionSettings: topictypes.PartitionSettings{
+			MinActivePartitions: 2,
+		},
+		RetentionPeriod: 24 * time.Hour,
+		SupportedCodecs: []topictypes.Codec{topictypes.CodecRaw, topictypes.CodecGzip},
+		MeteringMode:    topictypes.MeteringModeRequestUnits,
+		Consumers: []topictypes.Consumer{
+			{
+				Name:      "reader",
+				Important: true,
+				ReadFrom:  readFrom,
+			},
+		},
+	})
+	data, err := json.Marshal(topic)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"path": "events",
+		"min_active_partitions": 2,
+		"retention_period": "24h0m0s",
+		"supported_codecs": [1, 2],
+		"metering_mode": "request_units",
+		"consumers": [{"name": "reader", "important": true, "read_from": "2024-01-02T03:04:05Z"}]
+	}`, string(data))
+
+	opts, err := topic.createOptions()
+	require.NoError(t, err)
+	require.NotEmpty(t, opts)
+}
+
+func TestSchemaEnums(t *testing.T) {
+	v, err := enumValue(changefeedModes, "updates")
+	require.NoError(t, err)
+	require.Equal(t, options.ChangefeedModeUpdates, v)
+
+	v, err = enumValue(changefeedModes, "")
+	require.NoError(t, err)
+	require.Equal(t, options.ChangefeedModeUnspecified, v)
+
+	_, err = enumValue(changefeedModes, "unknown")
+	require.Error(t, err)
+
+	require.Equal(t, "", enumName(changefeedModes, options.ChangefeedModeUnspecified))
+}
@@ -0,0 +1,484 @@
+package sugar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+// ErrUnsafeTableChange returns from ApplyTable if plan contains changes which cannot be applied safely
+var ErrUnsafeTableChange = xerrors.Wrap(errors.New("unsafe table change"))
+
+// TableChange is a single step of TablePlan
+type TableChange struct {
+	// Description is a human-readable description of change
+	Description string
+
+	// Unsafe marks changes which lead to data loss (dropping columns) or cannot be applied with
+	// AlterTable at all (changing of column type or primary key)
+	Unsafe bool
+
+	option     options.AlterTableOption
+	dropColumn bool
+}
+
+// Option returns AlterTable option for change or nil if change cannot be applied with AlterTable
+func (c *TableChange) Option() options.AlterTableOption {
+	return c.option
+}
+
+// TablePlan is an ordered list of changes which transforms actual table to desired
+type TablePlan struct {
+	Changes []TableChange
+}
+
+// Empty returns true if actual table equals to desired
+func (p *TablePlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Unsafe returns unsafe changes of plan
+func (p *TablePlan) Unsafe() (changes []TableChange) {
+	for i := range p.Changes {
+		if p.Changes[i].Unsafe {
+			changes = append(changes, p.Changes[i])
+		}
+	}
+
+	return changes
+}
+
+// AlterTableOptions returns ordered AlterTable options of plan.
+// Changes which cannot be applied with AlterTable are skipped
+func (p *TablePlan) AlterTableOptions() (opts []options.AlterTableOption) {
+	for i := range p.Changes {
+		if opt := p.Changes[i].option; opt != nil {
+			opts = append(opts, opt)
+		}
+	}
+
+	return opts
+}
+
+func (p *TablePlan) String() string {
+	var buf strings.Builder
+	for i := range p.Changes {
+		if p.Changes[i].Unsafe {
+			buf.WriteString("! ")
+		} else {
+			buf.WriteString("  ")
+		}
+		buf.WriteString(p.Changes[i].Description)
+		buf.WriteByte('\n')
+	}
+
+	return buf.String()
+}
+
+func (p *TablePlan) add(description string, option options.AlterTableOption) {
+	p.Changes = append(p.Changes, TableChange{
+		Description: description,
+		option:      option,
+	})
+}
+
+func (p *TablePlan) addUnsafe(description string, option options.AlterTableOption) {
+	p.Changes = append(p.Changes, TableChange{
+		Description: description,
+		Unsafe:      true,
+		option:      option,
+	})
+}
+
+func (p *TablePlan) addDropColumn(name string) {
+	p.Changes = append(p.Changes, TableChange{
+		Description: fmt.Sprintf("drop column `%s`", name),
+		Unsafe:      true,
+		option:      options.WithDropColumn(name),
+		dropColumn:  true,
+	})
+}
+
+// DiffTable compares actual table description (from table.Session.DescribeTable) with desired
+// description and returns ordered plan of changes
+//
+// Empty (zero) fields of desired description are treated as unspecified and are not compared
+// with actual description. Indexes, changefeeds and attributes are compared only if desired
+// Indexes slice, Changefeeds slice and Attributes map are not nil.
+// Column families which are absent in desired description are not dropped.
+// RetentionPeriod of changefeed is compared only if it is known for both actual and desired
+// changefeeds, InitialScan and Consumers are used only for adding of changefeed.
+func DiffTable(actual, desired *options.Description) *TablePlan {
+	var (
+		plan           = &TablePlan{}
+		actualColumns  = make(map[string]options.Column, len(actual.Columns))
+		desiredColumns = make(map[string]options.Column, len(desired.Columns))
+		actualIndexes  = make(map[string]options.IndexDescription, len(actual.Indexes))
+		desiredIndexes = make(map[string]options.IndexDescription, len(desired.Indexes))
+	)
+	for _, c := range actual.Columns {
+		actualColumns[c.Name] = c
+	}
+	for _, c := range desired.Columns {
+		desiredColumns[c.Name] = c
+	}
+	for _, idx := range actual.Indexes {
+		actualIndexes[idx.Name] = idx
+	}
+	for _, idx := range desired.Indexes {
+		desiredIndexes[idx.Name] = idx
+	}
+
+	if len(desired.PrimaryKey) > 0 && !reflect.DeepEqual(actual.PrimaryKey, desired.PrimaryKey) {
+		plan.addUnsafe(fmt.Sprintf("change primary key from (%s) to (%s)",
+			strings.Join(actual.PrimaryKey, ", "), strings.Join(desired.PrimaryKey, ", "),
+		), nil)
+	}
+
+	for _, c := range desired.Columns {
+		a, has := actualColumns[c.Name]
+		if !has {
+			continue
+		}
+		if !types.Equal(a.Type, c.Type) {
+			plan.addUnsafe(fmt.Sprintf("change type of column `%s` from %s to %s",
+				c.Name, a.Type.Yql(), c.Type.Yql(),
+			), nil)
+		}
+		if c.Family != "" && a.Family != c.Family {
+			plan.add(fmt.Sprintf("change family of column `%s` from %q to %q",
+				c.Name, a.Family, c.Family,
+			), options.WithAlterColumnFamily(c.Name, c.Family))
+		}
+	}
+
+	for _, idx := range actual.Indexes {
+		if desired.Indexes == nil {
+			break
+		}
+		d, has := desiredIndexes[idx.Name]
+		if !has || !indexesEqual(&idx, &d) {
+			plan.add(fmt.Sprintf("drop index `%s`", idx.Name), options.WithDropIndex(idx.Name))
+		}
+	}
+
+	changefeeds := make(map[string]options.ChangefeedDescription, len(actual.Changefeeds))
+	for _, cf := range actual.Changefeeds {
+		changefeeds[cf.Name] = cf
+	}
+	for _, cf := range actual.Changefeeds {
+		if desired.Changefeeds == nil {
+			break
+		}
+		found := false
+		for _, d := range desired.Changefeeds {
+			if d.Name == cf.Name && changefeedsEqual(&cf, &d) {
+				found = true
+			}
+		}
+		if !found {
+			plan.add(fmt.Sprintf("drop changefeed `%s`", cf.Name), options.WithDropChangefeed(cf.Name))
+			delete(changefeeds, cf.Name)
+		}
+	}
+
+	for _, cf := range desired.ColumnFamilies {
+		found := false
+		for _, a := range actual.ColumnFamilies {
+			if a.Name != cf.Name {
+				continue
+			}
+			found = true
+			if a != cf {
+				plan.add(fmt.Sprintf("alter column family %q", cf.Name), options.WithAlterColumnFamilies(cf))
+			}
+		}
+		if !found {
+			plan.add(fmt.Sprintf("add column family %q", cf.Name), options.WithAddColumnFamilies(cf))
+		}
+	}
+
+	for _, c := range desired.Columns {
+		if _, has := actualColumns[c.Name]; !has {
+			plan.add(fmt.Sprintf("add column `%s` %s", c.Name, c.Type.Yql()), options.WithAddColumnMeta(c))
+		}
+	}
+
+	for _, idx := range desired.Indexes {
+		a, has := actualIndexes[idx.Name]
+		if !has || !indexesEqual(&a, &idx) {
+			plan.add(fmt.Sprintf("add index `%s` on (%s)", idx.Name, strings.Join(idx.IndexColumns, ", ")),
+				options.WithAddIndex(idx.Name,
+					options.WithIndexType(idx.Type),
+					options.WithIndexColumns(idx.IndexColumns...),
+					options.WithDataColumns(idx.DataColumns...),
+				),
+			)
+		}
+	}
+
+	for _, cf := range desired.Changefeeds {
+		if _, has := changefeeds[cf.Name]; has {
+			continue
+		}
+		opts := []options.ChangefeedOption{
+			options.WithChangefeedMode(cf.Mode),
+			options.WithChangefeedFormat(cf.Format),
+			options.WithChangefeedVirtualTimestamps(cf.VirtualTimestamps),
+			options.WithChangefeedInitialScan(cf.InitialScan),
+		}
+		if cf.RetentionPeriod > 0 {
+			opts = append(opts, options.WithChangefeedRetentionPeriod(cf.RetentionPeriod))
+		}
+		if len(cf.Consumers) > 0 {
+			opts = append(opts, options.WithChangefeedConsumers(cf.Consumers...))
+		}
+		for _, k := range sortedKeys(cf.Attributes) {
+			opts = append(opts, options.WithChangefeedAttribute(k, cf.Attributes[k]))
+		}
+		plan.add(fmt.Sprintf("add changefeed `%s` with mode %s and format %s", cf.Name,
+			enumName(changefeedModes, cf.Mode), enumName(changefeedFormats, cf.Format),
+		), options.WithAddChangefeed(cf.Name, opts...))
+	}
+
+	if ttl := desired.TimeToLiveSettings; ttl != nil && !reflect.DeepEqual(actual.TimeToLiveSettings, ttl) {
+		plan.add(fmt.Sprintf("set time to live on column `%s` expire after %ds", ttl.ColumnName, ttl.ExpireAfterSeconds),
+			options.WithSetTimeToLiveSettings(*ttl),
+		)
+	}
+
+	if ps := mergePartitioningSettings(actual.PartitioningSettings, desired.PartitioningSettings); ps !=
+		actual.PartitioningSettings {
+		plan.add(fmt.Sprintf("alter partitioning settings to %+v", ps), options.WithAlterPartitionSettingsObject(ps))
+	}
+
+	if rr := desired.ReadReplicaSettings; rr.Count > 0 && rr != actual.ReadReplicaSettings {
+		plan.add(fmt.Sprintf("alter read replicas settings to %+v", rr), options.WithAlterReadReplicasSettings(rr))
+	}
+
+	if f := desired.KeyBloomFilter; f != options.FeatureFlag(0) && f != actual.KeyBloomFilter {
+		plan.add(fmt.Sprintf("alter key bloom filter to %s", enumName(featureFlags, f)),
+			options.WithAlterKeyBloomFilter(f),
+		)
+	}
+
+	if desired.Attributes != nil {
+		for _, k := range sortedKeys(desired.Attributes) {
+			if v, has := actual.Attributes[k]; !has || v != desired.Attributes[k] {
+				plan.add(fmt.Sprintf("set attribute %q = %q", k, desired.Attributes[k]),
+					options.WithAlterAttribute(k, desired.Attributes[k]),
+				)
+			}
+		}
+		for _, k := range sortedKeys(actual.Attributes) {
+			if _, has := desired.Attributes[k]; !has {
+				plan.add(fmt.Sprintf("drop attribute %q", k), options.WithDropAttribute(k))
+			}
+		}
+	}
+
+	for _, c := range actual.Columns {
+		if _, has := desiredColumns[c.Name]; !has {
+			plan.addDropColumn(c.Name)
+		}
+	}
+
+	return plan
+}
+
+func indexesEqual(lhs, rhs *options.IndexDescription) bool {
+	dataColumns := func(columns []string) []string {
+		columns = append([]string{}, columns...)
+		sort.Strings(columns)
+
+		return columns
+	}
+
+	return lhs.Type == rhs.Type &&
+		reflect.DeepEqual(lhs.IndexColumns, rhs.IndexColumns) &&
+		reflect.DeepEqual(dataColumns(lhs.DataColumns), dataColumns(rhs.DataColumns))
+}
+
+func changefeedsEqual(lhs, rhs *options.ChangefeedDescription) bool {
+	if lhs.RetentionPeriod > 0 && rhs.RetentionPeriod > 0 && lhs.RetentionPeriod != rhs.RetentionPeriod {
+		return false
+	}
+
+	return lhs.Mode == rhs.Mode &&
+		lhs.Format == rhs.Format &&
+		lhs.VirtualTimestamps == rhs.VirtualTimestamps &&
+		(rhs.Attributes == nil || attributesEqual(lhs.Attributes, rhs.Attributes))
+}
+
+func attributesEqual(lhs, rhs map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for k, v := range lhs {
+		if vv, has := rhs[k]; !has || vv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mergePartitioningSettings(actual, desired options.PartitioningSettings) options.PartitioningSettings {
+	if desired.PartitioningBySize != options.FeatureFlag(0) {
+		actual.PartitioningBySize = desired.PartitioningBySize
+	}
+	if desired.PartitionSizeMb != 0 {
+		actual.PartitionSizeMb = desired.PartitionSizeMb
+	}
+	if desired.PartitioningByLoad != options.FeatureFlag(0) {
+		actual.PartitioningByLoad = desired.PartitioningByLoad
+	}
+	if desired.MinPartitionsCount != 0 {
+		actual.MinPartitionsCount = desired.MinPartitionsCount
+	}
+	if desired.MaxPartitionsCount != 0 {
+		actual.MaxPartitionsCount = desired.MaxPartitionsCount
+	}
+
+	return actual
+}
+
+type dbForApplyTable interface {
+	dbTable
+	dbTopic
+}
+
+type applyTableOptions struct {
+	dryRun           io.Writer
+	allowDropColumns bool
+}
+
+type ApplyTableOption func(o *applyTableOptions)
+
+// WithApplyTableDryRun makes ApplyTable to print plan into w instead of altering table
+func WithApplyTableDryRun(w io.Writer) ApplyTableOption {
+	return func(o *applyTableOptions) {
+		o.dryRun = w
+	}
+}
+
+// WithApplyTableDropColumns allows ApplyTable to drop columns which are absent in desired description
+func WithApplyTableDropColumns() ApplyTableOption {
+	return func(o *applyTableOptions) {
+		o.allowDropColumns = true
+	}
+}
+
+// ApplyTable describes table at tablePath, computes plan with DiffTable and alters table
+// step by step. Each change of plan applies with separate AlterTable call.
+//
+// ApplyTable returns ErrUnsafeTableChange if plan contains unsafe changes. Dropping of columns is the only
+// unsafe change which can be applied and it is allowed only with WithApplyTableDropColumns option.
+// Consumers of added changefeeds are added to changefeed topics after altering of table.
+func ApplyTable(ctx context.Context, db dbForApplyTable, tablePath string, desired *options.Description,
+	opts ...ApplyTableOption,
+) (plan *TablePlan, _ error) {
+	var o applyTableOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		actual, err := s.DescribeTable(ctx, tablePath)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		plan = DiffTable(&actual, desired)
+
+		return nil
+	}, table.WithIdempotent())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("describe table %q failed: %w", tablePath, err))
+	}
+
+	if o.dryRun != nil {
+		if _, err = io.WriteString(o.dryRun, plan.String()); err != nil {
+			return plan, xerrors.WithStackTrace(err)
+		}
+
+		return plan, nil
+	}
+
+	for _, c := range plan.Unsafe() {
+		if !c.dropColumn || !o.allowDropColumns {
+			return plan, xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrUnsafeTableChange, c.Description))
+		}
+	}
+
+	for i := range plan.Changes {
+		c := &plan.Changes[i]
+		err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.AlterTable(ctx, tablePath, c.option)
+		})
+		if err != nil {
+			return plan, xerrors.WithStackTrace(fmt.Errorf("%s in table %q failed: %w", c.Description, tablePath, err))
+		}
+		err = addChangefeedConsumers(ctx, db, tablePath, options.ChangefeedConsumers(c.option))
+		if err != nil {
+			return plan, xerrors.WithStackTrace(err)
+		}
+	}
+
+	return plan, nil
+}
+
+// addChangefeedConsumers adds consumers to topics of changefeeds of table at tablePath.
+// Consumers which already exist in changefeed topic are skipped, so repeated call is no-op
+func addChangefeedConsumers(ctx context.Context, db dbTopic, tablePath string,
+	consumers map[string][]topictypes.Consumer,
+) error {
+	names := make([]string, 0, len(consumers))
+	for name := range consumers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		topicPath := path.Join(tablePath, name)
+		desc, err := db.Topic().Describe(ctx, topicPath)
+		if err != nil {
+			return xerrors.WithStackTrace(
+				fmt.Errorf("describe changefeed %q of table %q failed: %w", name, tablePath, err),
+			)
+		}
+		existing := make(map[string]struct{}, len(desc.Consumers))
+		for i := range desc.Consumers {
+			existing[desc.Consumers[i].Name] = struct{}{}
+		}
+		var add []topictypes.Consumer
+		for _, c := range consumers[name] {
+			if _, has := existing[c.Name]; !has {
+				add = append(add, c)
+			}
+		}
+		if len(add) == 0 {
+			continue
+		}
+		if err = db.Topic().Alter(ctx, topicPath, topicoptions.AlterWithAddConsumers(add...)); err != nil {
+			return xerrors.WithStackTrace(
+				fmt.Errorf("add consumers to changefeed %q of table %q failed: %w", name, tablePath, err),
+			)
+		}
+	}
+
+	return nil
+}
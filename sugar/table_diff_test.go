@@ -0,0 +1,267 @@
+package sugar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+func TestDiffTable(t *testing.T) {
+	actual := options.Description{
+		Columns: []options.Column{
+			{Name: "id", Type: types.Optional(types.TypeUint64)},
+			{Name: "title", Type: types.Optional(types.TypeUTF8)},
+			{Name: "legacy", Type: types.Optional(types.TypeBytes)},
+		},
+		PrimaryKey: []string{"id"},
+		Indexes: []options.IndexDescription{
+			{Name: "title_index", IndexColumns: []string{"title"}},
+			{Name: "legacy_index", IndexColumns: []string{"legacy"}},
+		},
+		Attributes: map[string]string{
+			"owner": "team-a",
+			"stale": "true",
+		},
+		PartitioningSettings: options.PartitioningSettings{
+			PartitioningBySize: options.FeatureEnabled,
+			PartitionSizeMb:    2048,
+			MinPartitionsCount: 1,
+		},
+	}
+
+	t.Run("Equal", func(t *testing.T) {
+		plan := DiffTable(&actual, &options.Description{
+			Columns:    actual.Columns,
+			PrimaryKey: []string{"id"},
+			Indexes: []options.IndexDescription{
+				{Name: "title_index", IndexColumns: []string{"title"}},
+				{Name: "legacy_index", IndexColumns: []string{"legacy"}},
+			},
+		})
+		require.True(t, plan.Empty(), plan.String())
+	})
+
+	t.Run("Changes", func(t *testing.T) {
+		ttl := options.NewTTLSettings().ColumnSeconds("expire_at")
+		plan := DiffTable(&actual, &options.Description{
+			Columns: []options.Column{
+				{Name: "id", Type: types.Optional(types.TypeUint64)},
+				{Name: "title", Type: types.Optional(types.TypeUTF8)},
+				{Name: "expire_at", Type: types.Optional(types.TypeUint32)},
+			},
+			PrimaryKey: []string{"id"},
+			Indexes: []options.IndexDescription{
+				{Name: "title_index", IndexColumns: []string{"title"}, DataColumns: []string{"expire_at"}},
+			},
+			Attributes: map[string]string{
+				"owner": "team-b",
+			},
+			PartitioningSettings: options.PartitioningSettings{
+				MinPartitionsCount: 4,
+			},
+			TimeToLiveSettings: &ttl,
+		})
+		require.Equal(t, ""+
+			"  drop index `title_index`\n"+
+			"  drop index `legacy_index`\n"+
+			"  add column `expire_at` Optional<Uint32>\n"+
+			"  add index `title_index` on (title)\n"+
+			"  set time to live on column `expire_at` expire after 0s\n"+
+			"  alter partitioning settings to {PartitioningBySize:1 PartitionSizeMb:2048 PartitioningByLoad:0 "+
+			"MinPartitionsCount:4 MaxPartitionsCount:0}\n"+
+			"  set attribute \"owner\" = \"team-b\"\n"+
+			"  drop attribute \"stale\"\n"+
+			"! drop column `legacy`\n",
+			plan.String(),
+		)
+		require.Len(t, plan.Unsafe(), 1)
+		require.Len(t, plan.AlterTableOptions(), len(plan.Changes))
+	})
+
+	t.Run("Changefeeds", func(t *testing.T) {
+		withChangefeeds := actual
+		withChangefeeds.Changefeeds = []options.ChangefeedDescription{
+			{Name: "keys", Mode: options.ChangefeedModeKeysOnly, Format: options.ChangefeedFormatJSON},
+			{Name: "updates", Mode: options.ChangefeedModeUpdates, Format: options.ChangefeedFormatJSON},
+		}
+		plan := DiffTable(&withChangefeeds, &options.Description{
+			Columns: actual.Columns,
+			Changefeeds: []options.ChangefeedDescription{
+				{Name: "keys", Mode: options.ChangefeedModeKeysOnly, Format: options.ChangefeedFormatJSON},
+				{Name: "updates", Mode: options.ChangefeedModeNewImage, Format: options.ChangefeedFormatJSON},
+				{Name: "images", Mode: options.ChangefeedModeNewAndOldImages, Format: options.ChangefeedFormatJSON},
+			},
+		})
+		require.Equal(t, ""+
+			"  drop changefeed `updates`\n"+
+			"  add changefeed `updates` with mode new_image and format json\n"+
+			"  add changefeed `images` with mode new_and_old_images and format json\n",
+			plan.String(),
+		)
+	})
+
+	t.Run("ChangefeedSettings", func(t *testing.T) {
+		withChangefeeds := actual
+		withChangefeeds.Changefeeds = []options.ChangefeedDescription{
+			{
+				Name: "keys", Mode: options.ChangefeedModeKeysOnly, Format: options.ChangefeedFormatJSON,
+				Attributes: map[string]string{"a": "1"},
+			},
+			{Name: "updates", Mode: options.ChangefeedModeUpdates, Format: options.ChangefeedFormatJSON},
+		}
+		plan := DiffTable(&withChangefeeds, &options.Description{
+			Columns: actual.Columns,
+			Changefeeds: []options.ChangefeedDescription{
+				{
+					Name: "keys", Mode: options.ChangefeedModeKeysOnly, Format: options.ChangefeedFormatJSON,
+					Attributes: map[string]string{"a": "2"},
+				},
+				{
+					Name: "updates", Mode: options.ChangefeedModeUpdates, Format: options.ChangefeedFormatJSON,
+					RetentionPeriod: time.Hour, InitialScan: true,
+				},
+				{
+					Name: "images", Mode: options.ChangefeedModeNewImage, Format: options.ChangefeedFormatJSON,
+					RetentionPeriod: time.Hour, InitialScan: true,
+					Consumers: []topictypes.Consumer{{Name: "consumer"}},
+				},
+			},
+		})
+		require.Equal(t, ""+
+			"  drop changefeed `keys`\n"+
+			"  add changefeed `keys` with mode keys_only and format json\n"+
+			"  add changefeed `images` with mode new_image and format json\n",
+			plan.String(),
+		)
+		opts := plan.AlterTableOptions()
+		require.Equal(t, map[string][]topictypes.Consumer{
+			"images": {{Name: "consumer"}},
+		}, options.ChangefeedConsumers(opts...))
+
+		var desc options.AlterTableDesc
+		for _, opt := range opts {
+			opt.ApplyAlterTableOption(&desc, nil)
+		}
+		require.Len(t, desc.AddChangefeeds, 2)
+		require.Equal(t, map[string]string{"a": "2"}, desc.AddChangefeeds[0].GetAttributes())
+		require.Equal(t, time.Hour, desc.AddChangefeeds[1].GetRetentionPeriod().AsDuration())
+		require.True(t, desc.AddChangefeeds[1].GetInitialScan())
+	})
+
+	t.Run("ColumnFamily", func(t *testing.T) {
+		plan := DiffTable(&actual, &options.Description{
+			Columns: []options.Column{
+				{Name: "id", Type: types.Optional(types.TypeUint64)},
+				{Name: "title", Type: types.Optional(types.TypeUTF8), Family: "cold"},
+				{Name: "legacy", Type: types.Optional(types.TypeBytes)},
+			},
+		})
+		require.Equal(t, ""+
+			"  change family of column `title` from \"\" to \"cold\"\n",
+			plan.String(),
+		)
+		require.Empty(t, plan.Unsafe())
+
+		var desc options.AlterTableDesc
+		for _, opt := range plan.AlterTableOptions() {
+			opt.ApplyAlterTableOption(&desc, nil)
+		}
+		require.Len(t, desc.AlterColumns, 1)
+		require.Equal(t, "title", desc.AlterColumns[0].GetName())
+		require.Equal(t, "cold", desc.AlterColumns[0].GetFamily())
+	})
+
+	t.Run("Unsafe", func(t *testing.T) {
+		plan := DiffTable(&actual, &options.Description{
+			Columns: []options.Column{
+				{Name: "id", Type: types.Optional(types.TypeInt64)},
+				{Name: "title", Type: types.Optional(types.TypeUTF8)},
+				{Name: "legacy", Type: types.Optional(types.TypeBytes)},
+			},
+			PrimaryKey: []string{"id", "title"},
+		})
+		require.Equal(t, ""+
+			"! change primary key from (id) to (id, title)\n"+
+			"! change type of column `id` from Optional<Uint64> to Optional<Int64>\n",
+			plan.String(),
+		)
+		require.Len(t, plan.Unsafe(), 2)
+		for _, c := range plan.Unsafe() {
+			require.Nil(t, c.Option())
+		}
+		require.Empty(t, plan.AlterTableOptions())
+	})
+}
+
+type testChangefeedTopics struct {
+	topic.Client
+
+	consumers map[string][]topictypes.Consumer
+	alters    []rawtopic.AlterTopicRequest
+}
+
+func (db *testChangefeedTopics) Topic() topic.Client {
+	return db
+}
+
+func (db *testChangefeedTopics) Describe(
+	ctx context.Context, path string, opts ...topicoptions.DescribeOption,
+) (topictypes.TopicDescription, error) {
+	return topictypes.TopicDescription{
+		Path:      path,
+		Consumers: db.consumers[path],
+	}, nil
+}
+
+func (db *testChangefeedTopics) Alter(ctx context.Context, path string, opts ...topicoptions.AlterOption) error {
+	request := rawtopic.AlterTopicRequest{
+		Path: path,
+	}
+	for _, opt := range opts {
+		opt.ApplyAlterOption(&request)
+	}
+	db.alters = append(db.alters, request)
+	for _, c := range request.AddConsumers {
+		db.consumers[path] = append(db.consumers[path], topictypes.Consumer{
+			Name:      c.Name,
+			Important: c.Important,
+		})
+	}
+
+	return nil
+}
+
+func TestAddChangefeedConsumers(t *testing.T) {
+	db := &testChangefeedTopics{
+		consumers: map[string][]topictypes.Consumer{
+			"/local/episodes/updates": {{Name: "reader_a"}},
+		},
+	}
+	consumers := options.ChangefeedConsumers(
+		options.WithAddChangefeed("updates",
+			options.WithChangefeedConsumers(
+				topictypes.Consumer{Name: "reader_b"},
+				topictypes.Consumer{Name: "reader_a"},
+			),
+		),
+	)
+
+	require.NoError(t, addChangefeedConsumers(context.Background(), db, "/local/episodes", consumers))
+	require.Len(t, db.alters, 1)
+	require.Equal(t, "/local/episodes/updates", db.alters[0].Path)
+	require.Len(t, db.alters[0].AddConsumers, 1)
+	require.Equal(t, "reader_b", db.alters[0].AddConsumers[0].Name)
+
+	// repeated call (e.g. after retry of failed apply) does not add consumers twice
+	require.NoError(t, addChangefeedConsumers(context.Background(), db, "/local/episodes", consumers))
+	require.Len(t, db.alters, 1)
+}
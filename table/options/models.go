@@ -11,6 +11,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/feature"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 )
 
 type Column struct {
@@ -569,18 +570,27 @@ func (unit *TimeToLiveUnit) ToYDB() Ydb_Table.ValueSinceUnixEpochModeSettings_Un
 }
 
 type ChangefeedDescription struct {
-	Name   string
-	Mode   ChangefeedMode
-	Format ChangefeedFormat
-	State  ChangefeedState
+	Name              string
+	Mode              ChangefeedMode
+	Format            ChangefeedFormat
+	State             ChangefeedState
+	VirtualTimestamps bool
+	Attributes        map[string]string
+
+	// RetentionPeriod, InitialScan and Consumers are not filled by DescribeTable
+	RetentionPeriod time.Duration
+	InitialScan     bool
+	Consumers       []topictypes.Consumer
 }
 
 func NewChangefeedDescription(proto *Ydb_Table.ChangefeedDescription) ChangefeedDescription {
 	return ChangefeedDescription{
-		Name:   proto.GetName(),
-		Mode:   ChangefeedMode(proto.GetMode()),
-		Format: ChangefeedFormat(proto.GetFormat()),
-		State:  ChangefeedState(proto.GetState()),
+		Name:              proto.GetName(),
+		Mode:              ChangefeedMode(proto.GetMode()),
+		Format:            ChangefeedFormat(proto.GetFormat()),
+		State:             ChangefeedState(proto.GetState()),
+		VirtualTimestamps: proto.GetVirtualTimestamps(),
+		Attributes:        proto.GetAttributes(),
 	}
 }
 
@@ -1,13 +1,17 @@
 package options
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 )
 
 func WithShardKeyBounds() DescribeTableOption {
@@ -746,6 +750,26 @@ func WithDropColumn(name string) AlterTableOption {
 	return dropColumn(name)
 }
 
+type alterColumnFamily struct {
+	name   string
+	family string
+}
+
+func (c alterColumnFamily) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.AlterColumns = append(d.AlterColumns, &Ydb_Table.ColumnMeta{
+		Name:   c.name,
+		Family: c.family,
+	})
+}
+
+// WithAlterColumnFamily moves column to column family in AlterTable request
+func WithAlterColumnFamily(name, family string) AlterTableOption {
+	return alterColumnFamily{
+		name:   name,
+		family: family,
+	}
+}
+
 func WithAddColumnFamilies(cf ...ColumnFamily) AlterTableOption {
 	return columnFamilies(cf)
 }
@@ -786,6 +810,160 @@ func WithDropTimeToLive() AlterTableOption {
 	return dropTimeToLive{}
 }
 
+type (
+	changefeedDesc   Ydb_Table.Changefeed
+	ChangefeedOption interface {
+		ApplyChangefeedOption(d *changefeedDesc)
+	}
+)
+
+type changefeed struct {
+	name string
+	opts []ChangefeedOption
+}
+
+func (cf changefeed) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	x := &Ydb_Table.Changefeed{
+		Name: cf.name,
+	}
+	for _, opt := range cf.opts {
+		if opt != nil {
+			opt.ApplyChangefeedOption((*changefeedDesc)(x))
+		}
+	}
+	d.AddChangefeeds = append(d.AddChangefeeds, x)
+}
+
+// WithAddChangefeed adds changefeed in AlterTable request
+//
+// Changefeed writes changes of table into topic with path `<table path>/<changefeed name>`.
+// Consumers of changefeed topic are defined with WithChangefeedConsumers
+func WithAddChangefeed(name string, opts ...ChangefeedOption) AlterTableOption {
+	return changefeed{
+		name: name,
+		opts: opts,
+	}
+}
+
+type dropChangefeed string
+
+func (name dropChangefeed) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.DropChangefeeds = append(d.DropChangefeeds, string(name))
+}
+
+// WithDropChangefeed drops changefeed in AlterTable request
+func WithDropChangefeed(name string) AlterTableOption {
+	return dropChangefeed(name)
+}
+
+func (mode ChangefeedMode) ApplyChangefeedOption(d *changefeedDesc) {
+	d.Mode = Ydb_Table.ChangefeedMode_Mode(mode)
+}
+
+// WithChangefeedMode defines information which will be written to changefeed
+func WithChangefeedMode(mode ChangefeedMode) ChangefeedOption {
+	return mode
+}
+
+func (format ChangefeedFormat) ApplyChangefeedOption(d *changefeedDesc) {
+	d.Format = Ydb_Table.ChangefeedFormat_Format(format)
+}
+
+// WithChangefeedFormat defines format of changefeed records
+func WithChangefeedFormat(format ChangefeedFormat) ChangefeedOption {
+	return format
+}
+
+type changefeedRetentionPeriod time.Duration
+
+func (retentionPeriod changefeedRetentionPeriod) ApplyChangefeedOption(d *changefeedDesc) {
+	d.RetentionPeriod = durationpb.New(time.Duration(retentionPeriod))
+}
+
+// WithChangefeedRetentionPeriod defines how long data in changefeed topic should be stored
+func WithChangefeedRetentionPeriod(retentionPeriod time.Duration) ChangefeedOption {
+	return changefeedRetentionPeriod(retentionPeriod)
+}
+
+type changefeedVirtualTimestamps bool
+
+func (virtualTimestamps changefeedVirtualTimestamps) ApplyChangefeedOption(d *changefeedDesc) {
+	d.VirtualTimestamps = bool(virtualTimestamps)
+}
+
+// WithChangefeedVirtualTimestamps enables emitting of virtual timestamps of changes along with data
+func WithChangefeedVirtualTimestamps(virtualTimestamps bool) ChangefeedOption {
+	return changefeedVirtualTimestamps(virtualTimestamps)
+}
+
+type changefeedInitialScan bool
+
+func (initialScan changefeedInitialScan) ApplyChangefeedOption(d *changefeedDesc) {
+	d.InitialScan = bool(initialScan)
+}
+
+// WithChangefeedInitialScan enables output of current state of the table before changes
+func WithChangefeedInitialScan(initialScan bool) ChangefeedOption {
+	return changefeedInitialScan(initialScan)
+}
+
+type changefeedAttribute struct {
+	key   string
+	value string
+}
+
+func (a changefeedAttribute) ApplyChangefeedOption(d *changefeedDesc) {
+	if d.Attributes == nil {
+		d.Attributes = make(map[string]string)
+	}
+	d.Attributes[a.key] = a.value
+}
+
+// WithChangefeedAttribute adds attribute to changefeed
+func WithChangefeedAttribute(key, value string) ChangefeedOption {
+	return changefeedAttribute{
+		key:   key,
+		value: value,
+	}
+}
+
+type changefeedConsumers []topictypes.Consumer
+
+func (changefeedConsumers) ApplyChangefeedOption(d *changefeedDesc) {
+	// consumers are not a part of changefeed in AlterTable request, see ChangefeedConsumers
+}
+
+// WithChangefeedConsumers adds consumers to topic of changefeed.
+//
+// AlterTable request cannot contain consumers, so Session.AlterTable ignores them. Consumers are added
+// to changefeed topic by sugar.ApplyTable and sugar.RestoreSchema with separate AlterTopic call
+// after successful creation of changefeed (see ChangefeedConsumers)
+func WithChangefeedConsumers(consumers ...topictypes.Consumer) ChangefeedOption {
+	return changefeedConsumers(consumers)
+}
+
+// ChangefeedConsumers returns consumers of changefeed topics (by names of changefeeds) which
+// WithAddChangefeed options with WithChangefeedConsumers define
+func ChangefeedConsumers(opts ...AlterTableOption) map[string][]topictypes.Consumer {
+	var consumers map[string][]topictypes.Consumer
+	for _, opt := range opts {
+		cf, ok := opt.(changefeed)
+		if !ok {
+			continue
+		}
+		for _, cfOpt := range cf.opts {
+			if c, ok := cfOpt.(changefeedConsumers); ok && len(c) > 0 {
+				if consumers == nil {
+					consumers = make(map[string][]topictypes.Consumer)
+				}
+				consumers[cf.name] = append(consumers[cf.name], c...)
+			}
+		}
+	}
+
+	return consumers
+}
+
 type (
 	CopyTableDesc   Ydb_Table.CopyTableRequest
 	CopyTableOption func(*CopyTableDesc)
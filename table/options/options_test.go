@@ -2,6 +2,7 @@ package options
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
@@ -251,4 +252,35 @@ func TestAlterTableOptions(t *testing.T) {
 			t.Errorf("Alter table storage settings options is not as expected")
 		}
 	}
+	{
+		opt := WithAddChangefeed("feed",
+			WithChangefeedMode(ChangefeedModeNewAndOldImages),
+			WithChangefeedFormat(ChangefeedFormatJSON),
+			WithChangefeedRetentionPeriod(time.Hour),
+			WithChangefeedVirtualTimestamps(true),
+			WithChangefeedInitialScan(true),
+			WithChangefeedAttribute("k", "v"),
+		)
+		req := Ydb_Table.AlterTableRequest{}
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		if len(req.GetAddChangefeeds()) != 1 ||
+			req.GetAddChangefeeds()[0].GetName() != "feed" ||
+			req.GetAddChangefeeds()[0].GetMode() != Ydb_Table.ChangefeedMode_MODE_NEW_AND_OLD_IMAGES ||
+			req.GetAddChangefeeds()[0].GetFormat() != Ydb_Table.ChangefeedFormat_FORMAT_JSON ||
+			req.GetAddChangefeeds()[0].GetRetentionPeriod().AsDuration() != time.Hour ||
+			!req.GetAddChangefeeds()[0].GetVirtualTimestamps() ||
+			!req.GetAddChangefeeds()[0].GetInitialScan() ||
+			req.GetAddChangefeeds()[0].GetAttributes()["k"] != "v" {
+			t.Errorf("Alter table add changefeed options is not as expected")
+		}
+	}
+	{
+		opt := WithDropChangefeed("feed")
+		req := Ydb_Table.AlterTableRequest{}
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		if len(req.GetDropChangefeeds()) != 1 ||
+			req.GetDropChangefeeds()[0] != "feed" {
+			t.Errorf("Alter table drop changefeed options is not as expected")
+		}
+	}
 }
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+type (
+	// ValueConverter is an interface of custom go types which converts itself into YDB value with FromGo
+	ValueConverter = value.ValueConverter
+
+	// ValueScanner is an interface of custom go types which fills itself from YDB value with ToGo
+	ValueScanner = value.ValueScanner
+)
+
+// FromGo makes YDB value from go value by reflection.
+//
+// Structs are converted to Struct (names of members are taken from `sql` tag or names of fields),
+// slices and arrays to List ([]byte to String), maps to Dict (map[K]struct{} to Set), pointers to
+// Optional (nil pointers to null), int and uint to Int64 and Uint64, time.Time to Timestamp,
+// time.Duration to Interval, uuid.UUID to Uuid, json.RawMessage to Json and Decimal to Decimal.
+// Values of types implements ValueConverter or driver.Valuer converts by itself
+func FromGo(v interface{}) (Value, error) {
+	return value.FromGo(v)
+}
+
+// ToGo stores YDB value into go value pointed by dst by reflection (mapping is reversed to FromGo).
+// Null values stores as nil pointers or zero values. Destinations of types implements ValueScanner
+// fills by itself. Destination interface{} receives natural go representation of value
+func ToGo(v Value, dst interface{}) error {
+	return value.ToGo(v, dst)
+}
+
+// TypeFromGo returns YDB type which FromGo makes from values of go type t
+func TypeFromGo(t reflect.Type) (Type, error) {
+	return value.TypeFromGo(t)
+}
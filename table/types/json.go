@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+// MarshalJSON returns JSON representation of value.
+//
+// Int64 and Uint64 values are encoded as JSON strings, String and Yson as base64 strings,
+// Decimal as string with decimal number, dates and timestamps as ISO 8601 strings in UTC,
+// intervals as ISO 8601 durations, structs as JSON objects, lists, sets and tuples as JSON arrays,
+// dicts as arrays of key-value pairs and variants as pair of alternative name (or index) and value.
+// Null values are encoded as null.
+func MarshalJSON(v Value) ([]byte, error) {
+	return value.MarshalJSON(v)
+}
+
+// UnmarshalJSON makes value of type t from JSON representation produced by MarshalJSON.
+// Integers are accepted both as JSON numbers and strings. Missing members of struct are
+// decoded as nulls if type of member is optional
+func UnmarshalJSON(t Type, data []byte) (Value, error) {
+	return value.UnmarshalJSON(t, data)
+}
@@ -76,6 +76,16 @@ func Optional(t Type) Type {
 	return types.NewOptional(t)
 }
 
+func Tagged(t Type, tag string) Type {
+	return types.NewTagged(t, tag)
+}
+
+// ParseType parses string representation of type such as result of Type.Yql().
+// For example: List<Struct<id:Uint64,name:Optional<Utf8>>>, Decimal(22,9), Int32? or Tagged<Utf8,'tag'>
+func ParseType(s string) (Type, error) {
+	return types.Parse(s)
+}
+
 var DefaultDecimal = DecimalType(22, 9)
 
 func DecimalType(precision, scale uint32) Type {
@@ -116,6 +126,10 @@ const (
 	TypeUUID         = types.UUID
 	TypeJSONDocument = types.JSONDocument
 	TypeDyNumber     = types.DyNumber
+	TypeDate32       = types.Date32
+	TypeDatetime64   = types.Datetime64
+	TypeTimestamp64  = types.Timestamp64
+	TypeInterval64   = types.Interval64
 )
 
 // WriteTypeStringTo writes ydb type string representation into buffer
@@ -115,6 +115,38 @@ func TzTimestampValueFromTime(t time.Time) Value {
 	return value.TzTimestampValueFromTime(t)
 }
 
+// Date32Value returns ydb date32 value by given days since Epoch (negative before 1970)
+func Date32Value(v int32) Value { return value.Date32Value(v) }
+
+// Datetime64Value makes ydb datetime64 value from seconds since Epoch (negative before 1970)
+func Datetime64Value(v int64) Value { return value.Datetime64Value(v) }
+
+// Timestamp64Value makes ydb timestamp64 value from microseconds since Epoch (negative before 1970)
+func Timestamp64Value(v int64) Value { return value.Timestamp64Value(v) }
+
+// Interval64ValueFromMicroseconds makes Interval64 value from given microseconds value
+func Interval64ValueFromMicroseconds(v int64) Value { return value.Interval64Value(v) }
+
+// Date32ValueFromTime makes Date32 value from time.Time
+func Date32ValueFromTime(t time.Time) Value {
+	return value.Date32ValueFromTime(t)
+}
+
+// Datetime64ValueFromTime makes Datetime64 value from time.Time
+func Datetime64ValueFromTime(t time.Time) Value {
+	return value.Datetime64ValueFromTime(t)
+}
+
+// Timestamp64ValueFromTime makes Timestamp64 value from time.Time
+func Timestamp64ValueFromTime(t time.Time) Value {
+	return value.Timestamp64ValueFromTime(t)
+}
+
+// Interval64ValueFromDuration makes Interval64 value from time.Duration
+func Interval64ValueFromDuration(v time.Duration) Value {
+	return value.Interval64ValueFromDuration(v)
+}
+
 // StringValue returns bytes value
 //
 // Deprecated: use BytesValue instead
@@ -358,6 +390,38 @@ func NullableIntervalValueFromDuration(v *time.Duration) Value {
 	return value.NullableIntervalValueFromDuration(v)
 }
 
+func NullableDate32Value(v *int32) Value {
+	return value.NullableDate32Value(v)
+}
+
+func NullableDate32ValueFromTime(v *time.Time) Value {
+	return value.NullableDate32ValueFromTime(v)
+}
+
+func NullableDatetime64Value(v *int64) Value {
+	return value.NullableDatetime64Value(v)
+}
+
+func NullableDatetime64ValueFromTime(v *time.Time) Value {
+	return value.NullableDatetime64ValueFromTime(v)
+}
+
+func NullableTimestamp64Value(v *int64) Value {
+	return value.NullableTimestamp64Value(v)
+}
+
+func NullableTimestamp64ValueFromTime(v *time.Time) Value {
+	return value.NullableTimestamp64ValueFromTime(v)
+}
+
+func NullableInterval64ValueFromMicroseconds(v *int64) Value {
+	return value.NullableInterval64ValueFromMicroseconds(v)
+}
+
+func NullableInterval64ValueFromDuration(v *time.Duration) Value {
+	return value.NullableInterval64ValueFromDuration(v)
+}
+
 // NullableStringValue
 //
 // Deprecated: use NullableBytesValue instead
@@ -9,6 +9,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
@@ -18,7 +19,7 @@ var ErrNotComparable = xerrors.Wrap(fmt.Errorf("not comparable"))
 // Compare compares its operands.
 // It returns -1, 0, 1 if l < r, l == r, l > r. Returns error if types are not comparable.
 // Comparable types are all integer types, UUID, DyNumber, Float, Double, String, UTF8,
-// Date, Datetime, Timestamp, Tuples and Lists.
+// Date, Datetime, Timestamp, Date32, Datetime64, Timestamp64, Tuples and Lists.
 // Primitive arguments are comparable if their types are the same.
 // Optional types is comparable to underlying types, e.g. Optional<Optional<Float>> is comparable to Float.
 // Null value is comparable to non-null value of the same types and is considered less than any non-null value.
@@ -192,9 +193,15 @@ var comparators = map[Ydb.Type_PrimitiveTypeId]comparator{
 	Ydb.Type_DATETIME:  compareUint32,
 	Ydb.Type_TIMESTAMP: compareUint64,
 	Ydb.Type_INTERVAL:  compareInt64,
-	Ydb.Type_STRING:    compareBytes,
-	Ydb.Type_UTF8:      compareText,
-	Ydb.Type_UUID:      compareUUID,
+
+	types.TypeIDDate32:      compareInt32,
+	types.TypeIDDatetime64:  compareInt64,
+	types.TypeIDTimestamp64: compareInt64,
+	types.TypeIDInterval64:  compareInt64,
+
+	Ydb.Type_STRING: compareBytes,
+	Ydb.Type_UTF8:   compareText,
+	Ydb.Type_UUID:   compareUUID,
 }
 
 func compareUint32(l, r *Ydb.Value) int {
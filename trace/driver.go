@@ -59,6 +59,24 @@ type (
 		)
 		OnBalancerUpdate func(DriverBalancerUpdateStartInfo) func(DriverBalancerUpdateDoneInfo)
 
+		// Outlier detection events
+		OnBalancerEndpointEject   func(DriverBalancerEndpointEjectStartInfo) func(DriverBalancerEndpointEjectDoneInfo)
+		OnBalancerEndpointReadmit func(
+			DriverBalancerEndpointReadmitStartInfo,
+		) func(
+			DriverBalancerEndpointReadmitDoneInfo,
+		)
+
+		// Hedging events
+		OnBalancerHedge func(DriverBalancerHedgeStartInfo) func(DriverBalancerHedgeDoneInfo)
+
+		// Circuit breaker events
+		OnBalancerCircuitBreakerStateChange func(
+			DriverBalancerCircuitBreakerStateChangeStartInfo,
+		) func(
+			DriverBalancerCircuitBreakerStateChangeDoneInfo,
+		)
+
 		// Credentials events
 		OnGetCredentials func(DriverGetCredentialsStartInfo) func(DriverGetCredentialsDoneInfo)
 	}
@@ -153,7 +171,67 @@ type (
 		Dropped   []EndpointInfo
 		LocalDC   string
 	}
-	DriverBalancerClusterDiscoveryAttemptStartInfo struct {
+	DriverBalancerEndpointEjectStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context  *context.Context
+		Call     call
+		Endpoint EndpointInfo
+		// Reason is a reason of ejection: consecutive_errors or failure_rate
+		Reason string
+		// Ejections is a count of ejections of endpoint in a row
+		Ejections int
+		Duration  time.Duration
+	}
+	DriverBalancerEndpointEjectDoneInfo    struct{}
+	DriverBalancerEndpointReadmitStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context  *context.Context
+		Call     call
+		Endpoint EndpointInfo
+		// SlowStart is a duration of traffic ramp-up to re-admitted endpoint
+		SlowStart time.Duration
+	}
+	DriverBalancerEndpointReadmitDoneInfo struct{}
+	DriverBalancerHedgeStartInfo          struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+		Method  Method
+		// Endpoint is an endpoint of primary request
+		Endpoint EndpointInfo
+		// Hedged is an endpoint of hedged request
+		Hedged EndpointInfo
+		// Delay is a delay between primary and hedged requests
+		Delay time.Duration
+	}
+	DriverBalancerHedgeDoneInfo struct {
+		// Winner is an endpoint which response was used. Winner is nil if both requests failed
+		Winner EndpointInfo
+		Error  error
+	}
+	DriverBalancerCircuitBreakerStateChangeStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context  *context.Context
+		Call     call
+		Endpoint EndpointInfo
+		// From and To are states of circuit breaker: closed, open or half-open
+		From string
+		To   string
+	}
+	DriverBalancerCircuitBreakerStateChangeDoneInfo struct{}
+	DriverBalancerClusterDiscoveryAttemptStartInfo  struct {
 		// Context make available context in trace callback function.
 		// Pointer to context provide replacement of context in trace callback function.
 		// Warning: concurrent access to pointer on client side must be excluded.
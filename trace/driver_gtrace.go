@@ -4,6 +4,7 @@ package trace
 
 import (
 	"context"
+	"time"
 )
 
 // driverComposeOptions is a holder of options
@@ -835,6 +836,146 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 			}
 		}
 	}
+	{
+		h1 := t.OnBalancerEndpointEject
+		h2 := x.OnBalancerEndpointEject
+		ret.OnBalancerEndpointEject = func(d DriverBalancerEndpointEjectStartInfo) func(DriverBalancerEndpointEjectDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(DriverBalancerEndpointEjectDoneInfo)
+			if h1 != nil {
+				r = h1(d)
+			}
+			if h2 != nil {
+				r1 = h2(d)
+			}
+			return func(d DriverBalancerEndpointEjectDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(d)
+				}
+				if r1 != nil {
+					r1(d)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnBalancerEndpointReadmit
+		h2 := x.OnBalancerEndpointReadmit
+		ret.OnBalancerEndpointReadmit = func(d DriverBalancerEndpointReadmitStartInfo) func(DriverBalancerEndpointReadmitDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(DriverBalancerEndpointReadmitDoneInfo)
+			if h1 != nil {
+				r = h1(d)
+			}
+			if h2 != nil {
+				r1 = h2(d)
+			}
+			return func(d DriverBalancerEndpointReadmitDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(d)
+				}
+				if r1 != nil {
+					r1(d)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnBalancerHedge
+		h2 := x.OnBalancerHedge
+		ret.OnBalancerHedge = func(d DriverBalancerHedgeStartInfo) func(DriverBalancerHedgeDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(DriverBalancerHedgeDoneInfo)
+			if h1 != nil {
+				r = h1(d)
+			}
+			if h2 != nil {
+				r1 = h2(d)
+			}
+			return func(d DriverBalancerHedgeDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(d)
+				}
+				if r1 != nil {
+					r1(d)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnBalancerCircuitBreakerStateChange
+		h2 := x.OnBalancerCircuitBreakerStateChange
+		ret.OnBalancerCircuitBreakerStateChange = func(d DriverBalancerCircuitBreakerStateChangeStartInfo) func(DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(DriverBalancerCircuitBreakerStateChangeDoneInfo)
+			if h1 != nil {
+				r = h1(d)
+			}
+			if h2 != nil {
+				r1 = h2(d)
+			}
+			return func(d DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(d)
+				}
+				if r1 != nil {
+					r1(d)
+				}
+			}
+		}
+	}
 	{
 		h1 := t.OnGetCredentials
 		h2 := x.OnGetCredentials
@@ -1217,6 +1358,66 @@ func (t *Driver) onBalancerUpdate(d DriverBalancerUpdateStartInfo) func(DriverBa
 	}
 	return res
 }
+func (t *Driver) onBalancerEndpointEject(d DriverBalancerEndpointEjectStartInfo) func(DriverBalancerEndpointEjectDoneInfo) {
+	fn := t.OnBalancerEndpointEject
+	if fn == nil {
+		return func(DriverBalancerEndpointEjectDoneInfo) {
+			return
+		}
+	}
+	res := fn(d)
+	if res == nil {
+		return func(DriverBalancerEndpointEjectDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Driver) onBalancerEndpointReadmit(d DriverBalancerEndpointReadmitStartInfo) func(DriverBalancerEndpointReadmitDoneInfo) {
+	fn := t.OnBalancerEndpointReadmit
+	if fn == nil {
+		return func(DriverBalancerEndpointReadmitDoneInfo) {
+			return
+		}
+	}
+	res := fn(d)
+	if res == nil {
+		return func(DriverBalancerEndpointReadmitDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Driver) onBalancerHedge(d DriverBalancerHedgeStartInfo) func(DriverBalancerHedgeDoneInfo) {
+	fn := t.OnBalancerHedge
+	if fn == nil {
+		return func(DriverBalancerHedgeDoneInfo) {
+			return
+		}
+	}
+	res := fn(d)
+	if res == nil {
+		return func(DriverBalancerHedgeDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Driver) onBalancerCircuitBreakerStateChange(d DriverBalancerCircuitBreakerStateChangeStartInfo) func(DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+	fn := t.OnBalancerCircuitBreakerStateChange
+	if fn == nil {
+		return func(DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+			return
+		}
+	}
+	res := fn(d)
+	if res == nil {
+		return func(DriverBalancerCircuitBreakerStateChangeDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 func (t *Driver) onGetCredentials(d DriverGetCredentialsStartInfo) func(DriverGetCredentialsDoneInfo) {
 	fn := t.OnGetCredentials
 	if fn == nil {
@@ -1519,6 +1720,61 @@ func DriverOnBalancerUpdate(t *Driver, c *context.Context, call call, needLocalD
 		res(p)
 	}
 }
+func DriverOnBalancerEndpointEject(t *Driver, c *context.Context, call call, endpoint EndpointInfo, reason string, ejections int, d time.Duration) func() {
+	var p DriverBalancerEndpointEjectStartInfo
+	p.Context = c
+	p.Call = call
+	p.Endpoint = endpoint
+	p.Reason = reason
+	p.Ejections = ejections
+	p.Duration = d
+	res := t.onBalancerEndpointEject(p)
+	return func() {
+		var p DriverBalancerEndpointEjectDoneInfo
+		res(p)
+	}
+}
+func DriverOnBalancerEndpointReadmit(t *Driver, c *context.Context, call call, endpoint EndpointInfo, slowStart time.Duration) func() {
+	var p DriverBalancerEndpointReadmitStartInfo
+	p.Context = c
+	p.Call = call
+	p.Endpoint = endpoint
+	p.SlowStart = slowStart
+	res := t.onBalancerEndpointReadmit(p)
+	return func() {
+		var p DriverBalancerEndpointReadmitDoneInfo
+		res(p)
+	}
+}
+func DriverOnBalancerHedge(t *Driver, c *context.Context, call call, m Method, endpoint EndpointInfo, hedged EndpointInfo, delay time.Duration) func(winner EndpointInfo, _ error) {
+	var p DriverBalancerHedgeStartInfo
+	p.Context = c
+	p.Call = call
+	p.Method = m
+	p.Endpoint = endpoint
+	p.Hedged = hedged
+	p.Delay = delay
+	res := t.onBalancerHedge(p)
+	return func(winner EndpointInfo, e error) {
+		var p DriverBalancerHedgeDoneInfo
+		p.Winner = winner
+		p.Error = e
+		res(p)
+	}
+}
+func DriverOnBalancerCircuitBreakerStateChange(t *Driver, c *context.Context, call call, endpoint EndpointInfo, from string, to string) func() {
+	var p DriverBalancerCircuitBreakerStateChangeStartInfo
+	p.Context = c
+	p.Call = call
+	p.Endpoint = endpoint
+	p.From = from
+	p.To = to
+	res := t.onBalancerCircuitBreakerStateChange(p)
+	return func() {
+		var p DriverBalancerCircuitBreakerStateChangeDoneInfo
+		res(p)
+	}
+}
 func DriverOnGetCredentials(t *Driver, c *context.Context, call call) func(token string, _ error) {
 	var p DriverGetCredentialsStartInfo
 	p.Context = c
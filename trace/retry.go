@@ -13,6 +13,16 @@ type (
 	// gtrace:gen
 	Retry struct {
 		OnRetry func(RetryLoopStartInfo) func(RetryLoopIntermediateInfo) func(RetryLoopDoneInfo)
+
+		// OnBudget calls on taking token from retry budget after failed attempt
+		OnBudget func(RetryBudgetStartInfo) func(RetryBudgetDoneInfo)
+
+		// OnCircuitBreakerStateChange calls on change of state of circuit breaker of retry label
+		OnCircuitBreakerStateChange func(
+			RetryCircuitBreakerStateChangeStartInfo,
+		) func(
+			RetryCircuitBreakerStateChangeDoneInfo,
+		)
 	}
 	RetryLoopStartInfo struct {
 		// Context make available context in trace callback function.
@@ -34,4 +44,35 @@ type (
 		Attempts int
 		Error    error
 	}
+	RetryBudgetStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+
+		Call  call
+		Label string
+	}
+	RetryBudgetDoneInfo struct {
+		// Tokens is a count of tokens in retry budget after taking of token
+		Tokens    float64
+		MaxTokens float64
+		// Allowed is false if retry attempt is not allowed by retry budget
+		Allowed bool
+	}
+	RetryCircuitBreakerStateChangeStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+
+		Call  call
+		Label string
+		// From and To are states of circuit breaker: closed, open or half-open
+		From string
+		To   string
+	}
+	RetryCircuitBreakerStateChangeDoneInfo struct{}
 )
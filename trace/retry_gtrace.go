@@ -81,6 +81,76 @@ func (t *Retry) Compose(x *Retry, opts ...RetryComposeOption) *Retry {
 			}
 		}
 	}
+	{
+		h1 := t.OnBudget
+		h2 := x.OnBudget
+		ret.OnBudget = func(r RetryBudgetStartInfo) func(RetryBudgetDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r1, r2 func(RetryBudgetDoneInfo)
+			if h1 != nil {
+				r1 = h1(r)
+			}
+			if h2 != nil {
+				r2 = h2(r)
+			}
+			return func(r RetryBudgetDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r1 != nil {
+					r1(r)
+				}
+				if r2 != nil {
+					r2(r)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnCircuitBreakerStateChange
+		h2 := x.OnCircuitBreakerStateChange
+		ret.OnCircuitBreakerStateChange = func(r RetryCircuitBreakerStateChangeStartInfo) func(RetryCircuitBreakerStateChangeDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r1, r2 func(RetryCircuitBreakerStateChangeDoneInfo)
+			if h1 != nil {
+				r1 = h1(r)
+			}
+			if h2 != nil {
+				r2 = h2(r)
+			}
+			return func(r RetryCircuitBreakerStateChangeDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r1 != nil {
+					r1(r)
+				}
+				if r2 != nil {
+					r2(r)
+				}
+			}
+		}
+	}
 	return &ret
 }
 func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopIntermediateInfo) func(RetryLoopDoneInfo) {
@@ -110,6 +180,36 @@ func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopIntermediateInfo) fu
 		return res
 	}
 }
+func (t *Retry) onBudget(r RetryBudgetStartInfo) func(RetryBudgetDoneInfo) {
+	fn := t.OnBudget
+	if fn == nil {
+		return func(RetryBudgetDoneInfo) {
+			return
+		}
+	}
+	res := fn(r)
+	if res == nil {
+		return func(RetryBudgetDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Retry) onCircuitBreakerStateChange(r RetryCircuitBreakerStateChangeStartInfo) func(RetryCircuitBreakerStateChangeDoneInfo) {
+	fn := t.OnCircuitBreakerStateChange
+	if fn == nil {
+		return func(RetryCircuitBreakerStateChangeDoneInfo) {
+			return
+		}
+	}
+	res := fn(r)
+	if res == nil {
+		return func(RetryCircuitBreakerStateChangeDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 func RetryOnRetry(t *Retry, c *context.Context, call call, label string, idempotent bool, nestedCall bool) func(error) func(attempts int, _ error) {
 	var p RetryLoopStartInfo
 	p.Context = c
@@ -130,3 +230,30 @@ func RetryOnRetry(t *Retry, c *context.Context, call call, label string, idempot
 		}
 	}
 }
+func RetryOnBudget(t *Retry, c *context.Context, call call, label string) func(tokens float64, maxTokens float64, allowed bool) {
+	var p RetryBudgetStartInfo
+	p.Context = c
+	p.Call = call
+	p.Label = label
+	res := t.onBudget(p)
+	return func(tokens float64, maxTokens float64, allowed bool) {
+		var p RetryBudgetDoneInfo
+		p.Tokens = tokens
+		p.MaxTokens = maxTokens
+		p.Allowed = allowed
+		res(p)
+	}
+}
+func RetryOnCircuitBreakerStateChange(t *Retry, c *context.Context, call call, label string, from string, to string) func() {
+	var p RetryCircuitBreakerStateChangeStartInfo
+	p.Context = c
+	p.Call = call
+	p.Label = label
+	p.From = from
+	p.To = to
+	res := t.onCircuitBreakerStateChange(p)
+	return func() {
+		var p RetryCircuitBreakerStateChangeDoneInfo
+		res(p)
+	}
+}
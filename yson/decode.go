@@ -0,0 +1,831 @@
+package yson
+
+import (
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type nodeKind uint8
+
+const (
+	kindEntity nodeKind = iota
+	kindBool
+	kindInt64
+	kindUint64
+	kindDouble
+	kindString
+	kindList
+	kindMap
+)
+
+func (k nodeKind) String() string {
+	switch k {
+	case kindEntity:
+		return "entity"
+	case kindBool:
+		return "boolean"
+	case kindInt64:
+		return "int64"
+	case kindUint64:
+		return "uint64"
+	case kindDouble:
+		return "double"
+	case kindString:
+		return "string"
+	case kindList:
+		return "list"
+	case kindMap:
+		return "map"
+	default:
+		return fmt.Sprintf("nodeKind(%d)", uint8(k))
+	}
+}
+
+// node is a parsed YSON value. Keys of maps and attributes keep order of source
+type node struct {
+	kind   nodeKind
+	attrs  *node
+	b      bool
+	i      int64
+	u      uint64
+	f      float64
+	s      string
+	items  []*node
+	keys   []string
+	values []*node
+}
+
+// parse parses single YSON value. Text and binary scalars may be mixed
+func parse(data []byte) (*node, error) {
+	p := parser{data: data}
+	n, err := p.value()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	p.skipSpaces()
+	if p.pos < len(p.data) {
+		return nil, xerrors.WithStackTrace(p.errorf("unexpected %q after value", p.data[p.pos]))
+	}
+
+	return n, nil
+}
+
+type parser struct {
+	data  []byte
+	pos   int
+	depth int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w at offset %d: %s", errSyntax, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.data) {
+		switch p.data[p.pos] {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+// peek returns next significant byte or 0 at the end of data
+func (p *parser) peek() byte {
+	p.skipSpaces()
+	if p.pos < len(p.data) {
+		return p.data[p.pos]
+	}
+
+	return 0
+}
+
+func (p *parser) expect(c byte) error {
+	if p.peek() != c {
+		if p.pos >= len(p.data) {
+			return p.errorf("expected %q, got end of data", c)
+		}
+
+		return p.errorf("expected %q, got %q", c, p.data[p.pos])
+	}
+	p.pos++
+
+	return nil
+}
+
+func (p *parser) value() (*node, error) {
+	var attrs *node
+	if p.peek() == '<' {
+		var err error
+		if attrs, err = p.mapping('<', '>'); err != nil {
+			return nil, err
+		}
+	}
+	n, err := p.scalarOrContainer()
+	if err != nil {
+		return nil, err
+	}
+	n.attrs = attrs
+
+	return n, nil
+}
+
+//nolint:funlen,gocyclo
+func (p *parser) scalarOrContainer() (*node, error) {
+	c := p.peek()
+	switch {
+	case p.pos >= len(p.data):
+		return nil, p.errorf("unexpected end of data")
+	case c == '#':
+		p.pos++
+
+		return &node{kind: kindEntity}, nil
+	case c == '[':
+		return p.list()
+	case c == '{':
+		return p.mapping('{', '}')
+	case c == '"':
+		s, err := p.quoted()
+		if err != nil {
+			return nil, err
+		}
+
+		return &node{kind: kindString, s: s}, nil
+	case c == '%':
+		return p.literal()
+	case c == '-' || c == '+' || c >= '0' && c <= '9':
+		return p.number()
+	case c == binaryString:
+		s, err := p.binaryString()
+		if err != nil {
+			return nil, err
+		}
+
+		return &node{kind: kindString, s: s}, nil
+	case c == binaryInt64:
+		p.pos++
+		v, n := binary.Varint(p.data[p.pos:])
+		if n <= 0 {
+			return nil, p.errorf("invalid binary int64")
+		}
+		p.pos += n
+
+		return &node{kind: kindInt64, i: v}, nil
+	case c == binaryUint64:
+		p.pos++
+		v, n := binary.Uvarint(p.data[p.pos:])
+		if n <= 0 {
+			return nil, p.errorf("invalid binary uint64")
+		}
+		p.pos += n
+
+		return &node{kind: kindUint64, u: v}, nil
+	case c == binaryDouble:
+		p.pos++
+		if len(p.data)-p.pos < 8 {
+			return nil, p.errorf("invalid binary double")
+		}
+		v := math.Float64frombits(binary.LittleEndian.Uint64(p.data[p.pos:]))
+		p.pos += 8
+
+		return &node{kind: kindDouble, f: v}, nil
+	case c == binaryFalse || c == binaryTrue:
+		p.pos++
+
+		return &node{kind: kindBool, b: c == binaryTrue}, nil
+	case isIdentifierStart(c):
+		return &node{kind: kindString, s: p.identifier()}, nil
+	default:
+		return nil, p.errorf("unexpected %q", c)
+	}
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isIdentifierChar(c byte) bool {
+	return isIdentifierStart(c) || c >= '0' && c <= '9' || c == '-' || c == '.'
+}
+
+func (p *parser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.data) && isIdentifierChar(p.data[p.pos]) {
+		p.pos++
+	}
+
+	return string(p.data[start:p.pos])
+}
+
+func (p *parser) binaryString() (string, error) {
+	p.pos++
+	l, n := binary.Varint(p.data[p.pos:])
+	if n <= 0 || l < 0 || int64(len(p.data)-p.pos-n) < l {
+		return "", p.errorf("invalid binary string")
+	}
+	p.pos += n
+	s := string(p.data[p.pos : p.pos+int(l)])
+	p.pos += int(l)
+
+	return s, nil
+}
+
+func unhex(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	default:
+		return 0, false
+	}
+}
+
+//nolint:gocyclo
+func (p *parser) quoted() (string, error) {
+	p.pos++
+	buf := make([]byte, 0, 16)
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return string(buf), nil
+		case '\\':
+			if p.pos >= len(p.data) {
+				return "", p.errorf("unterminated string")
+			}
+			c = p.data[p.pos]
+			p.pos++
+			switch c {
+			case 'n':
+				buf = append(buf, '\n')
+			case 'r':
+				buf = append(buf, '\r')
+			case 't':
+				buf = append(buf, '\t')
+			case 'x':
+				if len(p.data)-p.pos < 2 {
+					return "", p.errorf("invalid escape sequence")
+				}
+				hi, ok1 := unhex(p.data[p.pos])
+				lo, ok2 := unhex(p.data[p.pos+1])
+				if !ok1 || !ok2 {
+					return "", p.errorf("invalid escape sequence")
+				}
+				buf = append(buf, hi<<4|lo)
+				p.pos += 2
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				// octal escape \ooo
+				v := int(c - '0')
+				for i := 0; i < 2 && p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '7'; i++ {
+					v = v*8 + int(p.data[p.pos]-'0')
+					p.pos++
+				}
+				if v > math.MaxUint8 {
+					return "", p.errorf("invalid escape sequence")
+				}
+				buf = append(buf, byte(v))
+			default:
+				buf = append(buf, c)
+			}
+		default:
+			buf = append(buf, c)
+		}
+	}
+
+	return "", p.errorf("unterminated string")
+}
+
+func (p *parser) literal() (*node, error) {
+	p.pos++
+	start := p.pos
+	for p.pos < len(p.data) && (isIdentifierChar(p.data[p.pos]) || p.data[p.pos] == '-') {
+		p.pos++
+	}
+	switch s := string(p.data[start:p.pos]); s {
+	case "true":
+		return &node{kind: kindBool, b: true}, nil
+	case "false":
+		return &node{kind: kindBool, b: false}, nil
+	case "nan":
+		return &node{kind: kindDouble, f: math.NaN()}, nil
+	case "inf":
+		return &node{kind: kindDouble, f: math.Inf(1)}, nil
+	case "-inf":
+		return &node{kind: kindDouble, f: math.Inf(-1)}, nil
+	default:
+		return nil, p.errorf("unknown literal %%%s", s)
+	}
+}
+
+func (p *parser) number() (*node, error) {
+	start := p.pos
+	isDouble := false
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		if c == '.' || c == 'e' || c == 'E' {
+			isDouble = true
+		} else if !(c >= '0' && c <= '9' || c == '-' || c == '+') {
+			break
+		}
+		p.pos++
+	}
+	s := string(p.data[start:p.pos])
+	switch {
+	case isDouble:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, p.errorf("invalid double %q", s)
+		}
+
+		return &node{kind: kindDouble, f: v}, nil
+	case p.pos < len(p.data) && p.data[p.pos] == 'u':
+		p.pos++
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, p.errorf("invalid uint64 %q", s)
+		}
+
+		return &node{kind: kindUint64, u: v}, nil
+	default:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, p.errorf("invalid int64 %q", s)
+		}
+
+		return &node{kind: kindInt64, i: v}, nil
+	}
+}
+
+func (p *parser) enter() error {
+	if p.depth >= maxNestingLvl {
+		return p.errorf("nesting depth exceeds %d", maxNestingLvl)
+	}
+	p.depth++
+
+	return nil
+}
+
+func (p *parser) list() (*node, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+	p.pos++
+	n := &node{kind: kindList}
+	for p.peek() != ']' {
+		item, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		n.items = append(n.items, item)
+		if p.peek() != ';' {
+			break
+		}
+		p.pos++
+	}
+	if err := p.expect(']'); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// mapping parses map or attributes (with brackets open and closing)
+func (p *parser) mapping(open, closing byte) (*node, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+	if err := p.expect(open); err != nil {
+		return nil, err
+	}
+	n := &node{kind: kindMap}
+	for p.peek() != closing {
+		var key string
+		switch c := p.peek(); {
+		case c == '"':
+			k, err := p.quoted()
+			if err != nil {
+				return nil, err
+			}
+			key = k
+		case c == binaryString:
+			k, err := p.binaryString()
+			if err != nil {
+				return nil, err
+			}
+			key = k
+		case isIdentifierStart(c):
+			key = p.identifier()
+		default:
+			return nil, p.errorf("expected key, got %q", c)
+		}
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		n.keys = append(n.keys, key)
+		n.values = append(n.values, v)
+		if p.peek() != ';' {
+			break
+		}
+		p.pos++
+	}
+	if err := p.expect(closing); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// encode writes node by encoder
+func (n *node) encode(e *encoder) error {
+	if n.attrs != nil && len(n.attrs.keys) > 0 {
+		if err := e.begin('<'); err != nil {
+			return err
+		}
+		if err := n.attrs.keyValues(e); err != nil {
+			return err
+		}
+		e.end('>', false)
+		if e.format == FormatPretty {
+			e.buf.WriteByte(' ')
+		}
+	}
+	switch n.kind {
+	case kindEntity:
+		e.entity()
+	case kindBool:
+		e.bool(n.b)
+	case kindInt64:
+		e.int64(n.i)
+	case kindUint64:
+		e.uint64(n.u)
+	case kindDouble:
+		e.double(n.f)
+	case kindString:
+		e.string(n.s)
+	case kindList:
+		if err := e.begin('['); err != nil {
+			return err
+		}
+		for i, item := range n.items {
+			e.item(i)
+			if err := item.encode(e); err != nil {
+				return err
+			}
+		}
+		e.end(']', len(n.items) == 0)
+	case kindMap:
+		if err := e.begin('{'); err != nil {
+			return err
+		}
+		if err := n.keyValues(e); err != nil {
+			return err
+		}
+		e.end('}', len(n.keys) == 0)
+	}
+
+	return nil
+}
+
+func (n *node) keyValues(e *encoder) error {
+	for i, k := range n.keys {
+		e.item(i)
+		e.string(k)
+		if e.format == FormatPretty {
+			e.buf.WriteString(" = ")
+		} else {
+			e.buf.WriteByte('=')
+		}
+		if err := n.values[i].encode(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decode stores node into value pointed by v
+func (n *node) decode(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: destination must be non-nil pointer, got %T",
+			errCannotStore, v,
+		))
+	}
+	if err := n.store(rv.Elem()); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (n *node) cannotStore(dst reflect.Value) error {
+	return fmt.Errorf("%w: %s into %s", errCannotStore, n.kind, dst.Type())
+}
+
+// natural returns go value of node for destination interface{}
+func (n *node) natural() interface{} {
+	var v interface{}
+	switch n.kind {
+	case kindEntity:
+		v = nil
+	case kindBool:
+		v = n.b
+	case kindInt64:
+		v = n.i
+	case kindUint64:
+		v = n.u
+	case kindDouble:
+		v = n.f
+	case kindString:
+		v = n.s
+	case kindList:
+		items := make([]interface{}, len(n.items))
+		for i, item := range n.items {
+			items[i] = item.natural()
+		}
+		v = items
+	case kindMap:
+		v = n.naturalMap()
+	}
+	if n.attrs != nil {
+		return Attributed{
+			Attributes: n.attrs.naturalMap(),
+			Value:      v,
+		}
+	}
+
+	return v
+}
+
+func (n *node) naturalMap() map[string]interface{} {
+	m := make(map[string]interface{}, len(n.keys))
+	for i, k := range n.keys {
+		m[k] = n.values[i].natural()
+	}
+
+	return m
+}
+
+// text returns node in text YSON format
+func (n *node) text() ([]byte, error) {
+	e := encoder{format: FormatText}
+	if err := n.encode(&e); err != nil {
+		return nil, err
+	}
+
+	return e.buf.Bytes(), nil
+}
+
+//nolint:funlen,gocyclo
+func (n *node) store(dst reflect.Value) error {
+	if dst.CanAddr() {
+		switch x := dst.Addr().Interface().(type) {
+		case Unmarshaler:
+			text, err := n.text()
+			if err != nil {
+				return err
+			}
+
+			return x.UnmarshalYSON(text)
+		case *Attributed:
+			x.Value = n.natural()
+			x.Attributes = nil
+			if a, ok := x.Value.(Attributed); ok {
+				*x = a
+			}
+
+			return nil
+		case *time.Time:
+			if n.kind != kindString {
+				return n.cannotStore(dst)
+			}
+			t, err := time.Parse(time.RFC3339Nano, n.s)
+			if err != nil {
+				return fmt.Errorf("%w: %v", errCannotStore, err)
+			}
+			*x = t
+
+			return nil
+		case encoding.TextUnmarshaler:
+			if n.kind != kindString {
+				return n.cannotStore(dst)
+			}
+
+			return x.UnmarshalText([]byte(n.s))
+		}
+	}
+	switch dst.Kind() {
+	case reflect.Interface:
+		if dst.NumMethod() != 0 {
+			return n.cannotStore(dst)
+		}
+		if v := n.natural(); v != nil {
+			dst.Set(reflect.ValueOf(v))
+		} else {
+			dst.Set(reflect.Zero(dst.Type()))
+		}
+
+		return nil
+	case reflect.Pointer:
+		if n.kind == kindEntity {
+			dst.Set(reflect.Zero(dst.Type()))
+
+			return nil
+		}
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+
+		return n.store(dst.Elem())
+	case reflect.Map, reflect.Slice:
+		if n.kind == kindEntity {
+			dst.Set(reflect.Zero(dst.Type()))
+
+			return nil
+		}
+	}
+
+	return n.storeValue(dst)
+}
+
+//nolint:funlen,gocyclo
+func (n *node) storeValue(dst reflect.Value) error {
+	switch dst.Kind() {
+	case reflect.Bool:
+		if n.kind != kindBool {
+			return n.cannotStore(dst)
+		}
+		dst.SetBool(n.b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var v int64
+		switch n.kind {
+		case kindInt64:
+			v = n.i
+		case kindUint64:
+			if n.u > math.MaxInt64 {
+				return fmt.Errorf("%w: %du overflows %s", errCannotStore, n.u, dst.Type())
+			}
+			v = int64(n.u)
+		default:
+			return n.cannotStore(dst)
+		}
+		if dst.OverflowInt(v) {
+			return fmt.Errorf("%w: %d overflows %s", errCannotStore, v, dst.Type())
+		}
+		dst.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var v uint64
+		switch n.kind {
+		case kindUint64:
+			v = n.u
+		case kindInt64:
+			if n.i < 0 {
+				return fmt.Errorf("%w: %d overflows %s", errCannotStore, n.i, dst.Type())
+			}
+			v = uint64(n.i)
+		default:
+			return n.cannotStore(dst)
+		}
+		if dst.OverflowUint(v) {
+			return fmt.Errorf("%w: %du overflows %s", errCannotStore, v, dst.Type())
+		}
+		dst.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		switch n.kind {
+		case kindDouble:
+			dst.SetFloat(n.f)
+		case kindInt64:
+			dst.SetFloat(float64(n.i))
+		case kindUint64:
+			dst.SetFloat(float64(n.u))
+		default:
+			return n.cannotStore(dst)
+		}
+	case reflect.String:
+		if n.kind != kindString {
+			return n.cannotStore(dst)
+		}
+		dst.SetString(n.s)
+	case reflect.Slice:
+		if dst.Type().Elem().Kind() == reflect.Uint8 && n.kind == kindString {
+			dst.SetBytes(append([]byte(nil), n.s...))
+
+			return nil
+		}
+		if n.kind != kindList {
+			return n.cannotStore(dst)
+		}
+		items := reflect.MakeSlice(dst.Type(), len(n.items), len(n.items))
+		for i, item := range n.items {
+			if err := item.store(items.Index(i)); err != nil {
+				return err
+			}
+		}
+		dst.Set(items)
+	case reflect.Array:
+		if n.kind != kindList || len(n.items) != dst.Len() {
+			return n.cannotStore(dst)
+		}
+		for i, item := range n.items {
+			if err := item.store(dst.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		return n.storeMap(dst)
+	case reflect.Struct:
+		return n.storeStruct(dst)
+	default:
+		return n.cannotStore(dst)
+	}
+
+	return nil
+}
+
+func (n *node) storeMap(dst reflect.Value) error {
+	if n.kind != kindMap {
+		return n.cannotStore(dst)
+	}
+	t := dst.Type()
+	m := reflect.MakeMapWithSize(t, len(n.keys))
+	for i, k := range n.keys {
+		key := reflect.New(t.Key()).Elem()
+		if err := storeKey(k, key); err != nil {
+			return err
+		}
+		value := reflect.New(t.Elem()).Elem()
+		if err := n.values[i].store(value); err != nil {
+			return err
+		}
+		m.SetMapIndex(key, value)
+	}
+	dst.Set(m)
+
+	return nil
+}
+
+// storeKey stores key of YSON map into key of go map
+func storeKey(k string, dst reflect.Value) error {
+	if tu, ok := dst.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(k))
+	}
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(k)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(k, 10, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: key %q into %s", errCannotStore, k, dst.Type())
+		}
+		dst.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(k, 10, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: key %q into %s", errCannotStore, k, dst.Type())
+		}
+		dst.SetUint(v)
+	default:
+		return fmt.Errorf("%w: map key %s", errUnsupported, dst.Type())
+	}
+
+	return nil
+}
+
+func (n *node) storeStruct(dst reflect.Value) error {
+	if n.kind != kindMap {
+		return n.cannotStore(dst)
+	}
+	fields := structFields(dst.Type())
+	for i, k := range n.keys {
+		for _, f := range fields {
+			if f.name != k {
+				continue
+			}
+			if err := n.values[i].store(dst.FieldByIndex(f.index)); err != nil {
+				return fmt.Errorf("field %q: %w", k, err)
+			}
+
+			break
+		}
+	}
+
+	return nil
+}
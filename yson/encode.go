@@ -0,0 +1,440 @@
+package yson
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// markers of binary scalars
+const (
+	binaryString  = 0x01
+	binaryInt64   = 0x02
+	binaryDouble  = 0x03
+	binaryFalse   = 0x04
+	binaryTrue    = 0x05
+	binaryUint64  = 0x06
+	prettyIndent  = "    "
+	tagName       = "yson"
+	tagOmitEmpty  = "omitempty"
+	maxNestingLvl = 1024
+)
+
+type encoder struct {
+	buf    bytes.Buffer
+	format Format
+	depth  int
+}
+
+func (e *encoder) encode(v interface{}) error {
+	if err := e.value(reflect.ValueOf(v)); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (e *encoder) newline() {
+	if e.format != FormatPretty {
+		return
+	}
+	e.buf.WriteByte('\n')
+	for i := 0; i < e.depth; i++ {
+		e.buf.WriteString(prettyIndent)
+	}
+}
+
+func (e *encoder) entity() {
+	e.buf.WriteByte('#')
+}
+
+func (e *encoder) bool(v bool) {
+	switch {
+	case e.format == FormatBinary && v:
+		e.buf.WriteByte(binaryTrue)
+	case e.format == FormatBinary:
+		e.buf.WriteByte(binaryFalse)
+	case v:
+		e.buf.WriteString("%true")
+	default:
+		e.buf.WriteString("%false")
+	}
+}
+
+func (e *encoder) int64(v int64) {
+	if e.format == FormatBinary {
+		e.buf.WriteByte(binaryInt64)
+		e.buf.Write(binary.AppendVarint(nil, v))
+
+		return
+	}
+	e.buf.WriteString(strconv.FormatInt(v, 10))
+}
+
+func (e *encoder) uint64(v uint64) {
+	if e.format == FormatBinary {
+		e.buf.WriteByte(binaryUint64)
+		e.buf.Write(binary.AppendUvarint(nil, v))
+
+		return
+	}
+	e.buf.WriteString(strconv.FormatUint(v, 10))
+	e.buf.WriteByte('u')
+}
+
+func (e *encoder) double(v float64) {
+	if e.format == FormatBinary {
+		e.buf.WriteByte(binaryDouble)
+		e.buf.Write(binary.LittleEndian.AppendUint64(nil, math.Float64bits(v)))
+
+		return
+	}
+	switch {
+	case math.IsNaN(v):
+		e.buf.WriteString("%nan")
+	case math.IsInf(v, 1):
+		e.buf.WriteString("%inf")
+	case math.IsInf(v, -1):
+		e.buf.WriteString("%-inf")
+	default:
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			// distinguishes double from integer
+			s += "."
+		}
+		e.buf.WriteString(s)
+	}
+}
+
+func (e *encoder) string(v string) {
+	if e.format == FormatBinary {
+		e.buf.WriteByte(binaryString)
+		e.buf.Write(binary.AppendVarint(nil, int64(len(v))))
+		e.buf.WriteString(v)
+
+		return
+	}
+	e.buf.WriteByte('"')
+	for i := 0; i < len(v); i++ {
+		switch c := v[i]; c {
+		case '"', '\\':
+			e.buf.WriteByte('\\')
+			e.buf.WriteByte(c)
+		case '\n':
+			e.buf.WriteString(`\n`)
+		case '\r':
+			e.buf.WriteString(`\r`)
+		case '\t':
+			e.buf.WriteString(`\t`)
+		default:
+			if c < 0x20 || c >= 0x7f {
+				fmt.Fprintf(&e.buf, `\x%02X`, c)
+			} else {
+				e.buf.WriteByte(c)
+			}
+		}
+	}
+	e.buf.WriteByte('"')
+}
+
+// begin writes opening bracket of list, map or attributes
+func (e *encoder) begin(c byte) error {
+	if e.depth >= maxNestingLvl {
+		return fmt.Errorf("%w: nesting depth exceeds %d", errUnsupported, maxNestingLvl)
+	}
+	e.buf.WriteByte(c)
+	e.depth++
+
+	return nil
+}
+
+// end writes closing bracket of list, map or attributes
+func (e *encoder) end(c byte, empty bool) {
+	e.depth--
+	if !empty {
+		e.newline()
+	}
+	e.buf.WriteByte(c)
+}
+
+// item writes separator before item of list, map or attributes
+func (e *encoder) item(i int) {
+	if i > 0 {
+		e.buf.WriteByte(';')
+	}
+	e.newline()
+}
+
+func (e *encoder) keyValue(i int, k string, v reflect.Value) error {
+	e.item(i)
+	e.string(k)
+	if e.format == FormatPretty {
+		e.buf.WriteString(" = ")
+	} else {
+		e.buf.WriteByte('=')
+	}
+
+	return e.value(v)
+}
+
+func (e *encoder) attributes(attrs map[string]interface{}) error {
+	if len(attrs) == 0 {
+		return nil
+	}
+	if err := e.begin('<'); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if err := e.keyValue(i, k, reflect.ValueOf(attrs[k])); err != nil {
+			return err
+		}
+	}
+	e.end('>', false)
+	if e.format == FormatPretty {
+		e.buf.WriteByte(' ')
+	}
+
+	return nil
+}
+
+//nolint:funlen,gocyclo
+func (e *encoder) value(v reflect.Value) error {
+	if !v.IsValid() {
+		e.entity()
+
+		return nil
+	}
+	if v.CanInterface() {
+		switch x := v.Interface().(type) {
+		case Marshaler:
+			if v.Kind() == reflect.Pointer && v.IsNil() {
+				e.entity()
+
+				return nil
+			}
+			data, err := x.MarshalYSON()
+			if err != nil {
+				return err
+			}
+
+			return e.raw(data)
+		case time.Time:
+			e.string(x.Format(time.RFC3339Nano))
+
+			return nil
+		case Attributed:
+			if err := e.attributes(x.Attributes); err != nil {
+				return err
+			}
+
+			return e.value(reflect.ValueOf(x.Value))
+		case encoding.TextMarshaler:
+			if v.Kind() == reflect.Pointer && v.IsNil() {
+				e.entity()
+
+				return nil
+			}
+			text, err := x.MarshalText()
+			if err != nil {
+				return err
+			}
+			e.string(string(text))
+
+			return nil
+		}
+	}
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		if v.IsNil() {
+			e.entity()
+
+			return nil
+		}
+
+		return e.value(v.Elem())
+	case reflect.Bool:
+		e.bool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.int64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		e.uint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		e.double(v.Float())
+	case reflect.String:
+		e.string(v.String())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			e.entity()
+
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			e.string(string(b))
+
+			return nil
+		}
+
+		return e.list(v)
+	case reflect.Map:
+		if v.IsNil() {
+			e.entity()
+
+			return nil
+		}
+
+		return e.mapping(v)
+	case reflect.Struct:
+		return e.structure(v)
+	default:
+		return fmt.Errorf("%w: %s", errUnsupported, v.Type())
+	}
+
+	return nil
+}
+
+// raw writes YSON produced by Marshaler
+func (e *encoder) raw(data []byte) error {
+	n, err := parse(data)
+	if err != nil {
+		return err
+	}
+
+	return n.encode(e)
+}
+
+func (e *encoder) list(v reflect.Value) error {
+	if err := e.begin('['); err != nil {
+		return err
+	}
+	for i := 0; i < v.Len(); i++ {
+		e.item(i)
+		if err := e.value(v.Index(i)); err != nil {
+			return err
+		}
+	}
+	e.end(']', v.Len() == 0)
+
+	return nil
+}
+
+func mapKey(k reflect.Value) (string, error) {
+	if tm, ok := k.Interface().(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+
+		return string(text), err
+	}
+	switch k.Kind() {
+	case reflect.String:
+		return k.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(k.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(k.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("%w: map key %s", errUnsupported, k.Type())
+	}
+}
+
+func (e *encoder) mapping(v reflect.Value) error {
+	type entry struct {
+		k string
+		v reflect.Value
+	}
+	entries := make([]entry, 0, v.Len())
+	for iter := v.MapRange(); iter.Next(); {
+		k, err := mapKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{k: k, v: iter.Value()})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].k < entries[j].k
+	})
+	if err := e.begin('{'); err != nil {
+		return err
+	}
+	for i := range entries {
+		if err := e.keyValue(i, entries[i].k, entries[i].v); err != nil {
+			return err
+		}
+	}
+	e.end('}', len(entries) == 0)
+
+	return nil
+}
+
+type structField struct {
+	index     []int
+	name      string
+	omitEmpty bool
+}
+
+// structFields returns exported fields of struct type (including fields of embedded structs)
+func structFields(t reflect.Type) (fields []structField) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get(tagName)
+		if tag == "-" || !f.IsExported() && !f.Anonymous {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			for _, ef := range structFields(f.Type) {
+				ef.index = append([]int{i}, ef.index...)
+				fields = append(fields, ef)
+			}
+
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, structField{
+			index:     []int{i},
+			name:      name,
+			omitEmpty: opts == tagOmitEmpty,
+		})
+	}
+
+	return fields
+}
+
+func (e *encoder) structure(v reflect.Value) error {
+	if err := e.begin('{'); err != nil {
+		return err
+	}
+	i := 0
+	for _, f := range structFields(v.Type()) {
+		fv := v.FieldByIndex(f.index)
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+		if err := e.keyValue(i, f.name, fv); err != nil {
+			return err
+		}
+		i++
+	}
+	e.end('}', i == 0)
+
+	return nil
+}
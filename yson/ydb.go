@@ -0,0 +1,80 @@
+package yson
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	_ value.ValueConverter = (*Wrapper)(nil)
+	_ value.ValueScanner   = (*Wrapper)(nil)
+	_ scanner.Scanner      = (*Wrapper)(nil)
+	_ sql.Scanner          = (*Wrapper)(nil)
+	_ driver.Valuer        = (*Wrapper)(nil)
+)
+
+// Wrapper binds go value as YDB value of type Yson and scans values of type Yson into go value.
+// Wrapper is created by Wrap
+type Wrapper struct {
+	v interface{}
+}
+
+// Wrap makes Wrapper of v for using as query parameter or scan destination.
+// For scanning v must be a non-nil pointer, such as:
+//
+//	var m map[string]interface{}
+//	err := row.Scan(yson.Wrap(&m))
+func Wrap(v interface{}) *Wrapper {
+	return &Wrapper{v: v}
+}
+
+// ToYDBValue converts wrapped value into YDB value of type Yson (in text format)
+func (w *Wrapper) ToYDBValue() (value.Value, error) {
+	data, err := Marshal(w.v)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.YSONValue(data), nil
+}
+
+// ScanYDBValue decodes YDB value of type Yson into wrapped value
+func (w *Wrapper) ScanYDBValue(v value.Value) error {
+	var data []byte
+	if err := value.CastTo(v, &data); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return Unmarshal(data, w.v)
+}
+
+// UnmarshalYDB implements scanner of table service results
+func (w *Wrapper) UnmarshalYDB(raw scanner.RawValue) error {
+	return w.Scan(raw.Any())
+}
+
+// Scan implements sql.Scanner. NULL is decoded as entity
+func (w *Wrapper) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		return Unmarshal([]byte("#"), w.v)
+	case []byte:
+		return Unmarshal(v, w.v)
+	case string:
+		return Unmarshal([]byte(v), w.v)
+	case value.Value:
+		return w.ScanYDBValue(v)
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("%w: cannot scan %T into yson", errCannotStore, src))
+	}
+}
+
+// Value implements driver.Valuer. Wrapped value is passed as text YSON
+func (w *Wrapper) Value() (driver.Value, error) {
+	return Marshal(w.v)
+}
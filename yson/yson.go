@@ -0,0 +1,96 @@
+// Package yson implements encoding and decoding of YSON (text and binary formats)
+// for values of YDB type Yson.
+//
+// Go values are mapped to YSON as follows:
+//
+//   - nil, nil pointers, nil maps and nil slices - entity (#)
+//   - bool - boolean (%true, %false)
+//   - int, int8, int16, int32, int64 - signed integer (123)
+//   - uint, uint8, uint16, uint32, uint64 - unsigned integer (123u)
+//   - float32, float64 - double (1.5, %nan, %inf, %-inf)
+//   - string, []byte - string ("abc")
+//   - time.Time - string in RFC 3339 format with nanoseconds
+//   - slices and arrays - list ([1;2;3])
+//   - maps with string or integer keys - map ({a=1;b=2}) with sorted keys
+//   - structs - map with names of members from tag `yson:"name"` or names of fields.
+//     Tag option omitempty skips empty values, tag "-" skips field
+//   - Attributed - value with attributes (<a=1>value)
+//   - Marshaler and encoding.TextMarshaler - encodes by itself
+//
+// Decoding into interface{} produces nil, bool, int64, uint64, float64, string, []interface{},
+// map[string]interface{} or Attributed (for values with attributes)
+package yson
+
+import (
+	"errors"
+)
+
+// Format is a format of YSON encoding
+type Format uint8
+
+const (
+	// FormatText is a compact text YSON (such as {a=1;b=[%true;"x"]})
+	FormatText Format = iota
+	// FormatBinary is a binary YSON with binary scalars
+	FormatBinary
+	// FormatPretty is a text YSON with indents
+	FormatPretty
+)
+
+var (
+	errSyntax      = errors.New("yson: syntax error")
+	errUnsupported = errors.New("yson: unsupported type")
+	errCannotStore = errors.New("yson: cannot store")
+)
+
+type (
+	// Marshaler is an interface of types which encode itself into YSON
+	Marshaler interface {
+		MarshalYSON() ([]byte, error)
+	}
+
+	// Unmarshaler is an interface of types which decode itself from YSON (in text format)
+	Unmarshaler interface {
+		UnmarshalYSON(data []byte) error
+	}
+
+	// Attributed is a YSON value with attributes
+	Attributed struct {
+		Attributes map[string]interface{}
+		Value      interface{}
+	}
+)
+
+// Marshal returns text YSON encoding of v
+func Marshal(v interface{}) ([]byte, error) {
+	return MarshalFormat(v, FormatText)
+}
+
+// MarshalFormat returns YSON encoding of v in given format
+func MarshalFormat(v interface{}, format Format) ([]byte, error) {
+	e := encoder{
+		format: format,
+	}
+	if err := e.encode(v); err != nil {
+		return nil, err
+	}
+
+	return e.buf.Bytes(), nil
+}
+
+// Unmarshal decodes YSON (in text or binary format) into value pointed by v
+func Unmarshal(data []byte, v interface{}) error {
+	n, err := parse(data)
+	if err != nil {
+		return err
+	}
+
+	return n.decode(v)
+}
+
+// Valid reports whether data is a valid YSON
+func Valid(data []byte) bool {
+	_, err := parse(data)
+
+	return err == nil
+}
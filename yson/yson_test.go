@@ -0,0 +1,263 @@
+package yson
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+type testRow struct {
+	ID      uint64            `yson:"id"`
+	Name    string            `yson:"name"`
+	Tags    []string          `yson:"tags,omitempty"`
+	Score   float64           `yson:"score"`
+	Labels  map[string]int64  `yson:"labels,omitempty"`
+	Parent  *testRow          `yson:"parent"`
+	Ignored string            `yson:"-"`
+	Extra   map[string]string `yson:"extra,omitempty"`
+}
+
+type testMarshaler struct {
+	s string
+}
+
+func (m testMarshaler) MarshalYSON() ([]byte, error) {
+	return []byte(`<kind=custom>"` + m.s + `"`), nil
+}
+
+func (m *testMarshaler) UnmarshalYSON(data []byte) error {
+	var a Attributed
+	if err := Unmarshal(data, &a); err != nil {
+		return err
+	}
+	m.s, _ = a.Value.(string)
+
+	return nil
+}
+
+func TestMarshal(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		v    interface{}
+		text string
+	}{
+		{name: "nil", v: nil, text: `#`},
+		{name: "NilPointer", v: (*int)(nil), text: `#`},
+		{name: "NilSlice", v: []int(nil), text: `#`},
+		{name: "True", v: true, text: `%true`},
+		{name: "False", v: false, text: `%false`},
+		{name: "Int", v: -42, text: `-42`},
+		{name: "Uint", v: uint8(42), text: `42u`},
+		{name: "Double", v: 1.5, text: `1.5`},
+		{name: "IntegralDouble", v: float64(3), text: `3.`},
+		{name: "NaN", v: math.NaN(), text: `%nan`},
+		{name: "Inf", v: math.Inf(1), text: `%inf`},
+		{name: "NegInf", v: math.Inf(-1), text: `%-inf`},
+		{name: "String", v: "a\"b\\c\n\x01", text: `"a\"b\\c\n\x01"`},
+		{name: "Bytes", v: []byte("abc"), text: `"abc"`},
+		{name: "Time", v: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC), text: `"2024-01-02T03:04:05.000000006Z"`},
+		{name: "EmptyList", v: []int{}, text: `[]`},
+		{name: "List", v: []interface{}{1, "a", nil}, text: `[1;"a";#]`},
+		{name: "Array", v: [2]bool{true, false}, text: `[%true;%false]`},
+		{name: "Map", v: map[string]int{"b": 2, "a": 1}, text: `{"a"=1;"b"=2}`},
+		{name: "IntKeys", v: map[int]string{10: "x", 2: "y"}, text: `{"10"="x";"2"="y"}`},
+		{
+			name: "Struct",
+			v:    testRow{ID: 1, Name: "x", Score: 0.5, Ignored: "y"},
+			text: `{"id"=1u;"name"="x";"score"=0.5;"parent"=#}`,
+		},
+		{
+			name: "Attributed",
+			v:    Attributed{Attributes: map[string]interface{}{"b": 1, "a": "x"}, Value: []int{1}},
+			text: `<"a"="x";"b"=1>[1]`,
+		},
+		{name: "Marshaler", v: testMarshaler{s: "v"}, text: `<"kind"="custom">"v"`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Marshal(tt.v)
+			require.NoError(t, err)
+			require.Equal(t, tt.text, string(data))
+			require.True(t, Valid(data))
+		})
+	}
+}
+
+func TestMarshalPretty(t *testing.T) {
+	data, err := MarshalFormat(map[string]interface{}{"a": []int{1, 2}, "b": map[string]int{}}, FormatPretty)
+	require.NoError(t, err)
+	require.Equal(t, "{\n    \"a\" = [\n        1;\n        2\n    ];\n    \"b\" = {}\n}", string(data))
+}
+
+func TestMarshalErrors(t *testing.T) {
+	for _, v := range []interface{}{
+		make(chan int),
+		func() {},
+		map[float64]int{1: 1},
+		complex(1, 2),
+	} {
+		_, err := Marshal(v)
+		require.ErrorIs(t, err, errUnsupported)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		text string
+		exp  interface{}
+	}{
+		{name: "Entity", text: `#`, exp: nil},
+		{name: "Bool", text: ` %true `, exp: true},
+		{name: "Int", text: `-42`, exp: int64(-42)},
+		{name: "Uint", text: `42u`, exp: uint64(42)},
+		{name: "Double", text: `1.5e3`, exp: 1500.},
+		{name: "Inf", text: `%-inf`, exp: math.Inf(-1)},
+		{name: "Identifier", text: `abc_1`, exp: "abc_1"},
+		{name: "Escapes", text: `"a\"\\\n\x41\101"`, exp: "a\"\\\nAA"},
+		{
+			name: "Containers",
+			text: `{a=[1;2u;"x";];"b c"={};}`,
+			exp: map[string]interface{}{
+				"a":   []interface{}{int64(1), uint64(2), "x"},
+				"b c": map[string]interface{}{},
+			},
+		},
+		{
+			name: "Attributes",
+			text: `<a=1>[<b=%false>#]`,
+			exp: Attributed{
+				Attributes: map[string]interface{}{"a": int64(1)},
+				Value: []interface{}{Attributed{
+					Attributes: map[string]interface{}{"b": false},
+				}},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var v interface{}
+			require.NoError(t, Unmarshal([]byte(tt.text), &v))
+			require.Equal(t, tt.exp, v)
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	src := testRow{
+		ID:     1,
+		Name:   "имя \"quoted\"",
+		Tags:   []string{"a", "b"},
+		Score:  -0.25,
+		Labels: map[string]int64{"x": math.MinInt64, "y": math.MaxInt64},
+		Parent: &testRow{ID: 2, Name: "parent"},
+		Extra:  map[string]string{"k": "v"},
+	}
+	for _, format := range []Format{FormatText, FormatBinary, FormatPretty} {
+		data, err := MarshalFormat(src, format)
+		require.NoError(t, err)
+		var dst testRow
+		require.NoError(t, Unmarshal(data, &dst))
+		require.Equal(t, src, dst)
+	}
+	t.Run("Marshaler", func(t *testing.T) {
+		data, err := MarshalFormat([]testMarshaler{{s: "a"}, {s: "b"}}, FormatBinary)
+		require.NoError(t, err)
+		var dst []testMarshaler
+		require.NoError(t, Unmarshal(data, &dst))
+		require.Equal(t, []testMarshaler{{s: "a"}, {s: "b"}}, dst)
+	})
+	t.Run("Time", func(t *testing.T) {
+		src := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+		data, err := Marshal(src)
+		require.NoError(t, err)
+		var dst time.Time
+		require.NoError(t, Unmarshal(data, &dst))
+		require.Equal(t, src, dst)
+	})
+	t.Run("Attributed", func(t *testing.T) {
+		src := Attributed{Attributes: map[string]interface{}{"a": "x"}, Value: int64(1)}
+		data, err := MarshalFormat(src, FormatBinary)
+		require.NoError(t, err)
+		var dst Attributed
+		require.NoError(t, Unmarshal(data, &dst))
+		require.Equal(t, src, dst)
+		var plain int
+		require.NoError(t, Unmarshal(data, &plain))
+		require.Equal(t, 1, plain)
+	})
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		text string
+		dst  interface{}
+		err  error
+	}{
+		{name: "Empty", text: ``, dst: new(interface{}), err: errSyntax},
+		{name: "Unterminated", text: `"abc`, dst: new(interface{}), err: errSyntax},
+		{name: "UnclosedList", text: `[1;2`, dst: new(interface{}), err: errSyntax},
+		{name: "MissingEquals", text: `{a;b}`, dst: new(interface{}), err: errSyntax},
+		{name: "Trailing", text: `1 2`, dst: new(interface{}), err: errSyntax},
+		{name: "UnknownLiteral", text: `%maybe`, dst: new(interface{}), err: errSyntax},
+		{name: "BadBinary", text: "\x01\x10ab", dst: new(interface{}), err: errSyntax},
+		{name: "NotPointer", text: `1`, dst: 1, err: errCannotStore},
+		{name: "NilPointer", text: `1`, dst: (*int)(nil), err: errCannotStore},
+		{name: "Overflow", text: `300`, dst: new(uint8), err: errCannotStore},
+		{name: "Negative", text: `-1`, dst: new(uint), err: errCannotStore},
+		{name: "Mismatch", text: `"a"`, dst: new(int), err: errCannotStore},
+		{name: "ArrayLength", text: `[1;2]`, dst: new([3]int), err: errCannotStore},
+		{name: "MapKey", text: `{a=1}`, dst: new(map[int]int), err: errCannotStore},
+		{name: "Field", text: `{id="x"}`, dst: new(testRow), err: errCannotStore},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Unmarshal([]byte(tt.text), tt.dst)
+			require.ErrorIs(t, err, tt.err)
+			if errors.Is(tt.err, errSyntax) {
+				require.False(t, Valid([]byte(tt.text)))
+			}
+		})
+	}
+}
+
+func TestWrapper(t *testing.T) {
+	src := map[string]interface{}{"a": []interface{}{int64(1), "x"}}
+	v, err := Wrap(src).ToYDBValue()
+	require.NoError(t, err)
+	require.Equal(t, types.YSON, v.Type())
+
+	t.Run("CastTo", func(t *testing.T) {
+		var dst map[string]interface{}
+		require.NoError(t, value.CastTo(v, Wrap(&dst)))
+		require.Equal(t, src, dst)
+		dst = nil
+		require.NoError(t, value.CastTo(value.OptionalValue(v), Wrap(&dst)))
+		require.Equal(t, src, dst)
+		require.Error(t, value.CastTo(value.NullValue(types.YSON), Wrap(&dst)))
+	})
+	t.Run("Scan", func(t *testing.T) {
+		var dst map[string]interface{}
+		require.NoError(t, Wrap(&dst).Scan([]byte(`{a=[1;x]}`)))
+		require.Equal(t, src, dst)
+		require.NoError(t, Wrap(&dst).Scan(nil))
+		require.Nil(t, dst)
+		require.NoError(t, Wrap(&dst).Scan(v))
+		require.Equal(t, src, dst)
+		require.Error(t, Wrap(&dst).Scan(1))
+	})
+	t.Run("Value", func(t *testing.T) {
+		data, err := Wrap([]int{1, 2}).Value()
+		require.NoError(t, err)
+		require.Equal(t, []byte(`[1;2]`), data)
+	})
+	t.Run("FromGo", func(t *testing.T) {
+		fromGo, err := value.FromGo(Wrap(src))
+		require.NoError(t, err)
+		require.Equal(t, v, fromGo)
+	})
+}